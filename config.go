@@ -0,0 +1,161 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+	discordRuntime "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+)
+
+// Config is the runtime configuration, loaded from CONFIG_PATH (./config.json by default).
+type Config struct {
+	Modules []ModuleConfig `json:"modules"`
+
+	// Privileged intents enabled for the bot in the Developer Portal (GUILD_MEMBERS, GUILD_PRESENCES,
+	// MESSAGE_CONTENT). The runtime only requests the ones that the modules need.
+	PrivilegedIntents []string `json:"privileged_intents,omitempty"`
+
+	// Time after which the runtime defers the interactions that a module hasn't answered yet
+	// (Discord fails the interactions that aren't answered within 3 seconds). "0" disables it.
+	InteractionAckBudget Duration `json:"interaction_ack_budget,omitempty"`
+
+	// Cache of the attachments downloaded by the modules: its directory, and its size in bytes
+	// (256 MiB if zero).
+	AttachmentCacheDir  string `json:"attachment_cache_dir,omitempty"`
+	AttachmentCacheSize int64  `json:"attachment_cache_size,omitempty"`
+}
+
+// DefaultInteractionAckBudget leaves time for the deferral to reach Discord.
+const DefaultInteractionAckBudget = 2 * time.Second
+
+// DefaultAttachmentCacheDir is the directory of the attachment cache if the config has none.
+const DefaultAttachmentCacheDir = "./attachment-cache"
+
+// ModuleConfig configures a single module.
+type ModuleConfig struct {
+	Path      string                     `json:"path"`                // Path to the module executable
+	Guilds    discordRuntime.GuildScope  `json:"guilds,omitempty"`    // Guilds where the module is enabled
+	Queue     discordRuntime.QueueConfig `json:"queue,omitempty"`     // Queue of the events sent to the module
+	Namespace string                     `json:"namespace,omitempty"` // Custom ID namespace of the module's components (the executable name if empty)
+
+	HookDeadlines   DeadlinesConfig `json:"hook_deadlines,omitempty"`   // Deadlines of the hooks called by the runtime
+	HelperDeadlines DeadlinesConfig `json:"helper_deadlines,omitempty"` // Deadlines of the Helper calls made by the module
+
+	MaxUploadSize int64           `json:"max_upload_size,omitempty"` // Size cap of the files sent by the module in a call, in bytes (25 MiB if zero)
+	Downloads     DownloadsConfig `json:"downloads,omitempty"`       // Limits of the attachments downloaded by the module
+}
+
+// CustomIDNamespace returns the namespace of the custom IDs of the module's components.
+// It should stay the same, otherwise the components of the messages sent before can't be used.
+func (c ModuleConfig) CustomIDNamespace() discordRuntime.Namespace {
+	if c.Namespace != "" {
+		return discordRuntime.Namespace(c.Namespace)
+	}
+	return discordRuntime.Namespace(filepath.Base(c.Path))
+}
+
+// DownloadsConfig limits the attachments that a module downloads through the runtime.
+type DownloadsConfig struct {
+	MaxSize      int64    `json:"max_size,omitempty"`      // Size cap in bytes (25 MiB if zero)
+	ContentTypes []string `json:"content_types,omitempty"` // Allowed content types (e.g. "audio/*"), all if empty
+}
+
+// Default deadlines of the modules, overridden by their hook_deadlines and helper_deadlines.
+var (
+	DefaultHookDeadlines = discord.Deadlines{
+		Default: 30 * time.Second,
+		Methods: map[string]time.Duration{"OnInit": 2 * time.Minute},
+	}
+	DefaultHelperDeadlines = discord.Deadlines{Default: 30 * time.Second}
+)
+
+// DeadlinesConfig configures deadlines by method name (e.g. "OnCreateChatMessage"), or "default"
+// for the methods without one. Deadlines are durations like "10s", and "0" removes the deadline.
+type DeadlinesConfig map[string]Duration
+
+// Deadlines returns the deadlines of the config, on top of def.
+func (c DeadlinesConfig) Deadlines(def discord.Deadlines) discord.Deadlines {
+	deadlines := discord.Deadlines{Default: def.Default, Methods: maps.Clone(def.Methods)}
+	if deadlines.Methods == nil {
+		deadlines.Methods = make(map[string]time.Duration)
+	}
+	for method, deadline := range c {
+		if method == "default" {
+			deadlines.Default = time.Duration(deadline)
+		} else {
+			deadlines.Methods[method] = time.Duration(deadline)
+		}
+	}
+	return deadlines
+}
+
+// Duration is a time.Duration written as a string (e.g. "1m30s") in the config.
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// LoadConfig reads the runtime configuration from path.
+//
+// If the file doesn't exist, a config with the single default module is returned.
+// It is enabled only in GUILD_ID (if set), which matches the behavior before config files existed.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		module := ModuleConfig{Path: "./bin/voice-player-module"}
+		if guildID := os.Getenv("GUILD_ID"); guildID != "" {
+			module.Guilds.Allow = []string{guildID}
+		}
+
+		return &Config{
+			Modules:              []ModuleConfig{module},
+			InteractionAckBudget: Duration(DefaultInteractionAckBudget),
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	config := &Config{InteractionAckBudget: Duration(DefaultInteractionAckBudget)}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+	if len(config.Modules) == 0 {
+		return nil, errors.New("no modules are configured")
+	}
+	namespaces := make(map[discordRuntime.Namespace]string, len(config.Modules))
+	for _, module := range config.Modules {
+		if err := module.Queue.Validate(); err != nil {
+			return nil, fmt.Errorf("module %s: %w", module.Path, err)
+		}
+
+		// The components of a module must not be routed to another one
+		namespace := module.CustomIDNamespace()
+		if namespace == "" || strings.Contains(string(namespace), discordRuntime.NamespaceSeparator) {
+			return nil, fmt.Errorf("module %s: invalid namespace %q", module.Path, namespace)
+		}
+		if other, ok := namespaces[namespace]; ok {
+			return nil, fmt.Errorf("module %s: namespace %q is already used by %s", module.Path, namespace, other)
+		}
+		namespaces[namespace] = module.Path
+	}
+
+	return config, nil
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"encoding/json"
+	"maps"
+	"testing"
+	"time"
+)
+
+func TestDeadlinesConfig(t *testing.T) {
+	var config ModuleConfig
+	err := json.Unmarshal([]byte(`{"path": "module", "hook_deadlines": {"default": "10s", "OnCreateChatMessage": "1m30s", "OnInit": "0"}}`), &config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deadlines := config.HookDeadlines.Deadlines(DefaultHookDeadlines)
+	want := map[string]time.Duration{"OnCreateChatMessage": 90 * time.Second, "OnInit": 0}
+	if deadlines.Default != 10*time.Second || !maps.Equal(deadlines.Methods, want) {
+		t.Errorf("got %+v", deadlines)
+	}
+	// The defaults are left as they are
+	if DefaultHookDeadlines.Methods["OnInit"] != 2*time.Minute {
+		t.Errorf("changed the defaults: %+v", DefaultHookDeadlines)
+	}
+
+	// Without a config, the defaults apply
+	deadlines = config.HelperDeadlines.Deadlines(DefaultHelperDeadlines)
+	if deadlines.Default != DefaultHelperDeadlines.Default || len(deadlines.Methods) != 0 {
+		t.Errorf("got %+v without a config", deadlines)
+	}
+
+	if err := json.Unmarshal([]byte(`{"hook_deadlines": {"default": "soon"}}`), &config); err == nil {
+		t.Error("parsed an invalid duration")
+	}
+}
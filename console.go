@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/thirdscam/chatanium-flexmodule/shared/console-v1"
+	discordRuntime "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+)
+
+// RunConsole serves the modules on the console platform until the script ends or the runtime is stopped.
+//
+// Events are read from CONSOLE_SCRIPT (or stdin if unset), and every Helper call
+// made by the modules is printed to stdout. See console.Console for the script syntax.
+func RunConsole(config *Config, state *RuntimeState, statePath string) {
+	var input io.Reader = os.Stdin
+	if path := os.Getenv("CONSOLE_SCRIPT"); path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			log.Error("Error opening console script", "path", path, "error", err.Error())
+			os.Exit(1)
+		}
+		defer f.Close()
+		input = f
+	}
+
+	c := console.New(os.Stdout)
+
+	// There is no voice on the console
+	modules := StartModules(config, state, statePath, c, nil, nil)
+	commands := discordRuntime.NewCommandRegistry()
+	for _, module := range modules {
+		// There are no guilds to register commands in, the console uses the global ones
+		if err := commands.Register(module.Path, module.Commands, []string{""}); err != nil {
+			log.Warn("Some commands of the module conflict with other modules and were not registered", "path", module.Path, "error", err.Error())
+		}
+		c.RegisterCommands(module.Commands)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- c.Run(input, console.Handler{
+			OnMessage: func(m *discordgo.Message) {
+				info := discordRuntime.MessageEventInfo("MESSAGE_CREATE", m)
+				for _, module := range modules {
+					if module.Wants(info) {
+						module.Hook.OnCreateChatMessage(module.Namespace.Message(m))
+					}
+				}
+			},
+			OnInteraction: func(i *discordgo.Interaction) {
+				module, routed := RouteInteraction(modules, commands, i)
+				if module == nil {
+					c.InteractionRespond(i, discordRuntime.UnroutedResponse(i))
+					return
+				}
+
+				// There's no deadline on the console, so the response is just printed
+				resp, err := module.Hook.OnCreateInteraction(routed)
+				if err == nil && resp != nil {
+					if resp, err = module.Namespace.Response(resp); err != nil {
+						log.Error("Invalid interaction response of the module", "path", module.Path, "error", err.Error())
+						return
+					}
+					c.InteractionRespond(i, resp)
+				}
+			},
+		})
+	}()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	log.Info("Ready to serve on the console. (press Ctrl+D or Ctrl+C to exit)")
+	select {
+	case err := <-done:
+		if err != nil {
+			log.Error("Error reading console input", "error", err.Error())
+		}
+	case <-stop:
+		fmt.Println()
+	}
+	log.Info("Shutting down...")
+
+	StopModules(modules, state, statePath)
+}
@@ -0,0 +1,593 @@
+// Package fakediscord is an in-process stand-in for Discord's REST API and gateway.
+//
+// It lets the runtime (and modules behind it) run end to end without a bot token or network access:
+// point discordgo at it with runtime.OverrideEndpoints(server.URL(), ""), open a session, inject
+// gateway events with Dispatch (or the MessageCreate/InteractionCreate shortcuts), and assert the
+// REST calls that were made with Requests or WaitForRequest.
+//
+// The REST API only covers the endpoints used by runtime.DiscordHelper. Unknown endpoints are
+// still recorded, and answered with an empty JSON object.
+package fakediscord
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/gorilla/websocket"
+)
+
+// Request is a REST request received by the server.
+type Request struct {
+	Method string
+	Path   string // Path without the "/api/v9" prefix (e.g. "/channels/1/messages")
+	Body   []byte // JSON body (the "payload_json" part for multipart requests)
+}
+
+// Server is a fake Discord server. Create it with New, and Close it when done.
+type Server struct {
+	Bot     *discordgo.User    // The user the session is logged in as
+	Guild   *discordgo.Guild   // A guild the bot is in (sent in READY)
+	Channel *discordgo.Channel // A text channel in Guild
+
+	http     *httptest.Server
+	mux      *http.ServeMux
+	upgrader websocket.Upgrader
+
+	mu       sync.Mutex
+	nextID   uint64
+	requests []Request
+	notify   chan struct{} // closed and replaced whenever a request is recorded
+	commands map[string][]*discordgo.ApplicationCommand
+	messages map[string]*discordgo.Message
+
+	wsMu     sync.Mutex
+	conn     *websocket.Conn
+	sequence int64
+	ready    chan struct{}
+}
+
+// New starts a fake Discord server on a local port.
+func New() *Server {
+	s := &Server{
+		nextID:   200000000000000000,
+		notify:   make(chan struct{}),
+		commands: map[string][]*discordgo.ApplicationCommand{},
+		messages: map[string]*discordgo.Message{},
+		ready:    make(chan struct{}),
+	}
+
+	s.Bot = &discordgo.User{ID: s.newID(), Username: "fakebot", Bot: true}
+	s.Guild = &discordgo.Guild{ID: s.newID(), Name: "fakeguild", OwnerID: s.Bot.ID}
+	s.Channel = &discordgo.Channel{ID: s.newID(), GuildID: s.Guild.ID, Name: "general", Type: discordgo.ChannelTypeGuildText}
+	s.Guild.Channels = []*discordgo.Channel{s.Channel}
+
+	s.mux = http.NewServeMux()
+	s.routes()
+	s.http = httptest.NewServer(s.mux)
+
+	return s
+}
+
+// URL returns the base URL of the server, to be passed to runtime.OverrideEndpoints.
+func (s *Server) URL() string {
+	return s.http.URL + "/"
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.wsMu.Lock()
+	if s.conn != nil {
+		s.conn.Close()
+	}
+	s.wsMu.Unlock()
+
+	s.http.Close()
+}
+
+// Ready returns a channel that is closed once a session has identified and received READY.
+func (s *Server) Ready() <-chan struct{} {
+	return s.ready
+}
+
+// ================================================
+// Gateway
+// ================================================
+
+type payload struct {
+	Op       int             `json:"op"`
+	Data     json.RawMessage `json:"d"`
+	Sequence int64           `json:"s,omitempty"`
+	Type     string          `json:"t,omitempty"`
+}
+
+// Dispatch sends a gateway event (op 0) with the given type (e.g. "MESSAGE_CREATE") to the session.
+func (s *Server) Dispatch(eventType string, data interface{}) error {
+	s.wsMu.Lock()
+	defer s.wsMu.Unlock()
+
+	if s.conn == nil {
+		return fmt.Errorf("no gateway connection")
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	s.sequence++
+	return s.conn.WriteJSON(payload{Op: 0, Data: raw, Sequence: s.sequence, Type: eventType})
+}
+
+// MessageCreate dispatches a MESSAGE_CREATE for a message with content, written by author in the default channel.
+func (s *Server) MessageCreate(author *discordgo.User, content string) (*discordgo.Message, error) {
+	m := &discordgo.Message{
+		ID:        s.newID(),
+		ChannelID: s.Channel.ID,
+		GuildID:   s.Guild.ID,
+		Content:   content,
+		Timestamp: time.Now(),
+		Author:    author,
+		Member:    &discordgo.Member{GuildID: s.Guild.ID, User: author},
+	}
+	return m, s.Dispatch("MESSAGE_CREATE", m)
+}
+
+// InteractionCreate dispatches an INTERACTION_CREATE for the application command name, used by user
+// in the default channel.
+func (s *Server) InteractionCreate(user *discordgo.User, name string, options ...*discordgo.ApplicationCommandInteractionDataOption) (*discordgo.Interaction, error) {
+	i := &discordgo.Interaction{
+		ID:        s.newID(),
+		AppID:     s.Bot.ID,
+		Type:      discordgo.InteractionApplicationCommand,
+		GuildID:   s.Guild.ID,
+		ChannelID: s.Channel.ID,
+		Member:    &discordgo.Member{GuildID: s.Guild.ID, User: user},
+		Token:     "fake-" + s.newID(),
+		Version:   1,
+		Data: discordgo.ApplicationCommandInteractionData{
+			ID:          s.commandID(name),
+			Name:        name,
+			CommandType: discordgo.ChatApplicationCommand,
+			Options:     options,
+		},
+	}
+	return i, s.Dispatch("INTERACTION_CREATE", i)
+}
+
+func (s *Server) gateway(w http.ResponseWriter, r *http.Request) {
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	s.wsMu.Lock()
+	s.conn = conn
+	s.wsMu.Unlock()
+
+	// Op 10 Hello
+	if err := s.send(conn, 10, map[string]int{"heartbeat_interval": 41250}); err != nil {
+		return
+	}
+
+	for {
+		var p payload
+		if err := conn.ReadJSON(&p); err != nil {
+			return
+		}
+
+		switch p.Op {
+		case 1: // Heartbeat -> Heartbeat ACK
+			s.send(conn, 11, nil)
+		case 2: // Identify -> READY
+			err = s.dispatchReady()
+		case 6: // Resume -> RESUMED
+			err = s.Dispatch("RESUMED", map[string]interface{}{})
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (s *Server) dispatchReady() error {
+	err := s.Dispatch("READY", discordgo.Ready{
+		Version:   9,
+		SessionID: "fake-session",
+		User:      s.Bot,
+		Guilds:    []*discordgo.Guild{s.Guild},
+	})
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	select {
+	case <-s.ready:
+	default:
+		close(s.ready)
+	}
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *Server) send(conn *websocket.Conn, op int, data interface{}) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	s.wsMu.Lock()
+	defer s.wsMu.Unlock()
+	return conn.WriteJSON(payload{Op: op, Data: raw})
+}
+
+// ================================================
+// REST
+// ================================================
+
+// Requests returns every REST request received so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request{}, s.requests...)
+}
+
+// WaitForRequest waits until a request with the method and path has been received, and returns it.
+func (s *Server) WaitForRequest(method, path string, timeout time.Duration) (Request, error) {
+	deadline := time.After(timeout)
+	for {
+		s.mu.Lock()
+		for _, r := range s.requests {
+			if r.Method == method && r.Path == path {
+				s.mu.Unlock()
+				return r, nil
+			}
+		}
+		notify := s.notify
+		s.mu.Unlock()
+
+		select {
+		case <-notify:
+		case <-deadline:
+			return Request{}, fmt.Errorf("timeout waiting for %s %s", method, path)
+		}
+	}
+}
+
+// handle registers a REST route. Patterns use http.ServeMux syntax without the API prefix
+// (e.g. "POST /channels/{channel}/messages").
+func (s *Server) handle(pattern string, fn func(r *http.Request, body []byte) interface{}) {
+	method, path, _ := strings.Cut(pattern, " ")
+	s.mux.HandleFunc(method+" /api/v"+discordgo.APIVersion+path, func(w http.ResponseWriter, r *http.Request) {
+		body := s.record(r)
+		resp := fn(r, body)
+		if resp == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+}
+
+// record reads the body of the request and records it.
+func (s *Server) record(r *http.Request) []byte {
+	body, _ := io.ReadAll(r.Body)
+
+	// Messages with files are sent as multipart, with the JSON in "payload_json"
+	if mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err == nil && strings.HasPrefix(mediaType, "multipart/") {
+		mr := multipart.NewReader(strings.NewReader(string(body)), params["boundary"])
+		for {
+			part, err := mr.NextPart()
+			if err != nil {
+				break
+			}
+			if part.FormName() == "payload_json" {
+				body, _ = io.ReadAll(part)
+				break
+			}
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   strings.TrimPrefix(r.URL.Path, "/api/v"+discordgo.APIVersion),
+		Body:   body,
+	})
+	close(s.notify)
+	s.notify = make(chan struct{})
+
+	return body
+}
+
+func (s *Server) routes() {
+	s.mux.HandleFunc("/gateway/", s.gateway)
+
+	// Unknown endpoints are recorded and answered with an empty object
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		s.record(r)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("{}"))
+	})
+
+	s.handle("GET /gateway", func(r *http.Request, body []byte) interface{} {
+		return map[string]string{"url": s.wsURL()}
+	})
+	s.handle("GET /gateway/bot", func(r *http.Request, body []byte) interface{} {
+		return discordgo.GatewayBotResponse{URL: s.wsURL(), Shards: 1}
+	})
+
+	// Messages
+	s.handle("POST /channels/{channel}/messages", func(r *http.Request, body []byte) interface{} {
+		var data discordgo.MessageSend
+		json.Unmarshal(body, &data)
+		return s.storeMessage(&discordgo.Message{
+			ID:        s.newID(),
+			ChannelID: r.PathValue("channel"),
+			Content:   data.Content,
+			Embeds:    data.Embeds,
+		})
+	})
+	s.handle("PATCH /channels/{channel}/messages/{message}", func(r *http.Request, body []byte) interface{} {
+		var data discordgo.MessageEdit
+		json.Unmarshal(body, &data)
+		m := s.message(r.PathValue("channel"), r.PathValue("message"))
+		if data.Content != nil {
+			m.Content = *data.Content
+		}
+		if data.Embeds != nil {
+			m.Embeds = *data.Embeds
+		}
+		return s.storeMessage(m)
+	})
+	s.handle("GET /channels/{channel}/messages/{message}", func(r *http.Request, body []byte) interface{} {
+		return s.message(r.PathValue("channel"), r.PathValue("message"))
+	})
+	s.handle("GET /channels/{channel}/messages", func(r *http.Request, body []byte) interface{} {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		messages := []*discordgo.Message{}
+		for _, m := range s.messages {
+			if m.ChannelID == r.PathValue("channel") {
+				messages = append(messages, m)
+			}
+		}
+		return messages
+	})
+	s.handle("DELETE /channels/{channel}/messages/{message}", func(r *http.Request, body []byte) interface{} {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		delete(s.messages, r.PathValue("message"))
+		return nil
+	})
+
+	// Channels
+	s.handle("GET /channels/{channel}", func(r *http.Request, body []byte) interface{} {
+		return s.channel(r.PathValue("channel"))
+	})
+	s.handle("PATCH /channels/{channel}", func(r *http.Request, body []byte) interface{} {
+		ch := s.channel(r.PathValue("channel"))
+		json.Unmarshal(body, ch)
+		return ch
+	})
+	s.handle("DELETE /channels/{channel}", func(r *http.Request, body []byte) interface{} {
+		return s.channel(r.PathValue("channel"))
+	})
+	s.handle("POST /channels/{channel}/typing", func(r *http.Request, body []byte) interface{} {
+		return nil
+	})
+
+	// Guilds
+	s.handle("GET /guilds/{guild}", func(r *http.Request, body []byte) interface{} {
+		return s.Guild
+	})
+	s.handle("GET /guilds/{guild}/channels", func(r *http.Request, body []byte) interface{} {
+		return s.Guild.Channels
+	})
+	s.handle("GET /guilds/{guild}/members", func(r *http.Request, body []byte) interface{} {
+		return []*discordgo.Member{{GuildID: r.PathValue("guild"), User: s.Bot}}
+	})
+	s.handle("GET /guilds/{guild}/members/{user}", func(r *http.Request, body []byte) interface{} {
+		return &discordgo.Member{GuildID: r.PathValue("guild"), User: &discordgo.User{ID: r.PathValue("user")}}
+	})
+	s.handle("GET /guilds/{guild}/roles", func(r *http.Request, body []byte) interface{} {
+		return []*discordgo.Role{}
+	})
+
+	// Users
+	s.handle("GET /users/{user}", func(r *http.Request, body []byte) interface{} {
+		if r.PathValue("user") == "@me" || r.PathValue("user") == s.Bot.ID {
+			return s.Bot
+		}
+		return &discordgo.User{ID: r.PathValue("user")}
+	})
+	s.handle("POST /users/@me/channels", func(r *http.Request, body []byte) interface{} {
+		var data struct {
+			RecipientID string `json:"recipient_id"`
+		}
+		json.Unmarshal(body, &data)
+		return &discordgo.Channel{ID: s.newID(), Type: discordgo.ChannelTypeDM, Recipients: []*discordgo.User{{ID: data.RecipientID}}}
+	})
+
+	// Interactions
+	s.handle("POST /interactions/{interaction}/{token}/callback", func(r *http.Request, body []byte) interface{} {
+		return nil
+	})
+	s.handle("PATCH /webhooks/{application}/{token}/messages/@original", func(r *http.Request, body []byte) interface{} {
+		var data discordgo.WebhookEdit
+		json.Unmarshal(body, &data)
+		m := &discordgo.Message{ID: s.newID(), ChannelID: s.Channel.ID}
+		if data.Content != nil {
+			m.Content = *data.Content
+		}
+		return s.storeMessage(m)
+	})
+
+	// Application commands
+	for _, prefix := range []string{"/applications/{application}/commands", "/applications/{application}/guilds/{guild}/commands"} {
+		s.handle("GET "+prefix, func(r *http.Request, body []byte) interface{} {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			return append([]*discordgo.ApplicationCommand{}, s.commands[r.PathValue("guild")]...)
+		})
+		s.handle("POST "+prefix, func(r *http.Request, body []byte) interface{} {
+			cmd := &discordgo.ApplicationCommand{}
+			json.Unmarshal(body, cmd)
+			return s.storeCommand(r.PathValue("application"), r.PathValue("guild"), cmd)
+		})
+		s.handle("PUT "+prefix, func(r *http.Request, body []byte) interface{} {
+			cmds := []*discordgo.ApplicationCommand{}
+			json.Unmarshal(body, &cmds)
+
+			s.mu.Lock()
+			delete(s.commands, r.PathValue("guild"))
+			s.mu.Unlock()
+
+			for _, cmd := range cmds {
+				s.storeCommand(r.PathValue("application"), r.PathValue("guild"), cmd)
+			}
+			return cmds
+		})
+		s.handle("PATCH "+prefix+"/{command}", func(r *http.Request, body []byte) interface{} {
+			cmd := &discordgo.ApplicationCommand{}
+			json.Unmarshal(body, cmd)
+			cmd.ID = r.PathValue("command")
+			return cmd
+		})
+		s.handle("DELETE "+prefix+"/{command}", func(r *http.Request, body []byte) interface{} {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			guildID := r.PathValue("guild")
+			cmds := s.commands[guildID][:0]
+			for _, cmd := range s.commands[guildID] {
+				if cmd.ID != r.PathValue("command") {
+					cmds = append(cmds, cmd)
+				}
+			}
+			s.commands[guildID] = cmds
+			return nil
+		})
+	}
+
+	// Reactions
+	s.handle("PUT /channels/{channel}/messages/{message}/reactions/{emoji}/@me", func(r *http.Request, body []byte) interface{} {
+		return nil
+	})
+	s.handle("DELETE /channels/{channel}/messages/{message}/reactions/{emoji}/{user}", func(r *http.Request, body []byte) interface{} {
+		return nil
+	})
+	s.handle("DELETE /channels/{channel}/messages/{message}/reactions", func(r *http.Request, body []byte) interface{} {
+		return nil
+	})
+
+	// Voice
+	s.handle("GET /voice/regions", func(r *http.Request, body []byte) interface{} {
+		return []*discordgo.VoiceRegion{}
+	})
+
+	// Webhooks
+	s.handle("POST /channels/{channel}/webhooks", func(r *http.Request, body []byte) interface{} {
+		wh := &discordgo.Webhook{}
+		json.Unmarshal(body, wh)
+		wh.ID, wh.ChannelID, wh.GuildID, wh.Token = s.newID(), r.PathValue("channel"), s.Guild.ID, "fake-"+s.newID()
+		return wh
+	})
+	s.handle("POST /webhooks/{webhook}/{token}", func(r *http.Request, body []byte) interface{} {
+		var data discordgo.WebhookParams
+		json.Unmarshal(body, &data)
+		return s.storeMessage(&discordgo.Message{ID: s.newID(), ChannelID: s.Channel.ID, Content: data.Content, Embeds: data.Embeds})
+	})
+}
+
+func (s *Server) wsURL() string {
+	return "ws" + strings.TrimPrefix(s.http.URL, "http") + "/gateway/"
+}
+
+// storeMessage fills in the server-side fields of a message sent by the bot, and stores it.
+func (s *Server) storeMessage(m *discordgo.Message) *discordgo.Message {
+	if m.ChannelID == s.Channel.ID {
+		m.GuildID = s.Guild.ID
+	}
+	m.Author = s.Bot
+	m.Timestamp = time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages[m.ID] = m
+	return m
+}
+
+// message returns a stored message, or a placeholder if it doesn't exist.
+func (s *Server) message(channelID, messageID string) *discordgo.Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if m, ok := s.messages[messageID]; ok {
+		return m
+	}
+	return &discordgo.Message{ID: messageID, ChannelID: channelID}
+}
+
+// channel returns the default channel, or a placeholder in the default guild.
+func (s *Server) channel(channelID string) *discordgo.Channel {
+	if channelID == s.Channel.ID {
+		return s.Channel
+	}
+	return &discordgo.Channel{ID: channelID, GuildID: s.Guild.ID, Type: discordgo.ChannelTypeGuildText}
+}
+
+func (s *Server) storeCommand(appID, guildID string, cmd *discordgo.ApplicationCommand) *discordgo.ApplicationCommand {
+	cmd.ID = s.newID()
+	cmd.ApplicationID = appID
+	cmd.GuildID = guildID
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.commands[guildID] = append(s.commands[guildID], cmd)
+	return cmd
+}
+
+// commandID returns the ID of the registered command name (in any guild), or a new ID.
+func (s *Server) commandID(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, cmds := range s.commands {
+		for _, cmd := range cmds {
+			if cmd.Name == name {
+				return cmd.ID
+			}
+		}
+	}
+	return s.newIDLocked()
+}
+
+func (s *Server) newID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.newIDLocked()
+}
+
+func (s *Server) newIDLocked() string {
+	s.nextID++
+	return strconv.FormatUint(s.nextID, 10)
+}
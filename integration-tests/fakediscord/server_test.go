@@ -0,0 +1,223 @@
+package fakediscord_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/thirdscam/chatanium-flexmodule/integration-tests/fakediscord"
+	discord "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+)
+
+func TestSessionAgainstFakeDiscord(t *testing.T) {
+	server := fakediscord.New()
+	defer server.Close()
+
+	runtime.OverrideEndpoints(server.URL(), "")
+
+	session, err := discordgo.New("Bot fake-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := make(chan *discordgo.Message, 1)
+	session.AddHandler(func(s *discordgo.Session, m *discordgo.MessageCreate) {
+		received <- m.Message
+	})
+
+	if err := session.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer session.Close()
+
+	if session.State.User == nil || session.State.User.ID != server.Bot.ID {
+		t.Fatalf("expected to be logged in as %s, got %+v", server.Bot.ID, session.State.User)
+	}
+
+	// Gateway -> session
+	user := &discordgo.User{ID: "1", Username: "alice"}
+	if _, err := server.MessageCreate(user, "ping"); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case m := <-received:
+		if m.Content != "ping" || m.Author.ID != user.ID {
+			t.Fatalf("unexpected message: %+v", m)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for MESSAGE_CREATE")
+	}
+
+	// Helper -> REST
+	helper := runtime.NewDiscordHelper(session)
+	sent, err := helper.ChannelMessageSend(server.Channel.ID, "pong")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sent.Content != "pong" || sent.Author.ID != server.Bot.ID {
+		t.Fatalf("unexpected sent message: %+v", sent)
+	}
+
+	req, err := server.WaitForRequest("POST", "/channels/"+server.Channel.ID+"/messages", time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var body discordgo.MessageSend
+	if err := json.Unmarshal(req.Body, &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Content != "pong" {
+		t.Fatalf("unexpected request body: %s", req.Body)
+	}
+
+	// Commands registered through REST are used for interactions
+	cmd, err := helper.ApplicationCommandCreate(server.Bot.ID, server.Guild.ID, &discordgo.ApplicationCommand{Name: "test", Description: "Test command"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	interaction, err := server.InteractionCreate(user, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if interaction.ApplicationCommandData().ID != cmd.ID {
+		t.Fatalf("expected command ID %s, got %s", cmd.ID, interaction.ApplicationCommandData().ID)
+	}
+}
+
+func TestCommandRegistrySync(t *testing.T) {
+	server := fakediscord.New()
+	defer server.Close()
+
+	runtime.OverrideEndpoints(server.URL(), "")
+
+	session, err := discordgo.New("Bot fake-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	appID, guildID := server.Bot.ID, server.Guild.ID
+
+	// Left by a module that isn't loaded anymore
+	if _, err := session.ApplicationCommandCreate(appID, guildID, &discordgo.ApplicationCommand{Name: "stale", Description: "Stale"}); err != nil {
+		t.Fatal(err)
+	}
+
+	registry := runtime.NewCommandRegistry()
+	play := &discordgo.ApplicationCommand{Name: "play", Description: "Play a song"}
+	if err := registry.Register("music", []*discordgo.ApplicationCommand{play}, []string{""}); err != nil {
+		t.Fatal(err)
+	}
+	err = registry.Register("games", []*discordgo.ApplicationCommand{
+		{Name: "play", Description: "Play a game"},
+		{Name: "roll", Description: "Roll a die"},
+		{Name: "play", Type: discordgo.UserApplicationCommand}, // Other command types have their own names
+	}, []string{guildID})
+	if err == nil {
+		t.Fatal("expected a conflict for /play")
+	}
+	if owner := registry.Owner(guildID, discordgo.ChatApplicationCommand, "play"); owner != "music" {
+		t.Fatalf("expected /play to stay with music, got %q", owner)
+	}
+	if owner := registry.Owner(guildID, discordgo.UserApplicationCommand, "play"); owner != "games" {
+		t.Fatalf("expected the user command play to be registered by games, got %q", owner)
+	}
+
+	if err := registry.Sync(session, appID); err != nil {
+		t.Fatal(err)
+	}
+	names := func(guildID string) []string {
+		commands, err := session.ApplicationCommands(appID, guildID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, command := range commands {
+			names = append(names, command.Name)
+		}
+		return names
+	}
+	if got := names(""); len(got) != 1 || got[0] != "play" {
+		t.Fatalf("unexpected global commands: %v", got)
+	}
+	if got := names(guildID); len(got) != 2 || got[0] != "play" || got[1] != "roll" {
+		t.Fatalf("unexpected guild commands: %v", got)
+	}
+
+	// Nothing changed, so nothing is overwritten
+	puts := func() int {
+		n := 0
+		for _, r := range server.Requests() {
+			if r.Method == "PUT" {
+				n++
+			}
+		}
+		return n
+	}
+	before := puts()
+	if err := registry.Sync(session, appID); err != nil {
+		t.Fatal(err)
+	}
+	if after := puts(); after != before {
+		t.Fatalf("expected no overwrite, got %d", after-before)
+	}
+
+	// The commands of an unloaded module are deleted
+	registry.Unregister("games")
+	if err := registry.Sync(session, appID); err != nil {
+		t.Fatal(err)
+	}
+	if got := names(guildID); len(got) != 0 {
+		t.Fatalf("unexpected guild commands: %v", got)
+	}
+
+	// A guild joined later is synced on its own once its commands are registered
+	if err := registry.Register("games", []*discordgo.ApplicationCommand{{Name: "roll", Description: "Roll a die"}}, []string{guildID}); err != nil {
+		t.Fatal(err)
+	}
+	if !registry.Registered("games", guildID) || registry.Synced(guildID) {
+		t.Fatal("expected the guild to be registered and not synced")
+	}
+	if err := registry.SyncGuild(session, appID, guildID); err != nil {
+		t.Fatal(err)
+	}
+	if !registry.Synced(guildID) {
+		t.Fatal("expected the guild to be synced")
+	}
+	if got := names(guildID); len(got) != 1 || got[0] != "roll" {
+		t.Fatalf("unexpected guild commands: %v", got)
+	}
+}
+
+func TestAutocompleteWithoutChoices(t *testing.T) {
+	server := fakediscord.New()
+	defer server.Close()
+
+	runtime.OverrideEndpoints(server.URL(), "")
+
+	session, err := discordgo.New("Bot fake-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	helper := runtime.NewDiscordHelper(session)
+
+	for n, resp := range []*discordgo.InteractionResponse{
+		discord.AutocompleteResponse(nil),
+		{Type: discordgo.InteractionApplicationCommandAutocompleteResult},
+		runtime.UnroutedResponse(&discordgo.Interaction{Type: discordgo.InteractionApplicationCommandAutocomplete}),
+	} {
+		id := strconv.Itoa(n)
+		if err := helper.InteractionRespond(&discordgo.Interaction{ID: id, Token: "token"}, resp); err != nil {
+			t.Fatal(err)
+		}
+		req, err := server.WaitForRequest("POST", "/interactions/"+id+"/token/callback", time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Discord rejects an autocomplete response without choices
+		if string(req.Body) != `{"type":8,"data":{"choices":[]}}` {
+			t.Errorf("got body %s", req.Body)
+		}
+	}
+}
@@ -60,7 +60,7 @@ func main() {
 	voiceHelper := discordRuntime.NewVoiceHelper(session, log)
 
 	// Create runtime plugin map
-	runtimePluginMap := shared.CreateRuntimePluginMap(discordHelper, voiceHelper)
+	runtimePluginMap := shared.CreateRuntimePluginMap(discordHelper, voiceHelper, discordRuntime.GuildScope{}, false, discord.Deadlines{}, discord.Deadlines{}, 0, nil)
 
 	// Launch plugin
 	client := plugin.NewClient(&plugin.ClientConfig{
@@ -1,17 +1,20 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/go-hclog"
-	"github.com/hashicorp/go-plugin"
-	"github.com/thirdscam/chatanium-flexmodule/shared"
+	proto "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
 	"github.com/thirdscam/chatanium-flexmodule/shared/core-v1"
 	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/convert/struct2buf"
 	discordRuntime "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
 
 	"github.com/joho/godotenv"
@@ -23,8 +26,6 @@ var (
 	log       hclog.Logger
 )
 
-var GUILD_ID string
-
 func main() {
 	log = hclog.New(&hclog.LoggerOptions{
 		Name:                 "Runtime",
@@ -34,16 +35,51 @@ func main() {
 	})
 
 	godotenv.Load("./private.env")
-	GUILD_ID = os.Getenv("GUILD_ID")
-	if GUILD_ID == "" {
-		log.Error("GUILD_ID is not set")
+
+	configPath := os.Getenv("CONFIG_PATH")
+	if configPath == "" {
+		configPath = "./config.json"
+	}
+	config, err := LoadConfig(configPath)
+	if err != nil {
+		log.Error("Error loading config", "path", configPath, "error", err.Error())
 		os.Exit(1)
 	}
 
+	statePath := os.Getenv("STATE_PATH")
+	if statePath == "" {
+		statePath = "./runtime-state.json"
+	}
+	state, err := LoadState(statePath)
+	if err != nil {
+		log.Warn("Error loading runtime state, starting without it", "path", statePath, "error", err.Error())
+	}
+
 	// We don't want to see the plugin logs.
 	// log.SetOutput(io.Discard)
 
-	log.Debug("starting up", "path", os.Getenv("PLUGIN_PATH"))
+	log.Debug("starting up", "modules", len(config.Modules))
+
+	// The console platform feeds the modules from stdin (or a script) instead of Discord,
+	// which is handy for developing modules without a bot token.
+	switch platform := os.Getenv("PLATFORM"); platform {
+	case "", "discord":
+		RunDiscord(config, state, statePath)
+	case "console":
+		RunConsole(config, state, statePath)
+	default:
+		log.Error("Unknown platform", "platform", platform)
+		os.Exit(1)
+	}
+}
+
+// RunDiscord serves the modules on Discord until the runtime is stopped.
+func RunDiscord(config *Config, state *RuntimeState, statePath string) {
+	// Point discordgo at a stand-in server (e.g. integration-tests/fakediscord) instead of Discord
+	if endpoint := os.Getenv("DISCORD_ENDPOINT"); endpoint != "" {
+		log.Warn("Using a custom Discord endpoint", "endpoint", endpoint)
+		discordRuntime.OverrideEndpoints(endpoint, os.Getenv("DISCORD_CDN_ENDPOINT"))
+	}
 
 	session, err := discordgo.New("Bot " + os.Getenv("DISCORD_TOKEN"))
 	if err != nil {
@@ -52,49 +88,124 @@ func main() {
 	}
 	dgSession = session
 
+	allowedIntents, err := discordRuntime.ParsePrivilegedIntents(config.PrivilegedIntents)
+	if err != nil {
+		log.Error("Invalid privileged_intents in the config", "error", err.Error())
+		os.Exit(1)
+	}
+
 	dgSession.AddHandler(func(s *discordgo.Session, r *discordgo.Ready) {
 		log.Info("Logged in", "username", s.State.User.Username, "discriminator", s.State.User.Discriminator)
 	})
 
-	dgSession.Open()
-
-	// Create Discord helper
-	discordHelper := discordRuntime.NewDiscordHelper(dgSession)
+	// Create Discord helper. The interaction responses of the modules go through the responder,
+	// which may have deferred them.
+	responder := discordRuntime.NewInteractionResponder(discordRuntime.NewDiscordHelper(dgSession))
+	discordHelper := responder.Helper()
 
 	// Create Voice helper
 	voiceHelper := discordRuntime.NewVoiceHelper(dgSession, log)
 
-	// Create runtime plugin map with Discord helper and voice helper
-	runtimePluginMap := shared.CreateRuntimePluginMap(discordHelper, voiceHelper)
+	// The modules are started before connecting, since the intents depend on their manifests.
+	// This also lets them receive the first READY and GUILD_CREATE events.
+	modules := StartModules(config, state, statePath, discordHelper, dgSession.State, voiceHelper)
+	PublishMetrics(modules)
+	commands := discordRuntime.NewCommandRegistry()
+	commands.RestoreRemote(state.CommandGuilds)
+	AddDiscordHandlers(modules, responder, commands, time.Duration(config.InteractionAckBudget))
+
+	dgSession.Identify.Intents = GatewayIntents(modules, allowedIntents)
+	if err := dgSession.Open(); err != nil {
+		log.Error("Error connecting to Discord", "error", err.Error())
+		os.Exit(1)
+	}
 
-	// We're a host. Start by launching the plugin process.
-	client := plugin.NewClient(&plugin.ClientConfig{
-		HandshakeConfig: shared.Handshake,
-		Plugins:         runtimePluginMap,
-		Cmd:             exec.Command("./bin/voice-player-module"),
-		Logger:          log.ResetNamed("Module").Named("TestModule"),
-		AllowedProtocols: []plugin.Protocol{
-			plugin.ProtocolGRPC,
-		},
-	})
-	defer client.Kill()
+	for _, module := range modules {
+		RegisterCommands(commands, module)
+	}
+	SyncCommands(commands, state, statePath)
 
-	// Connect via RPC
-	rpcClient, err := client.Client()
-	if err != nil {
-		log.Error("Error creating gRPC Client", "error", err.Error())
-		os.Exit(1)
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+	log.Info("Ready to serve. (press Ctrl+C to exit, send SIGHUP to reload the manifests and commands)")
+	for running := true; running; {
+		select {
+		case <-reload:
+			log.Info("Reloading the module manifests and commands")
+			Reload(modules, commands, allowedIntents, state, statePath)
+		case <-stop:
+			running = false
+		}
 	}
+	fmt.Println()
+	log.Info("Shutting down...")
 
-	RunCoreV1(rpcClient)
-	RunDiscordV1(rpcClient, discordHelper)
+	StopModules(modules, state, statePath)
 
 	dgSession.Close()
 }
 
-func RunCoreV1(client plugin.ClientProtocol) {
+// Reload applies the changes of the module manifests without restarting the modules: the
+// subscriptions (which are matched against the current manifests), and the gateway intents.
+// The commands returned by the modules at their start are registered and synced again, so the
+// ones edited or deleted on Discord are restored, and the scopes follow the guilds joined since.
+func Reload(modules []*Module, commands *discordRuntime.CommandRegistry, allowedIntents discordgo.Intent, state *RuntimeState, statePath string) {
+	ReloadManifests(modules)
+	UpdateIntents(GatewayIntents(modules, allowedIntents))
+
+	for _, module := range modules {
+		RegisterCommands(commands, module)
+	}
+	SyncCommands(commands, state, statePath)
+}
+
+// GatewayIntents returns the intents needed by the runtime and the modules.
+//
+// Privileged intents that aren't allowed by the config are left out, with a warning for
+// each module that needs them. Modules implementing hooks of privileged events without
+// subscribing to them are warned about too, since the intents come from the subscriptions.
+func GatewayIntents(modules []*Module, allowed discordgo.Intent) discordgo.Intent {
+	intents := discordRuntime.RuntimeIntents
+	for _, module := range modules {
+		needed := discordRuntime.Intents(module.Manifest())
+		if missing := needed & discordRuntime.PrivilegedIntents &^ allowed; missing != 0 {
+			log.Warn("Module needs privileged intents that are not in privileged_intents of the config",
+				"module", module.Path, "intents", discordRuntime.PrivilegedIntentNames(missing))
+		}
+		if hooks := discordRuntime.UnsubscribedHooks(module.Hooks, needed); len(hooks) > 0 {
+			log.Warn("Module implements hooks of privileged events it doesn't subscribe to, so they are never called",
+				"module", module.Path, "hooks", hooks)
+		}
+		intents |= needed
+	}
+	intents &^= discordRuntime.PrivilegedIntents &^ allowed
+
+	log.Debug("Discord", "intents", int(intents), "privileged", discordRuntime.PrivilegedIntentNames(intents))
+	return intents
+}
+
+// UpdateIntents reconnects to the gateway with the given intents, if they changed.
+//
+// discordgo tries to resume the previous session (with the previous intents) when reopened,
+// but Discord invalidates sessions closed normally, so discordgo identifies again instead.
+func UpdateIntents(intents discordgo.Intent) {
+	if dgSession.Identify.Intents == intents {
+		return
+	}
+
+	log.Info("Gateway intents changed, reconnecting", "from", int(dgSession.Identify.Intents), "to", int(intents))
+	dgSession.Identify.Intents = intents
+	dgSession.Close()
+	if err := dgSession.Open(); err != nil {
+		log.Error("Error reconnecting to Discord", "error", err.Error())
+	}
+}
+
+func RunCoreV1(module *Module) {
 	// Request the plugin
-	raw, err := client.Dispense("core-v1")
+	raw, err := module.RPC.Dispense("core-v1")
 	if err != nil {
 		log.Error("Core", "error", err.Error())
 		os.Exit(1)
@@ -113,6 +224,8 @@ func RunCoreV1(client plugin.ClientProtocol) {
 		os.Exit(1)
 	}
 	log.Debug("Core", "manifest", hclog.Fmt("%+v", manifest))
+	module.Core = hook
+	module.manifest.Store(&manifest)
 
 	status, err := hook.GetStatus()
 	if err != nil {
@@ -121,6 +234,12 @@ func RunCoreV1(client plugin.ClientProtocol) {
 	}
 	log.Debug("Core", "status", hclog.Fmt("%+v", status))
 
+	// A reattached module has already been initialized by the previous runtime.
+	if module.Reattached {
+		log.Debug("Core", "stage", "MODULE_INIT", "skipped", "reattached")
+		return
+	}
+
 	hook.OnStage("MODULE_INIT")
 	log.Debug("Core", "stage", "MODULE_INIT")
 
@@ -132,9 +251,9 @@ func RunCoreV1(client plugin.ClientProtocol) {
 	log.Debug("Core", "status", hclog.Fmt("%+v", status))
 }
 
-func RunDiscordV1(client plugin.ClientProtocol, discordHelper discord.Helper) {
+func RunDiscordV1(module *Module, discordHelper discord.Helper) {
 	// Request the plugin
-	raw, err := client.Dispense("discord-v1")
+	raw, err := module.RPC.Dispense("discord-v1")
 	if err != nil {
 		log.Error("Discord", "error", err.Error())
 		os.Exit(1)
@@ -147,42 +266,423 @@ func RunDiscordV1(client plugin.ClientProtocol, discordHelper discord.Helper) {
 		os.Exit(1)
 	}
 
-	// Get hook client to call module functions
-	hook := runtimeClients.GetHook()
+	// Get hook client to call module functions, until the module is stopped
+	module.Hook = discord.HookWithContext(runtimeClients.GetHook(), module.ctx)
 
 	// Use the runtime's Discord helper, not the module's helper client
-	resp := hook.OnInit(discordHelper)
+	resp := module.Hook.OnInit(discordHelper)
 	log.Debug("Discord", "initresp", hclog.Fmt("%+v", resp))
-	if len(resp.Interactions) != 0 {
-		for _, i := range resp.Interactions {
-			log.Debug("Discord", "interaction", hclog.Fmt("%+v", i))
-			_, err := dgSession.ApplicationCommandCreate(dgSession.State.User.ID, GUILD_ID, i)
-			if err != nil {
-				log.Error("Discord", "error", err.Error())
-				os.Exit(1)
-			}
+	module.Commands = resp.Interactions
+	module.Hooks = resp.Hooks
+}
+
+// RegisterCommands adds the module's commands to the registry: globally if the module is enabled
+// in every guild, otherwise in the guilds where it is enabled.
+func RegisterCommands(commands *discordRuntime.CommandRegistry, module *Module) {
+	guilds := []string{""}
+	if module.Scope.Restricted() {
+		guilds = module.Scope.EnabledGuilds(joinedGuilds())
+	}
+
+	for _, i := range module.Commands {
+		log.Debug("Discord", "interaction", hclog.Fmt("%+v", i), "guilds", guilds)
+	}
+	if err := commands.Register(module.Path, module.Commands, guilds); err != nil {
+		log.Warn("Some commands of the module conflict with other modules and were not registered", "path", module.Path, "error", err.Error())
+	}
+}
+
+// SyncCommands updates the commands registered on Discord to the ones of the registry, deleting
+// the commands of the modules that aren't loaded anymore. The guilds that have commands are saved
+// in the runtime state, so that the next runtime deletes them if their modules are gone.
+func SyncCommands(commands *discordRuntime.CommandRegistry, state *RuntimeState, statePath string) {
+	if err := commands.Sync(dgSession, dgSession.State.User.ID); err != nil {
+		log.Error("Error syncing the application commands", "error", err.Error())
+	}
+
+	state.CommandGuilds = commands.RemoteGuilds()
+	if err := state.Save(statePath); err != nil {
+		log.Warn("Error saving runtime state", "path", statePath, "error", err.Error())
+	}
+}
+
+// joinedGuilds returns the IDs of the guilds the bot is in.
+func joinedGuilds() []string {
+	dgSession.State.RLock()
+	defer dgSession.State.RUnlock()
+
+	joined := make([]string, 0, len(dgSession.State.Guilds))
+	for _, g := range dgSession.State.Guilds {
+		joined = append(joined, g.ID)
+	}
+	return joined
+}
+
+// hookedEvents are the gateway events with a dedicated hook (or handled by the runtime itself),
+// which are not sent to OnEvent.
+var hookedEvents = map[string]bool{
+	"READY":                         true,
+	"RESUMED":                       true,
+	"GUILD_CREATE":                  true,
+	"GUILD_UPDATE":                  true,
+	"GUILD_DELETE":                  true,
+	"GUILD_MEMBER_ADD":              true,
+	"GUILD_MEMBER_UPDATE":           true,
+	"GUILD_MEMBER_REMOVE":           true,
+	"GUILD_MEMBERS_CHUNK":           true,
+	"GUILD_BAN_ADD":                 true,
+	"GUILD_BAN_REMOVE":              true,
+	"MESSAGE_CREATE":                true,
+	"MESSAGE_UPDATE":                true,
+	"MESSAGE_DELETE":                true,
+	"MESSAGE_DELETE_BULK":           true,
+	"MESSAGE_REACTION_ADD":          true,
+	"MESSAGE_REACTION_REMOVE":       true,
+	"MESSAGE_REACTION_REMOVE_ALL":   true,
+	"MESSAGE_REACTION_REMOVE_EMOJI": true,
+	"VOICE_STATE_UPDATE":            true,
+	"VOICE_SERVER_UPDATE":           true, // Carries the voice token of the runtime
+	"INTERACTION_CREATE":            true,
+}
+
+// AddDiscordHandlers forwards Discord events to the modules that are enabled in the event's guild.
+//
+// The hooks are called from the event queue of each module (see Module.Dispatch), so that the
+// handlers return without waiting for the modules. The interactions that aren't answered within
+// ackBudget (if not zero) are deferred by responder. Each interaction goes to a single module (see
+// RouteInteraction), with commands having the owners of the application commands.
+func AddDiscordHandlers(modules []*Module, responder *discordRuntime.InteractionResponder, commands *discordRuntime.CommandRegistry, ackBudget time.Duration) {
+	// Recent messages in the state, to pass the previous version of edited and deleted messages
+	cacheSize := 100
+	if v := os.Getenv("MESSAGE_CACHE_SIZE"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			log.Warn("Invalid MESSAGE_CACHE_SIZE, using the default", "value", v, "default", cacheSize)
+		} else {
+			cacheSize = n
 		}
 	}
+	history := discordRuntime.TrackMessages(dgSession.State, cacheSize)
+
+	// Gateway connection events go to every module, regardless of its subscriptions
+	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.Ready) {
+		log.Debug("Discord", "type", "READY", "session", i.SessionID, "guilds", len(i.Guilds))
+		info := discordRuntime.EventInfo{Type: "READY"}
+		for _, module := range modules {
+			// Only tell the module about the guilds where it's enabled
+			ready := &discordgo.Ready{User: i.User, SessionID: i.SessionID}
+			for _, g := range i.Guilds {
+				if module.Scope.Enabled(g.ID) {
+					ready.Guilds = append(ready.Guilds, &discordgo.Guild{ID: g.ID, Unavailable: true})
+				}
+			}
+			module.Dispatch(info, func() { module.Hook.OnReady(ready) })
+		}
+	})
+
+	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.Resumed) {
+		log.Debug("Discord", "type", "RESUMED")
+		info := discordRuntime.EventInfo{Type: "RESUMED"}
+		for _, module := range modules {
+			module.Dispatch(info, func() { module.Hook.OnResumed() })
+		}
+	})
+
+	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.Disconnect) {
+		log.Debug("Discord", "type", "DISCONNECT")
+		info := discordRuntime.EventInfo{Type: "DISCONNECT"}
+		for _, module := range modules {
+			module.Dispatch(info, func() { module.Hook.OnDisconnect() })
+		}
+	})
+
+	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.GuildCreate) {
+		log.Debug("Discord", "type", "GUILD_CREATE", "guild", i.ID, "name", i.Name)
+		info := discordRuntime.EventInfo{Type: "GUILD_CREATE", GuildID: i.ID}
+		guild := *i.Guild // The state keeps i.Guild and updates it, while the modules read it
+		for _, module := range modules {
+			if module.Wants(info) {
+				module.Dispatch(info, func() { module.Hook.OnCreateGuild(&guild) })
+			}
+		}
+
+		// Restricted modules register their commands by guild, so the guilds joined after the
+		// start (or unavailable until now) get them here
+		for _, module := range modules {
+			if module.Scope.Restricted() && module.Scope.Enabled(i.ID) && len(module.Commands) > 0 && !commands.Registered(module.Path, i.ID) {
+				RegisterCommands(commands, module)
+			}
+		}
+		if len(commands.Commands(i.ID)) > 0 && !commands.Synced(i.ID) {
+			if err := commands.SyncGuild(s, s.State.User.ID, i.ID); err != nil {
+				log.Error("Error syncing the application commands", "guild", i.ID, "error", err.Error())
+			}
+		}
+	})
+
+	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.GuildUpdate) {
+		log.Debug("Discord", "type", "GUILD_UPDATE", "guild", i.ID, "name", i.Name)
+		info := discordRuntime.EventInfo{Type: "GUILD_UPDATE", GuildID: i.ID}
+		guild := *i.Guild // The state keeps i.Guild and updates it, while the modules read it
+		for _, module := range modules {
+			if module.Wants(info) {
+				module.Dispatch(info, func() { module.Hook.OnUpdateGuild(&guild) })
+			}
+		}
+	})
+
+	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.GuildDelete) {
+		log.Debug("Discord", "type", "GUILD_DELETE", "guild", i.ID, "unavailable", i.Unavailable)
+		info := discordRuntime.EventInfo{Type: "GUILD_DELETE", GuildID: i.ID}
+		for _, module := range modules {
+			if module.Wants(info) {
+				module.Dispatch(info, func() { module.Hook.OnDeleteGuild(i.Guild, i.BeforeDelete) })
+			}
+		}
+	})
 
 	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.MessageCreate) {
 		log.Debug("Discord", "type", "MESSAGE_CREATE", "message", hclog.Fmt("%+v", i.Message))
-		hook.OnCreateChatMessage(i.Message)
+		history.Track(i.Message)
+		info := discordRuntime.MessageEventInfo("MESSAGE_CREATE", i.Message)
+		message := *i.Message // The state keeps i.Message and updates it, while the modules read it
+		for _, module := range modules {
+			if module.Wants(info) {
+				module.Dispatch(info, func() { module.Hook.OnCreateChatMessage(module.Namespace.Message(&message)) })
+			}
+		}
+	})
+
+	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.MessageUpdate) {
+		log.Debug("Discord", "type", "MESSAGE_UPDATE", "message", hclog.Fmt("%+v", i.Message))
+		discordRuntime.UpdateMessage(s.State, i)
+		history.Track(i.Message)
+		info := discordRuntime.MessageEventInfo("MESSAGE_UPDATE", i.Message)
+		if i.Author == nil && i.BeforeUpdate != nil {
+			info.Content = i.BeforeUpdate.Content // For prefix subscriptions, as partial updates have no content
+		}
+		message := *i.Message // The state may keep i.Message, as for MESSAGE_CREATE
+		for _, module := range modules {
+			if module.Wants(info) {
+				module.Dispatch(info, func() {
+					module.Hook.OnUpdateChatMessage(module.Namespace.Message(&message), module.Namespace.Message(i.BeforeUpdate))
+				})
+			}
+		}
 	})
 
 	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.MessageDelete) {
-		log.Debug("Discord", "type", "MESSAGE_CREATE", "message", hclog.Fmt("%+v", i.Message))
-		hook.OnCreateChatMessage(i.Message)
+		log.Debug("Discord", "type", "MESSAGE_DELETE", "message", hclog.Fmt("%+v", i.Message))
+		history.Forget(i.ChannelID, i.ID)
+		before := i.BeforeDelete
+		info := discordRuntime.MessageEventInfo("MESSAGE_DELETE", i.Message)
+		if before != nil {
+			info.Content = before.Content // For prefix subscriptions
+		}
+		for _, module := range modules {
+			if module.Wants(info) {
+				module.Dispatch(info, func() { module.Hook.OnDeleteChatMessage(i.Message, module.Namespace.Message(before)) })
+			}
+		}
+	})
+
+	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.MessageDeleteBulk) {
+		log.Debug("Discord", "type", "MESSAGE_DELETE_BULK", "messages", i.Messages, "channel", i.ChannelID)
+		info := discordRuntime.EventInfo{Type: "MESSAGE_DELETE_BULK", GuildID: i.GuildID, ChannelID: i.ChannelID}
+		before := history.BulkDelete(i) // The state already removed the messages
+		for _, module := range modules {
+			if module.Wants(info) {
+				module.Dispatch(info, func() { module.Hook.OnBulkDeleteChatMessages(i, module.Namespace.Messages(before)) })
+			}
+		}
+	})
+
+	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.GuildMemberAdd) {
+		log.Debug("Discord", "type", "GUILD_MEMBER_ADD", "member", hclog.Fmt("%+v", i.Member))
+		info := discordRuntime.EventInfo{Type: "GUILD_MEMBER_ADD", GuildID: i.GuildID}
+		member := *i.Member // The state keeps i.Member and updates it, while the modules read it
+		for _, module := range modules {
+			if module.Wants(info) {
+				module.Dispatch(info, func() { module.Hook.OnAddGuildMember(&member) })
+			}
+		}
+	})
+
+	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.GuildMemberRemove) {
+		log.Debug("Discord", "type", "GUILD_MEMBER_REMOVE", "member", hclog.Fmt("%+v", i.Member))
+		info := discordRuntime.EventInfo{Type: "GUILD_MEMBER_REMOVE", GuildID: i.GuildID}
+		for _, module := range modules {
+			if module.Wants(info) {
+				module.Dispatch(info, func() { module.Hook.OnRemoveGuildMember(i.Member) })
+			}
+		}
+	})
+
+	// The state provides the previous version of updated members (it tracks members by default)
+	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.GuildMemberUpdate) {
+		log.Debug("Discord", "type", "GUILD_MEMBER_UPDATE", "member", hclog.Fmt("%+v", i.Member))
+		info := discordRuntime.EventInfo{Type: "GUILD_MEMBER_UPDATE", GuildID: i.GuildID}
+		member := *i.Member // The state keeps i.Member and updates it, while the modules read it
+		for _, module := range modules {
+			if module.Wants(info) {
+				module.Dispatch(info, func() { module.Hook.OnUpdateGuildMember(&member, i.BeforeUpdate) })
+			}
+		}
+	})
+
+	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.GuildBanAdd) {
+		log.Debug("Discord", "type", "GUILD_BAN_ADD", "guild", i.GuildID, "user", hclog.Fmt("%+v", i.User))
+		info := discordRuntime.EventInfo{Type: "GUILD_BAN_ADD", GuildID: i.GuildID}
+		for _, module := range modules {
+			if module.Wants(info) {
+				module.Dispatch(info, func() { module.Hook.OnAddGuildBan(i) })
+			}
+		}
+	})
+
+	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.GuildBanRemove) {
+		log.Debug("Discord", "type", "GUILD_BAN_REMOVE", "guild", i.GuildID, "user", hclog.Fmt("%+v", i.User))
+		info := discordRuntime.EventInfo{Type: "GUILD_BAN_REMOVE", GuildID: i.GuildID}
+		for _, module := range modules {
+			if module.Wants(info) {
+				module.Dispatch(info, func() { module.Hook.OnRemoveGuildBan(i) })
+			}
+		}
+	})
+
+	// The state provides the previous voice state (it tracks voice states by default)
+	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.VoiceStateUpdate) {
+		log.Debug("Discord", "type", "VOICE_STATE_UPDATE", "state", hclog.Fmt("%+v", i.VoiceState))
+		info := discordRuntime.EventInfo{Type: "VOICE_STATE_UPDATE", GuildID: i.GuildID, ChannelID: i.ChannelID}
+		for _, module := range modules {
+			if module.Wants(info) {
+				module.Dispatch(info, func() { module.Hook.OnVoiceStateUpdate(i.VoiceState, i.BeforeUpdate) })
+			}
+		}
+	})
+
+	// Reaction events are only sent to the modules that requested them (see discord.EventPermissions)
+	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.MessageReactionAdd) {
+		log.Debug("Discord", "type", "MESSAGE_REACTION_ADD", "reaction", hclog.Fmt("%+v", i.MessageReaction))
+		info := discordRuntime.EventInfo{Type: "MESSAGE_REACTION_ADD", GuildID: i.GuildID, ChannelID: i.ChannelID}
+		for _, module := range modules {
+			if module.Wants(info) {
+				module.Dispatch(info, func() { module.Hook.OnAddReaction(i) })
+			}
+		}
+	})
+
+	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.MessageReactionRemove) {
+		log.Debug("Discord", "type", "MESSAGE_REACTION_REMOVE", "reaction", hclog.Fmt("%+v", i.MessageReaction))
+		info := discordRuntime.EventInfo{Type: "MESSAGE_REACTION_REMOVE", GuildID: i.GuildID, ChannelID: i.ChannelID}
+		for _, module := range modules {
+			if module.Wants(info) {
+				module.Dispatch(info, func() { module.Hook.OnRemoveReaction(i) })
+			}
+		}
+	})
+
+	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.MessageReactionRemoveAll) {
+		log.Debug("Discord", "type", "MESSAGE_REACTION_REMOVE_ALL", "reaction", hclog.Fmt("%+v", i.MessageReaction))
+		info := discordRuntime.EventInfo{Type: "MESSAGE_REACTION_REMOVE_ALL", GuildID: i.GuildID, ChannelID: i.ChannelID}
+		for _, module := range modules {
+			if module.Wants(info) {
+				module.Dispatch(info, func() { module.Hook.OnRemoveAllReactions(i) })
+			}
+		}
+	})
+
+	// discordgo has no typed event for MESSAGE_REACTION_REMOVE_EMOJI, so decode the raw event
+	dgSession.AddHandler(func(s *discordgo.Session, e *discordgo.Event) {
+		if e.Type != "MESSAGE_REACTION_REMOVE_EMOJI" {
+			return
+		}
+
+		reaction := &discordgo.MessageReaction{}
+		if err := json.Unmarshal(e.RawData, reaction); err != nil {
+			log.Warn("Discord", "type", e.Type, "error", err.Error())
+			return
+		}
+
+		log.Debug("Discord", "type", e.Type, "reaction", hclog.Fmt("%+v", reaction))
+		info := discordRuntime.EventInfo{Type: e.Type, GuildID: reaction.GuildID, ChannelID: reaction.ChannelID}
+		for _, module := range modules {
+			if module.Wants(info) {
+				module.Dispatch(info, func() { module.Hook.OnRemoveEmojiReactions(reaction) })
+			}
+		}
 	})
 
 	dgSession.AddHandler(func(s *discordgo.Session, i *discordgo.InteractionCreate) {
 		log.Debug("Discord", "type", "INTERACTION_CREATE", "interaction", hclog.Fmt("%+v", i.Interaction))
-		hook.OnCreateInteraction(i.Interaction)
+		info := discordRuntime.InteractionEventInfo(i.Interaction)
+
+		// The budget includes the time spent in the queues
+		var deadline time.Time
+		if ackBudget > 0 {
+			deadline = time.Now().Add(ackBudget)
+		}
+
+		// A single module handles each interaction, the others would fail to respond anyway
+		module, routed := RouteInteraction(modules, commands, i.Interaction)
+		if module == nil {
+			log.Debug("No module handles the interaction", "interaction", i.ID, "type", i.Type.String())
+			err := responder.Handle(i.Interaction, deadline, func() (*discordgo.InteractionResponse, error) {
+				return discordRuntime.UnroutedResponse(i.Interaction), nil
+			})
+			if err != nil {
+				log.Warn("Error responding to unrouted interaction", "interaction", i.ID, "error", err.Error())
+			}
+			return
+		}
+
+		// The deferral starts now, however long the interaction waits in the queue of the module
+		pending := responder.Start(i.Interaction, deadline)
+		module.DispatchDroppable(info, func() {
+			err := pending.Handle(func() (*discordgo.InteractionResponse, error) {
+				resp, err := module.Hook.OnCreateInteraction(routed)
+				if err != nil {
+					return nil, err
+				}
+				return module.Namespace.Response(resp)
+			})
+			if err != nil {
+				log.Warn("Error handling interaction", "path", module.Path, "interaction", i.ID, "error", err.Error())
+			}
+		}, func() {
+			// The queue of the module is full, so the user is told instead of waiting for a timeout
+			if err := pending.Drop(); err != nil {
+				log.Warn("Error answering dropped interaction", "path", module.Path, "interaction", i.ID, "error", err.Error())
+			}
+		})
 	})
 
-	stop := make(chan os.Signal, 1)
-	signal.Notify(stop, os.Interrupt)
-	log.Info("Ready to serve. (press Ctrl+C to exit)")
-	<-stop
-	fmt.Println()
-	log.Info("Shutting down...")
+	// Every other event goes to OnEvent, typed when the proto models it and raw otherwise
+	dgSession.AddHandler(func(s *discordgo.Session, e *discordgo.Event) {
+		if hookedEvents[e.Type] {
+			return
+		}
+
+		info := discordRuntime.RawEventInfo(e)
+		log.Debug("Discord", "type", e.Type, "guild", info.GuildID)
+		var buf *proto.GatewayEvent // Converted once for all the modules
+		for _, module := range modules {
+			if module.Wants(info) {
+				if buf == nil {
+					buf = struct2buf.GatewayEvent(e)
+				}
+				module.Dispatch(info, func() {
+					var err error
+					if hook, ok := module.Hook.(discordRuntime.GatewayEventHook); ok {
+						err = hook.OnGatewayEvent(buf)
+					} else {
+						err = module.Hook.OnEvent(e)
+					}
+					if err != nil {
+						log.Warn("Error sending event to module", "path", module.Path, "type", e.Type, "error", err.Error())
+					}
+				})
+			}
+		}
+	})
 }
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"expvar"
+	"net/http"
+	"os"
+
+	discordRuntime "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+)
+
+// PublishMetrics publishes the event queue counters of every module (keyed by path) as the
+// "module_queues" expvar. If METRICS_ADDR is set, the expvars are served there at /debug/vars.
+func PublishMetrics(modules []*Module) {
+	expvar.Publish("module_queues", expvar.Func(func() any {
+		stats := make(map[string]discordRuntime.QueueStats, len(modules))
+		for _, module := range modules {
+			stats[module.Path] = module.Events.Stats()
+		}
+		return stats
+	}))
+
+	addr := os.Getenv("METRICS_ADDR")
+	if addr == "" {
+		return
+	}
+	go func() {
+		log.Info("Serving metrics", "addr", addr, "path", "/debug/vars")
+		if err := http.ListenAndServe(addr, nil); err != nil {
+			log.Warn("Error serving metrics", "addr", addr, "error", err.Error())
+		}
+	}()
+}
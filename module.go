@@ -0,0 +1,279 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/go-plugin"
+	"github.com/thirdscam/chatanium-flexmodule/shared"
+	"github.com/thirdscam/chatanium-flexmodule/shared/core-v1"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+	discordRuntime "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+)
+
+// Module is a module process connected to the runtime.
+type Module struct {
+	Path      string                    // Path to the module executable (also the key in the runtime state)
+	Scope     discordRuntime.GuildScope // Guilds where the module is enabled
+	Namespace discordRuntime.Namespace  // Custom ID namespace of the module's components
+
+	Events *discordRuntime.EventQueue // Queue of the hook calls, see Dispatch
+
+	// Context of the hook calls, cancelled by StopModules (along with the Helper calls they make)
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	Client     *plugin.Client
+	RPC        plugin.ClientProtocol
+	Reattached bool // Whether the module was started by a previous runtime
+
+	Core     core.Hook                     // Set by RunCoreV1
+	manifest atomic.Pointer[core.Manifest] // Set by RunCoreV1, replaced by ReloadManifests
+
+	Hook     discord.Hook                    // Set by RunDiscordV1, bound to ctx
+	Commands []*discordgo.ApplicationCommand // Commands returned from OnInit, set by RunDiscordV1
+	Hooks    []string                        // Hooks of privileged events implemented by the module, set by RunDiscordV1
+}
+
+// Manifest returns the last manifest received from the module.
+func (m *Module) Manifest() core.Manifest {
+	if manifest := m.manifest.Load(); manifest != nil {
+		return *manifest
+	}
+	return core.Manifest{}
+}
+
+// Wants reports whether an event should be forwarded to the module: it must be enabled in the guild
+// of the event, subscribed to the event, and have its permission (see discord.EventPermissions) if any.
+func (m *Module) Wants(e discordRuntime.EventInfo) bool {
+	manifest := m.Manifest()
+	if permission, ok := discord.EventPermissions[e.Type]; ok && !manifest.Permissions.Has(permission) {
+		return false
+	}
+	return m.Scope.Enabled(e.GuildID) && discordRuntime.Subscriptions(manifest.Subscriptions).Match(e)
+}
+
+// Dispatch queues a hook call for the event. Calls for the same channel (or the same guild, for
+// events without a channel) are made in order.
+func (m *Module) Dispatch(e discordRuntime.EventInfo, hook func()) {
+	m.DispatchDroppable(e, hook, nil)
+}
+
+// DispatchDroppable queues a hook call like Dispatch, and calls dropped instead if the queue drops
+// it (e.g. to answer an interaction, which would fail otherwise).
+func (m *Module) DispatchDroppable(e discordRuntime.EventInfo, hook, dropped func()) {
+	key := e.ChannelID
+	if key == "" {
+		key = e.GuildID
+	}
+	switch m.Events.PushDroppable(key, hook, dropped) {
+	case discordRuntime.PushDroppedOldest:
+		log.Debug("Module event queue is full, dropped its oldest event", "path", m.Path, "type", e.Type)
+	case discordRuntime.PushDropped:
+		log.Debug("Module event queue is full, dropped the event", "path", m.Path, "type", e.Type)
+	}
+}
+
+// RouteInteraction returns the module that handles an interaction, with the interaction as the
+// module sees it, or nil if no module handles it.
+//
+// Application commands (and their autocompletes) go to the module that registered the command in
+// commands, components and modals to the module of their custom ID namespace.
+func RouteInteraction(modules []*Module, commands *discordRuntime.CommandRegistry, i *discordgo.Interaction) (*Module, *discordgo.Interaction) {
+	var route func(*Module) bool
+	switch data := i.Data.(type) {
+	case discordgo.ApplicationCommandInteractionData:
+		owner := commands.Owner(i.GuildID, data.CommandType, data.Name)
+		route = func(m *Module) bool { return m.Path == owner }
+	case discordgo.MessageComponentInteractionData:
+		namespace, _, _ := discordRuntime.SplitCustomID(data.CustomID)
+		route = func(m *Module) bool { return m.Namespace == namespace }
+	case discordgo.ModalSubmitInteractionData:
+		namespace, _, _ := discordRuntime.SplitCustomID(data.CustomID)
+		route = func(m *Module) bool { return m.Namespace == namespace }
+	default:
+		return nil, nil
+	}
+
+	for _, module := range modules {
+		if !route(module) {
+			continue
+		}
+		// The subscriptions of the module match the custom IDs without the namespace
+		routed := module.Namespace.Interaction(i)
+		if module.Wants(discordRuntime.InteractionEventInfo(routed)) {
+			return module, routed
+		}
+	}
+	return nil, nil
+}
+
+// StartModules starts (or reattaches to) every configured module and initializes them.
+//
+// Each module gets its own view of helper that is limited to its guild scope, and namespaces the
+// custom IDs of its components. channelState is used to resolve the guild of channels for that
+// and may be nil.
+func StartModules(config *Config, state *RuntimeState, statePath string, helper discord.Helper, channelState *discordgo.State, voiceHelper *discordRuntime.VoiceHelper) []*Module {
+	// The attachments downloaded by the modules are cached once for all of them
+	cacheDir := config.AttachmentCacheDir
+	if cacheDir == "" {
+		cacheDir = DefaultAttachmentCacheDir
+	}
+	cache, err := discordRuntime.NewAttachmentCache(cacheDir, config.AttachmentCacheSize)
+	if err != nil {
+		log.Warn("Error creating the attachment cache, downloading without it", "dir", cacheDir, "error", err.Error())
+	}
+
+	modules := make([]*Module, 0, len(config.Modules))
+	for _, moduleConfig := range config.Modules {
+		// Each module can only touch the guilds where it's enabled
+		scoped := discordRuntime.NewScopedHelper(helper, channelState, moduleConfig.Guilds)
+		scoped = discordRuntime.NewNamespacedHelper(scoped, moduleConfig.CustomIDNamespace())
+
+		// Reattach to the module if it survived a previous runtime, otherwise launch it.
+		downloads := &discordRuntime.Downloader{
+			Cache:        cache,
+			MaxSize:      moduleConfig.Downloads.MaxSize,
+			ContentTypes: moduleConfig.Downloads.ContentTypes,
+		}
+		module := StartModule(moduleConfig, state, scoped, voiceHelper, downloads)
+		modules = append(modules, module)
+
+		state.Modules[module.Path] = NewModuleState(module.Client.ReattachConfig())
+		if err := state.Save(statePath); err != nil {
+			log.Warn("Error saving runtime state", "path", statePath, "error", err.Error())
+		}
+
+		RunCoreV1(module)
+		RunDiscordV1(module, scoped)
+	}
+	return modules
+}
+
+// ReloadManifests gets the manifest of every module again (e.g. after a module changed its subscriptions).
+// A module whose manifest can't be reloaded keeps its previous one.
+func ReloadManifests(modules []*Module) {
+	for _, module := range modules {
+		manifest, err := module.Core.GetManifest()
+		if err != nil {
+			log.Warn("Error reloading manifest, keeping the previous one", "path", module.Path, "error", err.Error())
+			continue
+		}
+		module.manifest.Store(&manifest)
+	}
+}
+
+// queueCloseTimeout bounds the time that StopModules waits for the modules to handle their queued events.
+const queueCloseTimeout = 10 * time.Second
+
+// StopModules kills the modules, unless DETACH_MODULES is "true".
+//
+// Detached modules keep running, so that the next runtime can reattach to them. This is useful
+// for upgrading the runtime without restarting modules with expensive warm-up.
+func StopModules(modules []*Module, state *RuntimeState, statePath string) {
+	detach := os.Getenv("DETACH_MODULES") == "true"
+
+	// Let the modules handle the events that are already queued, if they don't take too long
+	var wg sync.WaitGroup
+	for _, module := range modules {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !module.Events.Close(queueCloseTimeout) {
+				log.Warn("Module didn't handle its queued events in time, dropped them", "path", module.Path, "timeout", queueCloseTimeout)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, module := range modules {
+		// Cancel the hooks that are still running
+		module.cancel()
+
+		if detach {
+			log.Info("Detaching from module", "path", module.Path, "pid", state.Modules[module.Path].Pid)
+			continue
+		}
+
+		module.Client.Kill()
+		delete(state.Modules, module.Path)
+	}
+	if err := state.Save(statePath); err != nil {
+		log.Warn("Error saving runtime state", "path", statePath, "error", err.Error())
+	}
+}
+
+// StartModule connects to the module configured by config.
+//
+// If the state has reattach information for the module and it is still alive, the runtime
+// reattaches to it (Module.Reattached is true). Otherwise the module process is launched.
+// downloads downloads the attachments requested by the module.
+func StartModule(config ModuleConfig, state *RuntimeState, discordHelper discord.Helper, voiceHelper *discordRuntime.VoiceHelper, downloads *discordRuntime.Downloader) *Module {
+	logger := log.ResetNamed("Module").Named(filepath.Base(config.Path))
+	module := &Module{
+		Path:      config.Path,
+		Scope:     config.Guilds,
+		Namespace: config.CustomIDNamespace(),
+		Events:    discordRuntime.NewEventQueue(config.Queue),
+	}
+	module.ctx, module.cancel = context.WithCancel(context.Background())
+	hookDeadlines := config.HookDeadlines.Deadlines(DefaultHookDeadlines)
+	helperDeadlines := config.HelperDeadlines.Deadlines(DefaultHelperDeadlines)
+
+	if saved, ok := state.Modules[config.Path]; ok {
+		reattach, err := saved.ReattachConfig()
+		if err == nil {
+			client := plugin.NewClient(&plugin.ClientConfig{
+				HandshakeConfig: shared.Handshake,
+				Plugins:         shared.CreateRuntimePluginMap(discordHelper, voiceHelper, config.Guilds, true, hookDeadlines, helperDeadlines, config.MaxUploadSize, downloads),
+				Reattach:        reattach,
+				Logger:          logger,
+				AllowedProtocols: []plugin.Protocol{
+					plugin.ProtocolGRPC,
+				},
+			})
+
+			var rpcClient plugin.ClientProtocol
+			rpcClient, err = client.Client()
+			if err == nil {
+				err = rpcClient.Ping()
+			}
+			if err == nil {
+				log.Info("Reattached to running module", "path", config.Path, "pid", saved.Pid)
+				module.Client, module.RPC, module.Reattached = client, rpcClient, true
+				return module
+			}
+		}
+
+		// The module is gone (or isn't ours anymore), so never kill it here.
+		log.Warn("Error reattaching to module, launching a new one", "path", config.Path, "pid", saved.Pid, "error", err.Error())
+		delete(state.Modules, config.Path)
+	}
+
+	// We're a host. Start by launching the plugin process.
+	client := plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig: shared.Handshake,
+		Plugins:         shared.CreateRuntimePluginMap(discordHelper, voiceHelper, config.Guilds, false, hookDeadlines, helperDeadlines, config.MaxUploadSize, downloads),
+		Cmd:             exec.Command(config.Path),
+		Logger:          logger,
+		AllowedProtocols: []plugin.Protocol{
+			plugin.ProtocolGRPC,
+		},
+	})
+
+	// Connect via RPC
+	rpcClient, err := client.Client()
+	if err != nil {
+		log.Error("Error creating gRPC Client", "path", config.Path, "error", err.Error())
+		os.Exit(1)
+	}
+
+	module.Client, module.RPC = client, rpcClient
+	return module
+}
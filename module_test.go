@@ -0,0 +1,94 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/thirdscam/chatanium-flexmodule/shared/core-v1"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+	discordRuntime "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+)
+
+func TestRouteInteraction(t *testing.T) {
+	a := &Module{Path: "a", Namespace: "a"}
+	b := &Module{Path: "b", Namespace: "b", Scope: discordRuntime.GuildScope{Deny: []string{"G2"}}}
+	modules := []*Module{a, b}
+
+	commands := discordRuntime.NewCommandRegistry()
+	if err := commands.Register("a", []*discordgo.ApplicationCommand{{Name: "ping"}}, []string{""}); err != nil {
+		t.Fatal(err)
+	}
+	if err := commands.Register("b", []*discordgo.ApplicationCommand{{Name: "vote"}}, []string{""}); err != nil {
+		t.Fatal(err)
+	}
+
+	component := func(guildID, customID string) *discordgo.Interaction {
+		return &discordgo.Interaction{
+			Type:    discordgo.InteractionMessageComponent,
+			GuildID: guildID,
+			Data:    discordgo.MessageComponentInteractionData{CustomID: customID},
+		}
+	}
+	command := func(guildID, name string) *discordgo.Interaction {
+		return &discordgo.Interaction{
+			Type:    discordgo.InteractionApplicationCommand,
+			GuildID: guildID,
+			Data:    discordgo.ApplicationCommandInteractionData{Name: name, CommandType: discordgo.ChatApplicationCommand},
+		}
+	}
+
+	for _, test := range []struct {
+		name     string
+		i        *discordgo.Interaction
+		want     *Module
+		customID string
+	}{
+		{name: "command", i: command("G1", "ping"), want: a},
+		{name: "command of another module", i: command("G1", "vote"), want: b},
+		{name: "unknown command", i: command("G1", "unknown")},
+		{name: "command out of scope", i: command("G2", "vote")},
+		{name: "component", i: component("G1", "b:vote:yes"), want: b, customID: "vote:yes"},
+		{name: "component of an unknown namespace", i: component("G1", "c:vote")},
+		{name: "component without namespace", i: component("G1", "vote")},
+		{name: "component out of scope", i: component("G2", "b:vote")},
+		{name: "ping", i: &discordgo.Interaction{Type: discordgo.InteractionPing}},
+	} {
+		module, routed := RouteInteraction(modules, commands, test.i)
+		if module != test.want {
+			t.Errorf("%s: routed to %v, want %v", test.name, module, test.want)
+			continue
+		}
+		if module == nil {
+			continue
+		}
+		if data, ok := routed.Data.(discordgo.MessageComponentInteractionData); ok && data.CustomID != test.customID {
+			t.Errorf("%s: got custom ID %q, want %q", test.name, data.CustomID, test.customID)
+		}
+	}
+}
+
+func TestModuleWants(t *testing.T) {
+	voice := &Module{Path: "voice", Scope: discordRuntime.GuildScope{Allow: []string{"G1"}}}
+	voice.manifest.Store(&core.Manifest{Permissions: core.Permissions{discord.PermissionVoiceState}})
+	messages := &Module{Path: "messages"}
+	messages.manifest.Store(&core.Manifest{Subscriptions: []core.Subscription{{Event: "MESSAGE_CREATE"}, {Event: "VOICE_STATE_UPDATE"}}})
+
+	for _, test := range []struct {
+		name   string
+		module *Module
+		e      discordRuntime.EventInfo
+		want   bool
+	}{
+		{name: "voice state", module: voice, e: discordRuntime.EventInfo{Type: "VOICE_STATE_UPDATE", GuildID: "G1", ChannelID: "V1"}, want: true},
+		{name: "voice state out of scope", module: voice, e: discordRuntime.EventInfo{Type: "VOICE_STATE_UPDATE", GuildID: "G2", ChannelID: "V2"}},
+		{name: "reaction without permission", module: voice, e: discordRuntime.EventInfo{Type: "MESSAGE_REACTION_ADD", GuildID: "G1", ChannelID: "C1"}},
+		{name: "message", module: voice, e: discordRuntime.EventInfo{Type: "MESSAGE_CREATE", GuildID: "G1", ChannelID: "C1"}, want: true},
+		{name: "subscribed voice state without permission", module: messages, e: discordRuntime.EventInfo{Type: "VOICE_STATE_UPDATE", GuildID: "G1", ChannelID: "V1"}},
+		{name: "subscribed message", module: messages, e: discordRuntime.EventInfo{Type: "MESSAGE_CREATE", GuildID: "G1", ChannelID: "C1"}, want: true},
+		{name: "unsubscribed message update", module: messages, e: discordRuntime.EventInfo{Type: "MESSAGE_UPDATE", GuildID: "G1", ChannelID: "C1"}},
+	} {
+		if got := test.module.Wants(test.e); got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
@@ -29,6 +29,7 @@ type GetManifestResponse struct {
 	Author        string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
 	Repository    string                 `protobuf:"bytes,4,opt,name=repository,proto3" json:"repository,omitempty"`
 	Permissions   []string               `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Subscriptions []*Subscription        `protobuf:"bytes,6,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,6 +99,98 @@ func (x *GetManifestResponse) GetPermissions() []string {
 	return nil
 }
 
+func (x *GetManifestResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+// Subscription declares events the module wants. Empty filters match everything.
+type Subscription struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Event          string                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Guilds         []string               `protobuf:"bytes,2,rep,name=guilds,proto3" json:"guilds,omitempty"`
+	Channels       []string               `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty"`
+	MessagePrefix  string                 `protobuf:"bytes,4,opt,name=message_prefix,json=messagePrefix,proto3" json:"message_prefix,omitempty"`
+	Commands       []string               `protobuf:"bytes,5,rep,name=commands,proto3" json:"commands,omitempty"`
+	CustomIdPrefix string                 `protobuf:"bytes,6,opt,name=custom_id_prefix,json=customIdPrefix,proto3" json:"custom_id_prefix,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_core_v1_hook_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_hook_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_core_v1_hook_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Subscription) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *Subscription) GetGuilds() []string {
+	if x != nil {
+		return x.Guilds
+	}
+	return nil
+}
+
+func (x *Subscription) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *Subscription) GetMessagePrefix() string {
+	if x != nil {
+		return x.MessagePrefix
+	}
+	return ""
+}
+
+func (x *Subscription) GetCommands() []string {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *Subscription) GetCustomIdPrefix() string {
+	if x != nil {
+		return x.CustomIdPrefix
+	}
+	return ""
+}
+
 type GetStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	IsReady       bool                   `protobuf:"varint,1,opt,name=isReady,proto3" json:"isReady,omitempty"`
@@ -107,7 +200,7 @@ type GetStatusResponse struct {
 
 func (x *GetStatusResponse) Reset() {
 	*x = GetStatusResponse{}
-	mi := &file_core_v1_hook_proto_msgTypes[1]
+	mi := &file_core_v1_hook_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -119,7 +212,7 @@ func (x *GetStatusResponse) String() string {
 func (*GetStatusResponse) ProtoMessage() {}
 
 func (x *GetStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_hook_proto_msgTypes[1]
+	mi := &file_core_v1_hook_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -132,7 +225,7 @@ func (x *GetStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetStatusResponse) Descriptor() ([]byte, []int) {
-	return file_core_v1_hook_proto_rawDescGZIP(), []int{1}
+	return file_core_v1_hook_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetStatusResponse) GetIsReady() bool {
@@ -151,7 +244,7 @@ type OnStageRequest struct {
 
 func (x *OnStageRequest) Reset() {
 	*x = OnStageRequest{}
-	mi := &file_core_v1_hook_proto_msgTypes[2]
+	mi := &file_core_v1_hook_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -163,7 +256,7 @@ func (x *OnStageRequest) String() string {
 func (*OnStageRequest) ProtoMessage() {}
 
 func (x *OnStageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_hook_proto_msgTypes[2]
+	mi := &file_core_v1_hook_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -176,7 +269,7 @@ func (x *OnStageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OnStageRequest.ProtoReflect.Descriptor instead.
 func (*OnStageRequest) Descriptor() ([]byte, []int) {
-	return file_core_v1_hook_proto_rawDescGZIP(), []int{2}
+	return file_core_v1_hook_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *OnStageRequest) GetStage() string {
@@ -190,7 +283,7 @@ var File_core_v1_hook_proto protoreflect.FileDescriptor
 
 const file_core_v1_hook_proto_rawDesc = "" +
 	"\n" +
-	"\x12core-v1/hook.proto\x12\acore_v1\x1a\fcommon.proto\"\x9d\x01\n" +
+	"\x12core-v1/hook.proto\x12\acore_v1\x1a\fcommon.proto\"\xda\x01\n" +
 	"\x13GetManifestResponse\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x16\n" +
@@ -198,7 +291,15 @@ const file_core_v1_hook_proto_rawDesc = "" +
 	"\n" +
 	"repository\x18\x04 \x01(\tR\n" +
 	"repository\x12 \n" +
-	"\vpermissions\x18\x05 \x03(\tR\vpermissions\"-\n" +
+	"\vpermissions\x18\x05 \x03(\tR\vpermissions\x12;\n" +
+	"\rsubscriptions\x18\x06 \x03(\v2\x15.core_v1.SubscriptionR\rsubscriptions\"\xc5\x01\n" +
+	"\fSubscription\x12\x14\n" +
+	"\x05event\x18\x01 \x01(\tR\x05event\x12\x16\n" +
+	"\x06guilds\x18\x02 \x03(\tR\x06guilds\x12\x1a\n" +
+	"\bchannels\x18\x03 \x03(\tR\bchannels\x12%\n" +
+	"\x0emessage_prefix\x18\x04 \x01(\tR\rmessagePrefix\x12\x1a\n" +
+	"\bcommands\x18\x05 \x03(\tR\bcommands\x12(\n" +
+	"\x10custom_id_prefix\x18\x06 \x01(\tR\x0ecustomIdPrefix\"-\n" +
 	"\x11GetStatusResponse\x12\x18\n" +
 	"\aisReady\x18\x01 \x01(\bR\aisReady\"&\n" +
 	"\x0eOnStageRequest\x12\x14\n" +
@@ -220,25 +321,27 @@ func file_core_v1_hook_proto_rawDescGZIP() []byte {
 	return file_core_v1_hook_proto_rawDescData
 }
 
-var file_core_v1_hook_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_core_v1_hook_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_core_v1_hook_proto_goTypes = []any{
 	(*GetManifestResponse)(nil), // 0: core_v1.GetManifestResponse
-	(*GetStatusResponse)(nil),   // 1: core_v1.GetStatusResponse
-	(*OnStageRequest)(nil),      // 2: core_v1.OnStageRequest
-	(*proto.Empty)(nil),         // 3: common.Empty
+	(*Subscription)(nil),        // 1: core_v1.Subscription
+	(*GetStatusResponse)(nil),   // 2: core_v1.GetStatusResponse
+	(*OnStageRequest)(nil),      // 3: core_v1.OnStageRequest
+	(*proto.Empty)(nil),         // 4: common.Empty
 }
 var file_core_v1_hook_proto_depIdxs = []int32{
-	3, // 0: core_v1.Hook.GetManifest:input_type -> common.Empty
-	3, // 1: core_v1.Hook.GetStatus:input_type -> common.Empty
-	2, // 2: core_v1.Hook.OnStage:input_type -> core_v1.OnStageRequest
-	0, // 3: core_v1.Hook.GetManifest:output_type -> core_v1.GetManifestResponse
-	1, // 4: core_v1.Hook.GetStatus:output_type -> core_v1.GetStatusResponse
-	3, // 5: core_v1.Hook.OnStage:output_type -> common.Empty
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: core_v1.GetManifestResponse.subscriptions:type_name -> core_v1.Subscription
+	4, // 1: core_v1.Hook.GetManifest:input_type -> common.Empty
+	4, // 2: core_v1.Hook.GetStatus:input_type -> common.Empty
+	3, // 3: core_v1.Hook.OnStage:input_type -> core_v1.OnStageRequest
+	0, // 4: core_v1.Hook.GetManifest:output_type -> core_v1.GetManifestResponse
+	2, // 5: core_v1.Hook.GetStatus:output_type -> core_v1.GetStatusResponse
+	4, // 6: core_v1.Hook.OnStage:output_type -> common.Empty
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_core_v1_hook_proto_init() }
@@ -252,7 +355,7 @@ func file_core_v1_hook_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_core_v1_hook_proto_rawDesc), len(file_core_v1_hook_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -9,7 +9,6 @@ package discord_v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	anypb "google.golang.org/protobuf/types/known/anypb"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -720,10 +719,14 @@ type ComponentType int32
 const (
 	ComponentType_COMPONENT_TYPE_UNKNOWN ComponentType = 0 // Default zero value
 	// Add specific component types based on Discord API, e.g.:
-	ComponentType_COMPONENT_TYPE_ACTION_ROW  ComponentType = 1
-	ComponentType_COMPONENT_TYPE_BUTTON      ComponentType = 2
-	ComponentType_COMPONENT_TYPE_SELECT_MENU ComponentType = 3
-	ComponentType_COMPONENT_TYPE_TEXT_INPUT  ComponentType = 4 // ... other component types ...
+	ComponentType_COMPONENT_TYPE_ACTION_ROW              ComponentType = 1
+	ComponentType_COMPONENT_TYPE_BUTTON                  ComponentType = 2
+	ComponentType_COMPONENT_TYPE_SELECT_MENU             ComponentType = 3
+	ComponentType_COMPONENT_TYPE_TEXT_INPUT              ComponentType = 4
+	ComponentType_COMPONENT_TYPE_USER_SELECT_MENU        ComponentType = 5
+	ComponentType_COMPONENT_TYPE_ROLE_SELECT_MENU        ComponentType = 6
+	ComponentType_COMPONENT_TYPE_MENTIONABLE_SELECT_MENU ComponentType = 7
+	ComponentType_COMPONENT_TYPE_CHANNEL_SELECT_MENU     ComponentType = 8
 )
 
 // Enum value maps for ComponentType.
@@ -734,13 +737,21 @@ var (
 		2: "COMPONENT_TYPE_BUTTON",
 		3: "COMPONENT_TYPE_SELECT_MENU",
 		4: "COMPONENT_TYPE_TEXT_INPUT",
+		5: "COMPONENT_TYPE_USER_SELECT_MENU",
+		6: "COMPONENT_TYPE_ROLE_SELECT_MENU",
+		7: "COMPONENT_TYPE_MENTIONABLE_SELECT_MENU",
+		8: "COMPONENT_TYPE_CHANNEL_SELECT_MENU",
 	}
 	ComponentType_value = map[string]int32{
-		"COMPONENT_TYPE_UNKNOWN":     0,
-		"COMPONENT_TYPE_ACTION_ROW":  1,
-		"COMPONENT_TYPE_BUTTON":      2,
-		"COMPONENT_TYPE_SELECT_MENU": 3,
-		"COMPONENT_TYPE_TEXT_INPUT":  4,
+		"COMPONENT_TYPE_UNKNOWN":                 0,
+		"COMPONENT_TYPE_ACTION_ROW":              1,
+		"COMPONENT_TYPE_BUTTON":                  2,
+		"COMPONENT_TYPE_SELECT_MENU":             3,
+		"COMPONENT_TYPE_TEXT_INPUT":              4,
+		"COMPONENT_TYPE_USER_SELECT_MENU":        5,
+		"COMPONENT_TYPE_ROLE_SELECT_MENU":        6,
+		"COMPONENT_TYPE_MENTIONABLE_SELECT_MENU": 7,
+		"COMPONENT_TYPE_CHANNEL_SELECT_MENU":     8,
 	}
 )
 
@@ -771,6 +782,115 @@ func (ComponentType) EnumDescriptor() ([]byte, []int) {
 	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{11}
 }
 
+// ButtonStyle is the style of a button.
+type ButtonStyle int32
+
+const (
+	ButtonStyle_BUTTON_STYLE_UNKNOWN   ButtonStyle = 0
+	ButtonStyle_BUTTON_STYLE_PRIMARY   ButtonStyle = 1
+	ButtonStyle_BUTTON_STYLE_SECONDARY ButtonStyle = 2
+	ButtonStyle_BUTTON_STYLE_SUCCESS   ButtonStyle = 3
+	ButtonStyle_BUTTON_STYLE_DANGER    ButtonStyle = 4
+	ButtonStyle_BUTTON_STYLE_LINK      ButtonStyle = 5
+)
+
+// Enum value maps for ButtonStyle.
+var (
+	ButtonStyle_name = map[int32]string{
+		0: "BUTTON_STYLE_UNKNOWN",
+		1: "BUTTON_STYLE_PRIMARY",
+		2: "BUTTON_STYLE_SECONDARY",
+		3: "BUTTON_STYLE_SUCCESS",
+		4: "BUTTON_STYLE_DANGER",
+		5: "BUTTON_STYLE_LINK",
+	}
+	ButtonStyle_value = map[string]int32{
+		"BUTTON_STYLE_UNKNOWN":   0,
+		"BUTTON_STYLE_PRIMARY":   1,
+		"BUTTON_STYLE_SECONDARY": 2,
+		"BUTTON_STYLE_SUCCESS":   3,
+		"BUTTON_STYLE_DANGER":    4,
+		"BUTTON_STYLE_LINK":      5,
+	}
+)
+
+func (x ButtonStyle) Enum() *ButtonStyle {
+	p := new(ButtonStyle)
+	*p = x
+	return p
+}
+
+func (x ButtonStyle) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ButtonStyle) Descriptor() protoreflect.EnumDescriptor {
+	return file_discord_v1_discordgo_proto_enumTypes[12].Descriptor()
+}
+
+func (ButtonStyle) Type() protoreflect.EnumType {
+	return &file_discord_v1_discordgo_proto_enumTypes[12]
+}
+
+func (x ButtonStyle) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ButtonStyle.Descriptor instead.
+func (ButtonStyle) EnumDescriptor() ([]byte, []int) {
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{12}
+}
+
+// TextInputStyle is the style of a text input.
+type TextInputStyle int32
+
+const (
+	TextInputStyle_TEXT_INPUT_STYLE_UNKNOWN   TextInputStyle = 0
+	TextInputStyle_TEXT_INPUT_STYLE_SHORT     TextInputStyle = 1
+	TextInputStyle_TEXT_INPUT_STYLE_PARAGRAPH TextInputStyle = 2
+)
+
+// Enum value maps for TextInputStyle.
+var (
+	TextInputStyle_name = map[int32]string{
+		0: "TEXT_INPUT_STYLE_UNKNOWN",
+		1: "TEXT_INPUT_STYLE_SHORT",
+		2: "TEXT_INPUT_STYLE_PARAGRAPH",
+	}
+	TextInputStyle_value = map[string]int32{
+		"TEXT_INPUT_STYLE_UNKNOWN":   0,
+		"TEXT_INPUT_STYLE_SHORT":     1,
+		"TEXT_INPUT_STYLE_PARAGRAPH": 2,
+	}
+)
+
+func (x TextInputStyle) Enum() *TextInputStyle {
+	p := new(TextInputStyle)
+	*p = x
+	return p
+}
+
+func (x TextInputStyle) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TextInputStyle) Descriptor() protoreflect.EnumDescriptor {
+	return file_discord_v1_discordgo_proto_enumTypes[13].Descriptor()
+}
+
+func (TextInputStyle) Type() protoreflect.EnumType {
+	return &file_discord_v1_discordgo_proto_enumTypes[13]
+}
+
+func (x TextInputStyle) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TextInputStyle.Descriptor instead.
+func (TextInputStyle) EnumDescriptor() ([]byte, []int) {
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{13}
+}
+
 // ApplicationCommandType represents the type of application command.
 type ApplicationCommandType int32
 
@@ -811,11 +931,11 @@ func (x ApplicationCommandType) String() string {
 }
 
 func (ApplicationCommandType) Descriptor() protoreflect.EnumDescriptor {
-	return file_discord_v1_discordgo_proto_enumTypes[12].Descriptor()
+	return file_discord_v1_discordgo_proto_enumTypes[14].Descriptor()
 }
 
 func (ApplicationCommandType) Type() protoreflect.EnumType {
-	return &file_discord_v1_discordgo_proto_enumTypes[12]
+	return &file_discord_v1_discordgo_proto_enumTypes[14]
 }
 
 func (x ApplicationCommandType) Number() protoreflect.EnumNumber {
@@ -824,7 +944,7 @@ func (x ApplicationCommandType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ApplicationCommandType.Descriptor instead.
 func (ApplicationCommandType) EnumDescriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{12}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{14}
 }
 
 // InteractionContextType represents the context in which interaction can be used or was triggered from.
@@ -867,11 +987,11 @@ func (x InteractionContextType) String() string {
 }
 
 func (InteractionContextType) Descriptor() protoreflect.EnumDescriptor {
-	return file_discord_v1_discordgo_proto_enumTypes[13].Descriptor()
+	return file_discord_v1_discordgo_proto_enumTypes[15].Descriptor()
 }
 
 func (InteractionContextType) Type() protoreflect.EnumType {
-	return &file_discord_v1_discordgo_proto_enumTypes[13]
+	return &file_discord_v1_discordgo_proto_enumTypes[15]
 }
 
 func (x InteractionContextType) Number() protoreflect.EnumNumber {
@@ -880,7 +1000,7 @@ func (x InteractionContextType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InteractionContextType.Descriptor instead.
 func (InteractionContextType) EnumDescriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{13}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{15}
 }
 
 // ApplicationIntegrationType represents the type of integration. (Needs definition based on Discord API)
@@ -918,11 +1038,11 @@ func (x ApplicationIntegrationType) String() string {
 }
 
 func (ApplicationIntegrationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_discord_v1_discordgo_proto_enumTypes[14].Descriptor()
+	return file_discord_v1_discordgo_proto_enumTypes[16].Descriptor()
 }
 
 func (ApplicationIntegrationType) Type() protoreflect.EnumType {
-	return &file_discord_v1_discordgo_proto_enumTypes[14]
+	return &file_discord_v1_discordgo_proto_enumTypes[16]
 }
 
 func (x ApplicationIntegrationType) Number() protoreflect.EnumNumber {
@@ -931,7 +1051,7 @@ func (x ApplicationIntegrationType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ApplicationIntegrationType.Descriptor instead.
 func (ApplicationIntegrationType) EnumDescriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{14}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{16}
 }
 
 // ApplicationCommandOptionType indicates the type of a slash command's option.
@@ -995,11 +1115,11 @@ func (x ApplicationCommandOptionType) String() string {
 }
 
 func (ApplicationCommandOptionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_discord_v1_discordgo_proto_enumTypes[15].Descriptor()
+	return file_discord_v1_discordgo_proto_enumTypes[17].Descriptor()
 }
 
 func (ApplicationCommandOptionType) Type() protoreflect.EnumType {
-	return &file_discord_v1_discordgo_proto_enumTypes[15]
+	return &file_discord_v1_discordgo_proto_enumTypes[17]
 }
 
 func (x ApplicationCommandOptionType) Number() protoreflect.EnumNumber {
@@ -1008,7 +1128,7 @@ func (x ApplicationCommandOptionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ApplicationCommandOptionType.Descriptor instead.
 func (ApplicationCommandOptionType) EnumDescriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{15}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{17}
 }
 
 // ApplicationCommandPermissionType indicates whether a permission is user or role based.
@@ -1048,11 +1168,11 @@ func (x ApplicationCommandPermissionType) String() string {
 }
 
 func (ApplicationCommandPermissionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_discord_v1_discordgo_proto_enumTypes[16].Descriptor()
+	return file_discord_v1_discordgo_proto_enumTypes[18].Descriptor()
 }
 
 func (ApplicationCommandPermissionType) Type() protoreflect.EnumType {
-	return &file_discord_v1_discordgo_proto_enumTypes[16]
+	return &file_discord_v1_discordgo_proto_enumTypes[18]
 }
 
 func (x ApplicationCommandPermissionType) Number() protoreflect.EnumNumber {
@@ -1061,7 +1181,7 @@ func (x ApplicationCommandPermissionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ApplicationCommandPermissionType.Descriptor instead.
 func (ApplicationCommandPermissionType) EnumDescriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{16}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{18}
 }
 
 // InteractionType indicates the type of an interaction event.
@@ -1107,11 +1227,11 @@ func (x InteractionType) String() string {
 }
 
 func (InteractionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_discord_v1_discordgo_proto_enumTypes[17].Descriptor()
+	return file_discord_v1_discordgo_proto_enumTypes[19].Descriptor()
 }
 
 func (InteractionType) Type() protoreflect.EnumType {
-	return &file_discord_v1_discordgo_proto_enumTypes[17]
+	return &file_discord_v1_discordgo_proto_enumTypes[19]
 }
 
 func (x InteractionType) Number() protoreflect.EnumNumber {
@@ -1120,7 +1240,7 @@ func (x InteractionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InteractionType.Descriptor instead.
 func (InteractionType) EnumDescriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{17}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{19}
 }
 
 // InteractionResponseType is type of interaction response.
@@ -1179,11 +1299,11 @@ func (x InteractionResponseType) String() string {
 }
 
 func (InteractionResponseType) Descriptor() protoreflect.EnumDescriptor {
-	return file_discord_v1_discordgo_proto_enumTypes[18].Descriptor()
+	return file_discord_v1_discordgo_proto_enumTypes[20].Descriptor()
 }
 
 func (InteractionResponseType) Type() protoreflect.EnumType {
-	return &file_discord_v1_discordgo_proto_enumTypes[18]
+	return &file_discord_v1_discordgo_proto_enumTypes[20]
 }
 
 func (x InteractionResponseType) Number() protoreflect.EnumNumber {
@@ -1192,7 +1312,7 @@ func (x InteractionResponseType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InteractionResponseType.Descriptor instead.
 func (InteractionResponseType) EnumDescriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{18}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{20}
 }
 
 // A Message stores all data related to a specific Discord message.
@@ -1209,7 +1329,7 @@ type Message struct {
 	MentionEveryone     bool                        `protobuf:"varint,9,opt,name=mention_everyone,json=mentionEveryone,proto3" json:"mention_everyone,omitempty"`
 	Author              *User                       `protobuf:"bytes,10,opt,name=author,proto3" json:"author,omitempty"`
 	Attachments         []*MessageAttachment        `protobuf:"bytes,11,rep,name=attachments,proto3" json:"attachments,omitempty"`
-	Components          []*anypb.Any                `protobuf:"bytes,12,rep,name=components,proto3" json:"components,omitempty"` // Using Any for MessageComponent as it's an interface
+	Components          []*MessageComponent         `protobuf:"bytes,12,rep,name=components,proto3" json:"components,omitempty"`
 	Embeds              []*MessageEmbed             `protobuf:"bytes,13,rep,name=embeds,proto3" json:"embeds,omitempty"`
 	Mentions            []*User                     `protobuf:"bytes,14,rep,name=mentions,proto3" json:"mentions,omitempty"`
 	Reactions           []*MessageReactions         `protobuf:"bytes,15,rep,name=reactions,proto3" json:"reactions,omitempty"`
@@ -1340,7 +1460,7 @@ func (x *Message) GetAttachments() []*MessageAttachment {
 	return nil
 }
 
-func (x *Message) GetComponents() []*anypb.Any {
+func (x *Message) GetComponents() []*MessageComponent {
 	if x != nil {
 		return x.Components
 	}
@@ -1482,9 +1602,13 @@ func (x *Message) GetPoll() *Poll {
 
 // File stores info about files you e.g. send in messages.
 type File struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// The content of Reader: inline for small files, or uploaded beforehand with Helper.UploadFile
+	// for large ones (data is empty then).
+	Data          []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	UploadId      string `protobuf:"bytes,4,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1533,13 +1657,27 @@ func (x *File) GetContentType() string {
 	return ""
 }
 
+func (x *File) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *File) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
 // MessageSend stores all parameters you can send with ChannelMessageSendComplex.
 type MessageSend struct {
 	state           protoimpl.MessageState  `protogen:"open.v1"`
 	Content         string                  `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 	Embeds          []*MessageEmbed         `protobuf:"bytes,2,rep,name=embeds,proto3" json:"embeds,omitempty"`
 	Tts             bool                    `protobuf:"varint,3,opt,name=tts,proto3" json:"tts,omitempty"`
-	Components      []*anypb.Any            `protobuf:"bytes,4,rep,name=components,proto3" json:"components,omitempty"` // Using Any for MessageComponent
+	Components      []*MessageComponent     `protobuf:"bytes,4,rep,name=components,proto3" json:"components,omitempty"`
 	Files           []*File                 `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
 	AllowedMentions *MessageAllowedMentions `protobuf:"bytes,6,opt,name=allowed_mentions,json=allowedMentions,proto3" json:"allowed_mentions,omitempty"`
 	Reference       *MessageReference       `protobuf:"bytes,7,opt,name=reference,proto3" json:"reference,omitempty"`
@@ -1601,7 +1739,7 @@ func (x *MessageSend) GetTts() bool {
 	return false
 }
 
-func (x *MessageSend) GetComponents() []*anypb.Any {
+func (x *MessageSend) GetComponents() []*MessageComponent {
 	if x != nil {
 		return x.Components
 	}
@@ -1654,7 +1792,7 @@ func (x *MessageSend) GetPoll() *Poll {
 type MessageEdit struct {
 	state           protoimpl.MessageState  `protogen:"open.v1"`
 	Content         string                  `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Components      []*anypb.Any            `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"` // Using Any for MessageComponent
+	Components      []*MessageComponent     `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
 	Embeds          []*MessageEmbed         `protobuf:"bytes,3,rep,name=embeds,proto3" json:"embeds,omitempty"`
 	AllowedMentions *MessageAllowedMentions `protobuf:"bytes,4,opt,name=allowed_mentions,json=allowedMentions,proto3" json:"allowed_mentions,omitempty"`
 	Flags           MessageFlags            `protobuf:"varint,5,opt,name=flags,proto3,enum=discord_v1.MessageFlags" json:"flags,omitempty"`
@@ -1662,6 +1800,8 @@ type MessageEdit struct {
 	Attachments     []*MessageAttachment    `protobuf:"bytes,7,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	Id              string                  `protobuf:"bytes,8,opt,name=id,proto3" json:"id,omitempty"`
 	Channel         string                  `protobuf:"bytes,9,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Whether to remove the components, since an empty components list can't be told apart from none
+	ClearComponents bool `protobuf:"varint,10,opt,name=clear_components,json=clearComponents,proto3" json:"clear_components,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1703,7 +1843,7 @@ func (x *MessageEdit) GetContent() string {
 	return ""
 }
 
-func (x *MessageEdit) GetComponents() []*anypb.Any {
+func (x *MessageEdit) GetComponents() []*MessageComponent {
 	if x != nil {
 		return x.Components
 	}
@@ -1759,6 +1899,13 @@ func (x *MessageEdit) GetChannel() string {
 	return ""
 }
 
+func (x *MessageEdit) GetClearComponents() bool {
+	if x != nil {
+		return x.ClearComponents
+	}
+	return false
+}
+
 // MessageAllowedMentions allows the user to specify which mentions
 // Discord is allowed to parse in this message.
 type MessageAllowedMentions struct {
@@ -11772,7 +11919,7 @@ type WebhookParams struct {
 	// Represented here as a repeated field of the placeholder File message.
 	Files []*File `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
 	// Slice in Go -> repeated message
-	Components []*anypb.Any `protobuf:"bytes,6,rep,name=components,proto3" json:"components,omitempty"` // Using Any for MessageComponent
+	Components []*MessageComponent `protobuf:"bytes,6,rep,name=components,proto3" json:"components,omitempty"`
 	// Slice of pointers in Go -> repeated message
 	Embeds []*MessageEmbed `protobuf:"bytes,7,rep,name=embeds,proto3" json:"embeds,omitempty"`
 	// Slice of pointers in Go -> repeated message
@@ -11853,7 +12000,7 @@ func (x *WebhookParams) GetFiles() []*File {
 	return nil
 }
 
-func (x *WebhookParams) GetComponents() []*anypb.Any {
+func (x *WebhookParams) GetComponents() []*MessageComponent {
 	if x != nil {
 		return x.Components
 	}
@@ -11899,8 +12046,8 @@ func (x *WebhookParams) GetThreadName() string {
 type WebhookEdit struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Pointer in Go + OmitEmpty -> optional string
-	Content    *string      `protobuf:"bytes,1,opt,name=content,proto3,oneof" json:"content,omitempty"`
-	Components []*anypb.Any `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"` // Changed from optional repeated
+	Content    *string             `protobuf:"bytes,1,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	Components []*MessageComponent `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
 	// Pointer to Slice of Pointers in Go + OmitEmpty -> repeated message
 	Embeds []*MessageEmbed `protobuf:"bytes,3,rep,name=embeds,proto3" json:"embeds,omitempty"` // Changed from optional repeated
 	// json:"-" tag in Go. Files are typically sent separately.
@@ -11909,6 +12056,8 @@ type WebhookEdit struct {
 	Attachments []*MessageAttachment `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"` // Changed from optional repeated
 	// Pointer in Go + OmitEmpty -> optional message
 	AllowedMentions *MessageAllowedMentions `protobuf:"bytes,6,opt,name=allowed_mentions,json=allowedMentions,proto3,oneof" json:"allowed_mentions,omitempty"`
+	// Whether to remove the components, since an empty components list can't be told apart from none
+	ClearComponents bool `protobuf:"varint,7,opt,name=clear_components,json=clearComponents,proto3" json:"clear_components,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -11950,7 +12099,7 @@ func (x *WebhookEdit) GetContent() string {
 	return ""
 }
 
-func (x *WebhookEdit) GetComponents() []*anypb.Any {
+func (x *WebhookEdit) GetComponents() []*MessageComponent {
 	if x != nil {
 		return x.Components
 	}
@@ -11985,6 +12134,13 @@ func (x *WebhookEdit) GetAllowedMentions() *MessageAllowedMentions {
 	return nil
 }
 
+func (x *WebhookEdit) GetClearComponents() bool {
+	if x != nil {
+		return x.ClearComponents
+	}
+	return false
+}
+
 type ComponentEmoji struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -12045,52 +12201,34 @@ func (x *ComponentEmoji) GetAnimated() bool {
 	return false
 }
 
-// ApplicationCommand represents an application's slash command.
-type ApplicationCommand struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ApplicationId     string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	GuildId           string                 `protobuf:"bytes,3,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	Version           string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
-	Type              ApplicationCommandType `protobuf:"varint,5,opt,name=type,proto3,enum=discord_v1.ApplicationCommandType" json:"type,omitempty"`
-	Name              string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	NameLocalizations map[string]string      `protobuf:"bytes,7,rep,name=name_localizations,json=nameLocalizations,proto3" json:"name_localizations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Assuming Locale is stringable or maps to string
-	// NOTE: DefaultPermission will be soon deprecated. Use DefaultMemberPermissions and Contexts instead.
-	// DefaultPermission *bool `json:"default_permission,omitempty"` - Use optional bool for deprecation clarity? Or omit entirely as deprecated? Let's keep for now, marked as deprecated.
-	//
-	// Deprecated: Marked as deprecated in discord-v1/discordgo.proto.
-	DefaultPermission        *bool `protobuf:"varint,8,opt,name=default_permission,json=defaultPermission,proto3,oneof" json:"default_permission,omitempty"`
-	DefaultMemberPermissions int64 `protobuf:"varint,9,opt,name=default_member_permissions,json=defaultMemberPermissions,proto3" json:"default_member_permissions,omitempty"` // Use int64 for permissions bitfield
-	Nsfw                     *bool `protobuf:"varint,10,opt,name=nsfw,proto3,oneof" json:"nsfw,omitempty"`
-	// Deprecated: use Contexts instead.
-	// DMPermission *bool `json:"dm_permission,omitempty"` - Similar to DefaultPermission, mark deprecated or omit. Keep for now, marked.
+// MessageComponent is a component of a message or a modal (discordgo.MessageComponent interface).
+type MessageComponent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Component:
 	//
-	// Deprecated: Marked as deprecated in discord-v1/discordgo.proto.
-	DmPermission     *bool                        `protobuf:"varint,11,opt,name=dm_permission,json=dmPermission,proto3,oneof" json:"dm_permission,omitempty"`
-	Contexts         []InteractionContextType     `protobuf:"varint,12,rep,packed,name=contexts,proto3,enum=discord_v1.InteractionContextType" json:"contexts,omitempty"`
-	IntegrationTypes []ApplicationIntegrationType `protobuf:"varint,13,rep,packed,name=integration_types,json=integrationTypes,proto3,enum=discord_v1.ApplicationIntegrationType" json:"integration_types,omitempty"`
-	// NOTE: Chat commands only. Otherwise it mustn't be set.
-	Description              string                      `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`
-	DescriptionLocalizations map[string]string           `protobuf:"bytes,15,rep,name=description_localizations,json=descriptionLocalizations,proto3" json:"description_localizations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Assuming Locale is stringable or maps to string
-	Options                  []*ApplicationCommandOption `protobuf:"bytes,16,rep,name=options,proto3" json:"options,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+	//	*MessageComponent_ActionsRow
+	//	*MessageComponent_Button
+	//	*MessageComponent_SelectMenu
+	//	*MessageComponent_TextInput
+	Component     isMessageComponent_Component `protobuf_oneof:"component"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplicationCommand) Reset() {
-	*x = ApplicationCommand{}
+func (x *MessageComponent) Reset() {
+	*x = MessageComponent{}
 	mi := &file_discord_v1_discordgo_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplicationCommand) String() string {
+func (x *MessageComponent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplicationCommand) ProtoMessage() {}
+func (*MessageComponent) ProtoMessage() {}
 
-func (x *ApplicationCommand) ProtoReflect() protoreflect.Message {
+func (x *MessageComponent) ProtoReflect() protoreflect.Message {
 	mi := &file_discord_v1_discordgo_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -12102,129 +12240,735 @@ func (x *ApplicationCommand) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplicationCommand.ProtoReflect.Descriptor instead.
-func (*ApplicationCommand) Descriptor() ([]byte, []int) {
+// Deprecated: Use MessageComponent.ProtoReflect.Descriptor instead.
+func (*MessageComponent) Descriptor() ([]byte, []int) {
 	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *ApplicationCommand) GetId() string {
+func (x *MessageComponent) GetComponent() isMessageComponent_Component {
 	if x != nil {
-		return x.Id
+		return x.Component
 	}
-	return ""
+	return nil
 }
 
-func (x *ApplicationCommand) GetApplicationId() string {
+func (x *MessageComponent) GetActionsRow() *ActionsRow {
 	if x != nil {
-		return x.ApplicationId
+		if x, ok := x.Component.(*MessageComponent_ActionsRow); ok {
+			return x.ActionsRow
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *ApplicationCommand) GetGuildId() string {
+func (x *MessageComponent) GetButton() *Button {
 	if x != nil {
-		return x.GuildId
+		if x, ok := x.Component.(*MessageComponent_Button); ok {
+			return x.Button
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *ApplicationCommand) GetVersion() string {
+func (x *MessageComponent) GetSelectMenu() *SelectMenu {
 	if x != nil {
-		return x.Version
+		if x, ok := x.Component.(*MessageComponent_SelectMenu); ok {
+			return x.SelectMenu
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *ApplicationCommand) GetType() ApplicationCommandType {
+func (x *MessageComponent) GetTextInput() *TextInput {
 	if x != nil {
-		return x.Type
+		if x, ok := x.Component.(*MessageComponent_TextInput); ok {
+			return x.TextInput
+		}
 	}
-	return ApplicationCommandType_APPLICATION_COMMAND_TYPE_UNKNOWN
+	return nil
 }
 
-func (x *ApplicationCommand) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type isMessageComponent_Component interface {
+	isMessageComponent_Component()
 }
 
-func (x *ApplicationCommand) GetNameLocalizations() map[string]string {
-	if x != nil {
-		return x.NameLocalizations
-	}
-	return nil
+type MessageComponent_ActionsRow struct {
+	ActionsRow *ActionsRow `protobuf:"bytes,1,opt,name=actions_row,json=actionsRow,proto3,oneof"`
 }
 
-// Deprecated: Marked as deprecated in discord-v1/discordgo.proto.
-func (x *ApplicationCommand) GetDefaultPermission() bool {
-	if x != nil && x.DefaultPermission != nil {
-		return *x.DefaultPermission
-	}
-	return false
+type MessageComponent_Button struct {
+	Button *Button `protobuf:"bytes,2,opt,name=button,proto3,oneof"`
 }
 
-func (x *ApplicationCommand) GetDefaultMemberPermissions() int64 {
-	if x != nil {
-		return x.DefaultMemberPermissions
-	}
-	return 0
+type MessageComponent_SelectMenu struct {
+	SelectMenu *SelectMenu `protobuf:"bytes,3,opt,name=select_menu,json=selectMenu,proto3,oneof"` // Every kind of select menu (see SelectMenu.menu_type)
 }
 
-func (x *ApplicationCommand) GetNsfw() bool {
-	if x != nil && x.Nsfw != nil {
-		return *x.Nsfw
-	}
-	return false
+type MessageComponent_TextInput struct {
+	TextInput *TextInput `protobuf:"bytes,4,opt,name=text_input,json=textInput,proto3,oneof"`
 }
 
-// Deprecated: Marked as deprecated in discord-v1/discordgo.proto.
-func (x *ApplicationCommand) GetDmPermission() bool {
-	if x != nil && x.DmPermission != nil {
-		return *x.DmPermission
-	}
-	return false
+func (*MessageComponent_ActionsRow) isMessageComponent_Component() {}
+
+func (*MessageComponent_Button) isMessageComponent_Component() {}
+
+func (*MessageComponent_SelectMenu) isMessageComponent_Component() {}
+
+func (*MessageComponent_TextInput) isMessageComponent_Component() {}
+
+// ActionsRow is a container for the other components.
+type ActionsRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Components    []*MessageComponent    `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplicationCommand) GetContexts() []InteractionContextType {
-	if x != nil {
-		return x.Contexts
-	}
-	return nil
+func (x *ActionsRow) Reset() {
+	*x = ActionsRow{}
+	mi := &file_discord_v1_discordgo_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplicationCommand) GetIntegrationTypes() []ApplicationIntegrationType {
-	if x != nil {
-		return x.IntegrationTypes
-	}
-	return nil
+func (x *ActionsRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ApplicationCommand) GetDescription() string {
+func (*ActionsRow) ProtoMessage() {}
+
+func (x *ActionsRow) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_discordgo_proto_msgTypes[124]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ApplicationCommand) GetDescriptionLocalizations() map[string]string {
-	if x != nil {
-		return x.DescriptionLocalizations
-	}
-	return nil
+// Deprecated: Use ActionsRow.ProtoReflect.Descriptor instead.
+func (*ActionsRow) Descriptor() ([]byte, []int) {
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *ApplicationCommand) GetOptions() []*ApplicationCommandOption {
+func (x *ActionsRow) GetComponents() []*MessageComponent {
 	if x != nil {
-		return x.Options
+		return x.Components
 	}
 	return nil
 }
 
-// ApplicationCommandOption represents an option/subcommand/subcommands group.
-type ApplicationCommandOption struct {
-	state                    protoimpl.MessageState       `protogen:"open.v1"`
-	Type                     ApplicationCommandOptionType `protobuf:"varint,1,opt,name=type,proto3,enum=discord_v1.ApplicationCommandOptionType" json:"type,omitempty"`
+// Button is a clickable button.
+type Button struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Label    string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Style    ButtonStyle            `protobuf:"varint,2,opt,name=style,proto3,enum=discord_v1.ButtonStyle" json:"style,omitempty"`
+	Disabled bool                   `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Emoji    *ComponentEmoji        `protobuf:"bytes,4,opt,name=emoji,proto3,oneof" json:"emoji,omitempty"`
+	// NOTE: Only button with LinkButton style can have link. Also, URL is mutually exclusive with CustomID.
+	Url           string `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	CustomId      string `protobuf:"bytes,6,opt,name=custom_id,json=customId,proto3" json:"custom_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Button) Reset() {
+	*x = Button{}
+	mi := &file_discord_v1_discordgo_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Button) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Button) ProtoMessage() {}
+
+func (x *Button) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_discordgo_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Button.ProtoReflect.Descriptor instead.
+func (*Button) Descriptor() ([]byte, []int) {
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *Button) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Button) GetStyle() ButtonStyle {
+	if x != nil {
+		return x.Style
+	}
+	return ButtonStyle_BUTTON_STYLE_UNKNOWN
+}
+
+func (x *Button) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Button) GetEmoji() *ComponentEmoji {
+	if x != nil {
+		return x.Emoji
+	}
+	return nil
+}
+
+func (x *Button) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Button) GetCustomId() string {
+	if x != nil {
+		return x.CustomId
+	}
+	return ""
+}
+
+// SelectMenuDefaultValue is a default value of an auto populated select menu.
+type SelectMenuDefaultValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // "user", "role" or "channel"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectMenuDefaultValue) Reset() {
+	*x = SelectMenuDefaultValue{}
+	mi := &file_discord_v1_discordgo_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectMenuDefaultValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectMenuDefaultValue) ProtoMessage() {}
+
+func (x *SelectMenuDefaultValue) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_discordgo_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectMenuDefaultValue.ProtoReflect.Descriptor instead.
+func (*SelectMenuDefaultValue) Descriptor() ([]byte, []int) {
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *SelectMenuDefaultValue) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SelectMenuDefaultValue) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// SelectMenuOption is an option of a string select menu.
+type SelectMenuOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Emoji         *ComponentEmoji        `protobuf:"bytes,4,opt,name=emoji,proto3,oneof" json:"emoji,omitempty"`
+	Default       bool                   `protobuf:"varint,5,opt,name=default,proto3" json:"default,omitempty"` // Whether the option is selected by default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectMenuOption) Reset() {
+	*x = SelectMenuOption{}
+	mi := &file_discord_v1_discordgo_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectMenuOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectMenuOption) ProtoMessage() {}
+
+func (x *SelectMenuOption) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_discordgo_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectMenuOption.ProtoReflect.Descriptor instead.
+func (*SelectMenuOption) Descriptor() ([]byte, []int) {
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *SelectMenuOption) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SelectMenuOption) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SelectMenuOption) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SelectMenuOption) GetEmoji() *ComponentEmoji {
+	if x != nil {
+		return x.Emoji
+	}
+	return nil
+}
+
+func (x *SelectMenuOption) GetDefault() bool {
+	if x != nil {
+		return x.Default
+	}
+	return false
+}
+
+// SelectMenu is a select menu. Only string select menus (menu_type 3) have options.
+type SelectMenu struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	MenuType    ComponentType          `protobuf:"varint,1,opt,name=menu_type,json=menuType,proto3,enum=discord_v1.ComponentType" json:"menu_type,omitempty"`
+	CustomId    string                 `protobuf:"bytes,2,opt,name=custom_id,json=customId,proto3" json:"custom_id,omitempty"`
+	Placeholder string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	// Pointer in Go -> optional, since zero is a valid minimum
+	MinValues     *int32                    `protobuf:"varint,4,opt,name=min_values,json=minValues,proto3,oneof" json:"min_values,omitempty"`
+	MaxValues     int32                     `protobuf:"varint,5,opt,name=max_values,json=maxValues,proto3" json:"max_values,omitempty"`
+	DefaultValues []*SelectMenuDefaultValue `protobuf:"bytes,6,rep,name=default_values,json=defaultValues,proto3" json:"default_values,omitempty"`
+	Options       []*SelectMenuOption       `protobuf:"bytes,7,rep,name=options,proto3" json:"options,omitempty"`
+	Disabled      bool                      `protobuf:"varint,8,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// NOTE: Can only be used in SelectMenu with Channel menu type.
+	ChannelTypes  []ChannelType `protobuf:"varint,9,rep,packed,name=channel_types,json=channelTypes,proto3,enum=discord_v1.ChannelType" json:"channel_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectMenu) Reset() {
+	*x = SelectMenu{}
+	mi := &file_discord_v1_discordgo_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectMenu) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectMenu) ProtoMessage() {}
+
+func (x *SelectMenu) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_discordgo_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectMenu.ProtoReflect.Descriptor instead.
+func (*SelectMenu) Descriptor() ([]byte, []int) {
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *SelectMenu) GetMenuType() ComponentType {
+	if x != nil {
+		return x.MenuType
+	}
+	return ComponentType_COMPONENT_TYPE_UNKNOWN
+}
+
+func (x *SelectMenu) GetCustomId() string {
+	if x != nil {
+		return x.CustomId
+	}
+	return ""
+}
+
+func (x *SelectMenu) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *SelectMenu) GetMinValues() int32 {
+	if x != nil && x.MinValues != nil {
+		return *x.MinValues
+	}
+	return 0
+}
+
+func (x *SelectMenu) GetMaxValues() int32 {
+	if x != nil {
+		return x.MaxValues
+	}
+	return 0
+}
+
+func (x *SelectMenu) GetDefaultValues() []*SelectMenuDefaultValue {
+	if x != nil {
+		return x.DefaultValues
+	}
+	return nil
+}
+
+func (x *SelectMenu) GetOptions() []*SelectMenuOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *SelectMenu) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *SelectMenu) GetChannelTypes() []ChannelType {
+	if x != nil {
+		return x.ChannelTypes
+	}
+	return nil
+}
+
+// TextInput is a text input of a modal.
+type TextInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomId      string                 `protobuf:"bytes,1,opt,name=custom_id,json=customId,proto3" json:"custom_id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Style         TextInputStyle         `protobuf:"varint,3,opt,name=style,proto3,enum=discord_v1.TextInputStyle" json:"style,omitempty"`
+	Placeholder   string                 `protobuf:"bytes,4,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	Value         string                 `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Required      bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
+	MinLength     int32                  `protobuf:"varint,7,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	MaxLength     int32                  `protobuf:"varint,8,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextInput) Reset() {
+	*x = TextInput{}
+	mi := &file_discord_v1_discordgo_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextInput) ProtoMessage() {}
+
+func (x *TextInput) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_discordgo_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextInput.ProtoReflect.Descriptor instead.
+func (*TextInput) Descriptor() ([]byte, []int) {
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *TextInput) GetCustomId() string {
+	if x != nil {
+		return x.CustomId
+	}
+	return ""
+}
+
+func (x *TextInput) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TextInput) GetStyle() TextInputStyle {
+	if x != nil {
+		return x.Style
+	}
+	return TextInputStyle_TEXT_INPUT_STYLE_UNKNOWN
+}
+
+func (x *TextInput) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *TextInput) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *TextInput) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *TextInput) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *TextInput) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+// ApplicationCommand represents an application's slash command.
+type ApplicationCommand struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId     string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	GuildId           string                 `protobuf:"bytes,3,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Version           string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Type              ApplicationCommandType `protobuf:"varint,5,opt,name=type,proto3,enum=discord_v1.ApplicationCommandType" json:"type,omitempty"`
+	Name              string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	NameLocalizations map[string]string      `protobuf:"bytes,7,rep,name=name_localizations,json=nameLocalizations,proto3" json:"name_localizations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Assuming Locale is stringable or maps to string
+	// NOTE: DefaultPermission will be soon deprecated. Use DefaultMemberPermissions and Contexts instead.
+	// DefaultPermission *bool `json:"default_permission,omitempty"` - Use optional bool for deprecation clarity? Or omit entirely as deprecated? Let's keep for now, marked as deprecated.
+	//
+	// Deprecated: Marked as deprecated in discord-v1/discordgo.proto.
+	DefaultPermission        *bool `protobuf:"varint,8,opt,name=default_permission,json=defaultPermission,proto3,oneof" json:"default_permission,omitempty"`
+	DefaultMemberPermissions int64 `protobuf:"varint,9,opt,name=default_member_permissions,json=defaultMemberPermissions,proto3" json:"default_member_permissions,omitempty"` // Use int64 for permissions bitfield
+	Nsfw                     *bool `protobuf:"varint,10,opt,name=nsfw,proto3,oneof" json:"nsfw,omitempty"`
+	// Deprecated: use Contexts instead.
+	// DMPermission *bool `json:"dm_permission,omitempty"` - Similar to DefaultPermission, mark deprecated or omit. Keep for now, marked.
+	//
+	// Deprecated: Marked as deprecated in discord-v1/discordgo.proto.
+	DmPermission     *bool                        `protobuf:"varint,11,opt,name=dm_permission,json=dmPermission,proto3,oneof" json:"dm_permission,omitempty"`
+	Contexts         []InteractionContextType     `protobuf:"varint,12,rep,packed,name=contexts,proto3,enum=discord_v1.InteractionContextType" json:"contexts,omitempty"`
+	IntegrationTypes []ApplicationIntegrationType `protobuf:"varint,13,rep,packed,name=integration_types,json=integrationTypes,proto3,enum=discord_v1.ApplicationIntegrationType" json:"integration_types,omitempty"`
+	// NOTE: Chat commands only. Otherwise it mustn't be set.
+	Description              string                      `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`
+	DescriptionLocalizations map[string]string           `protobuf:"bytes,15,rep,name=description_localizations,json=descriptionLocalizations,proto3" json:"description_localizations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Assuming Locale is stringable or maps to string
+	Options                  []*ApplicationCommandOption `protobuf:"bytes,16,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ApplicationCommand) Reset() {
+	*x = ApplicationCommand{}
+	mi := &file_discord_v1_discordgo_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationCommand) ProtoMessage() {}
+
+func (x *ApplicationCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_discordgo_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationCommand.ProtoReflect.Descriptor instead.
+func (*ApplicationCommand) Descriptor() ([]byte, []int) {
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ApplicationCommand) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApplicationCommand) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *ApplicationCommand) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *ApplicationCommand) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ApplicationCommand) GetType() ApplicationCommandType {
+	if x != nil {
+		return x.Type
+	}
+	return ApplicationCommandType_APPLICATION_COMMAND_TYPE_UNKNOWN
+}
+
+func (x *ApplicationCommand) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplicationCommand) GetNameLocalizations() map[string]string {
+	if x != nil {
+		return x.NameLocalizations
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in discord-v1/discordgo.proto.
+func (x *ApplicationCommand) GetDefaultPermission() bool {
+	if x != nil && x.DefaultPermission != nil {
+		return *x.DefaultPermission
+	}
+	return false
+}
+
+func (x *ApplicationCommand) GetDefaultMemberPermissions() int64 {
+	if x != nil {
+		return x.DefaultMemberPermissions
+	}
+	return 0
+}
+
+func (x *ApplicationCommand) GetNsfw() bool {
+	if x != nil && x.Nsfw != nil {
+		return *x.Nsfw
+	}
+	return false
+}
+
+// Deprecated: Marked as deprecated in discord-v1/discordgo.proto.
+func (x *ApplicationCommand) GetDmPermission() bool {
+	if x != nil && x.DmPermission != nil {
+		return *x.DmPermission
+	}
+	return false
+}
+
+func (x *ApplicationCommand) GetContexts() []InteractionContextType {
+	if x != nil {
+		return x.Contexts
+	}
+	return nil
+}
+
+func (x *ApplicationCommand) GetIntegrationTypes() []ApplicationIntegrationType {
+	if x != nil {
+		return x.IntegrationTypes
+	}
+	return nil
+}
+
+func (x *ApplicationCommand) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ApplicationCommand) GetDescriptionLocalizations() map[string]string {
+	if x != nil {
+		return x.DescriptionLocalizations
+	}
+	return nil
+}
+
+func (x *ApplicationCommand) GetOptions() []*ApplicationCommandOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// ApplicationCommandOption represents an option/subcommand/subcommands group.
+type ApplicationCommandOption struct {
+	state                    protoimpl.MessageState       `protogen:"open.v1"`
+	Type                     ApplicationCommandOptionType `protobuf:"varint,1,opt,name=type,proto3,enum=discord_v1.ApplicationCommandOptionType" json:"type,omitempty"`
 	Name                     string                       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	NameLocalizations        map[string]string            `protobuf:"bytes,3,rep,name=name_localizations,json=nameLocalizations,proto3" json:"name_localizations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Assuming Locale is stringable or maps to string
 	Description              string                       `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
@@ -12250,7 +12994,7 @@ type ApplicationCommandOption struct {
 
 func (x *ApplicationCommandOption) Reset() {
 	*x = ApplicationCommandOption{}
-	mi := &file_discord_v1_discordgo_proto_msgTypes[124]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12262,7 +13006,7 @@ func (x *ApplicationCommandOption) String() string {
 func (*ApplicationCommandOption) ProtoMessage() {}
 
 func (x *ApplicationCommandOption) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_discordgo_proto_msgTypes[124]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12275,7 +13019,7 @@ func (x *ApplicationCommandOption) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplicationCommandOption.ProtoReflect.Descriptor instead.
 func (*ApplicationCommandOption) Descriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{124}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{131}
 }
 
 func (x *ApplicationCommandOption) GetType() ApplicationCommandOptionType {
@@ -12396,7 +13140,7 @@ type ApplicationCommandOptionChoice struct {
 
 func (x *ApplicationCommandOptionChoice) Reset() {
 	*x = ApplicationCommandOptionChoice{}
-	mi := &file_discord_v1_discordgo_proto_msgTypes[125]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12408,7 +13152,7 @@ func (x *ApplicationCommandOptionChoice) String() string {
 func (*ApplicationCommandOptionChoice) ProtoMessage() {}
 
 func (x *ApplicationCommandOptionChoice) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_discordgo_proto_msgTypes[125]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12421,7 +13165,7 @@ func (x *ApplicationCommandOptionChoice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplicationCommandOptionChoice.ProtoReflect.Descriptor instead.
 func (*ApplicationCommandOptionChoice) Descriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{125}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *ApplicationCommandOptionChoice) GetName() string {
@@ -12506,7 +13250,7 @@ type ApplicationCommandPermissions struct {
 
 func (x *ApplicationCommandPermissions) Reset() {
 	*x = ApplicationCommandPermissions{}
-	mi := &file_discord_v1_discordgo_proto_msgTypes[126]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12518,7 +13262,7 @@ func (x *ApplicationCommandPermissions) String() string {
 func (*ApplicationCommandPermissions) ProtoMessage() {}
 
 func (x *ApplicationCommandPermissions) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_discordgo_proto_msgTypes[126]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12531,7 +13275,7 @@ func (x *ApplicationCommandPermissions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplicationCommandPermissions.ProtoReflect.Descriptor instead.
 func (*ApplicationCommandPermissions) Descriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{126}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *ApplicationCommandPermissions) GetId() string {
@@ -12566,7 +13310,7 @@ type ApplicationCommandPermissionsList struct {
 
 func (x *ApplicationCommandPermissionsList) Reset() {
 	*x = ApplicationCommandPermissionsList{}
-	mi := &file_discord_v1_discordgo_proto_msgTypes[127]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12578,7 +13322,7 @@ func (x *ApplicationCommandPermissionsList) String() string {
 func (*ApplicationCommandPermissionsList) ProtoMessage() {}
 
 func (x *ApplicationCommandPermissionsList) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_discordgo_proto_msgTypes[127]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12591,7 +13335,7 @@ func (x *ApplicationCommandPermissionsList) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ApplicationCommandPermissionsList.ProtoReflect.Descriptor instead.
 func (*ApplicationCommandPermissionsList) Descriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{127}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *ApplicationCommandPermissionsList) GetPermissions() []*ApplicationCommandPermissions {
@@ -12614,7 +13358,7 @@ type GuildApplicationCommandPermissions struct {
 
 func (x *GuildApplicationCommandPermissions) Reset() {
 	*x = GuildApplicationCommandPermissions{}
-	mi := &file_discord_v1_discordgo_proto_msgTypes[128]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12626,7 +13370,7 @@ func (x *GuildApplicationCommandPermissions) String() string {
 func (*GuildApplicationCommandPermissions) ProtoMessage() {}
 
 func (x *GuildApplicationCommandPermissions) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_discordgo_proto_msgTypes[128]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12639,7 +13383,7 @@ func (x *GuildApplicationCommandPermissions) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GuildApplicationCommandPermissions.ProtoReflect.Descriptor instead.
 func (*GuildApplicationCommandPermissions) Descriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{128}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *GuildApplicationCommandPermissions) GetId() string {
@@ -12684,6 +13428,7 @@ type Interaction struct {
 	//	*Interaction_ApplicationCommandData
 	//	*Interaction_MessageComponentData
 	//	*Interaction_ModalSubmitData
+	//	*Interaction_AutocompleteData
 	Data      isInteraction_Data `protobuf_oneof:"data"`
 	GuildId   string             `protobuf:"bytes,8,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
 	ChannelId string             `protobuf:"bytes,9,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
@@ -12722,7 +13467,7 @@ type Interaction struct {
 
 func (x *Interaction) Reset() {
 	*x = Interaction{}
-	mi := &file_discord_v1_discordgo_proto_msgTypes[129]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12734,7 +13479,7 @@ func (x *Interaction) String() string {
 func (*Interaction) ProtoMessage() {}
 
 func (x *Interaction) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_discordgo_proto_msgTypes[129]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12747,7 +13492,7 @@ func (x *Interaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Interaction.ProtoReflect.Descriptor instead.
 func (*Interaction) Descriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{129}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *Interaction) GetId() string {
@@ -12805,6 +13550,15 @@ func (x *Interaction) GetModalSubmitData() *ModalSubmitInteractionData {
 	return nil
 }
 
+func (x *Interaction) GetAutocompleteData() *ApplicationCommandInteractionData {
+	if x != nil {
+		if x, ok := x.Data.(*Interaction_AutocompleteData); ok {
+			return x.AutocompleteData
+		}
+	}
+	return nil
+}
+
 func (x *Interaction) GetGuildId() string {
 	if x != nil {
 		return x.GuildId
@@ -12912,12 +13666,19 @@ type Interaction_ModalSubmitData struct {
 	ModalSubmitData *ModalSubmitInteractionData `protobuf:"bytes,6,opt,name=modal_submit_data,json=modalSubmitData,proto3,oneof"`
 }
 
+type Interaction_AutocompleteData struct {
+	// Autocomplete reuses the data of application commands, with the option being typed marked as focused.
+	AutocompleteData *ApplicationCommandInteractionData `protobuf:"bytes,7,opt,name=autocomplete_data,json=autocompleteData,proto3,oneof"`
+}
+
 func (*Interaction_ApplicationCommandData) isInteraction_Data() {}
 
 func (*Interaction_MessageComponentData) isInteraction_Data() {}
 
 func (*Interaction_ModalSubmitData) isInteraction_Data() {}
 
+func (*Interaction_AutocompleteData) isInteraction_Data() {}
+
 // ApplicationCommandInteractionData contains the data of application command interaction.
 type ApplicationCommandInteractionData struct {
 	state       protoimpl.MessageState                     `protogen:"open.v1"`
@@ -12936,7 +13697,7 @@ type ApplicationCommandInteractionData struct {
 
 func (x *ApplicationCommandInteractionData) Reset() {
 	*x = ApplicationCommandInteractionData{}
-	mi := &file_discord_v1_discordgo_proto_msgTypes[130]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12948,7 +13709,7 @@ func (x *ApplicationCommandInteractionData) String() string {
 func (*ApplicationCommandInteractionData) ProtoMessage() {}
 
 func (x *ApplicationCommandInteractionData) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_discordgo_proto_msgTypes[130]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12961,7 +13722,7 @@ func (x *ApplicationCommandInteractionData) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ApplicationCommandInteractionData.ProtoReflect.Descriptor instead.
 func (*ApplicationCommandInteractionData) Descriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{130}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *ApplicationCommandInteractionData) GetId() string {
@@ -13023,7 +13784,7 @@ type ApplicationCommandInteractionDataResolved struct {
 
 func (x *ApplicationCommandInteractionDataResolved) Reset() {
 	*x = ApplicationCommandInteractionDataResolved{}
-	mi := &file_discord_v1_discordgo_proto_msgTypes[131]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13035,7 +13796,7 @@ func (x *ApplicationCommandInteractionDataResolved) String() string {
 func (*ApplicationCommandInteractionDataResolved) ProtoMessage() {}
 
 func (x *ApplicationCommandInteractionDataResolved) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_discordgo_proto_msgTypes[131]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13048,7 +13809,7 @@ func (x *ApplicationCommandInteractionDataResolved) ProtoReflect() protoreflect.
 
 // Deprecated: Use ApplicationCommandInteractionDataResolved.ProtoReflect.Descriptor instead.
 func (*ApplicationCommandInteractionDataResolved) Descriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{131}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *ApplicationCommandInteractionDataResolved) GetUsers() map[string]*User {
@@ -13108,7 +13869,7 @@ type MessageComponentInteractionData struct {
 
 func (x *MessageComponentInteractionData) Reset() {
 	*x = MessageComponentInteractionData{}
-	mi := &file_discord_v1_discordgo_proto_msgTypes[132]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13120,7 +13881,7 @@ func (x *MessageComponentInteractionData) String() string {
 func (*MessageComponentInteractionData) ProtoMessage() {}
 
 func (x *MessageComponentInteractionData) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_discordgo_proto_msgTypes[132]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13133,7 +13894,7 @@ func (x *MessageComponentInteractionData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageComponentInteractionData.ProtoReflect.Descriptor instead.
 func (*MessageComponentInteractionData) Descriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{132}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{139}
 }
 
 func (x *MessageComponentInteractionData) GetCustomId() string {
@@ -13177,7 +13938,7 @@ type MessageComponentInteractionDataResolved struct {
 
 func (x *MessageComponentInteractionDataResolved) Reset() {
 	*x = MessageComponentInteractionDataResolved{}
-	mi := &file_discord_v1_discordgo_proto_msgTypes[133]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13189,7 +13950,7 @@ func (x *MessageComponentInteractionDataResolved) String() string {
 func (*MessageComponentInteractionDataResolved) ProtoMessage() {}
 
 func (x *MessageComponentInteractionDataResolved) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_discordgo_proto_msgTypes[133]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13202,7 +13963,7 @@ func (x *MessageComponentInteractionDataResolved) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use MessageComponentInteractionDataResolved.ProtoReflect.Descriptor instead.
 func (*MessageComponentInteractionDataResolved) Descriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{133}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{140}
 }
 
 func (x *MessageComponentInteractionDataResolved) GetUsers() map[string]*User {
@@ -13237,14 +13998,14 @@ func (x *MessageComponentInteractionDataResolved) GetChannels() map[string]*Chan
 type ModalSubmitInteractionData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CustomId      string                 `protobuf:"bytes,1,opt,name=custom_id,json=customId,proto3" json:"custom_id,omitempty"`
-	Components    []*anypb.Any           `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"` // Go code had json:"-" but it's data
+	Components    []*MessageComponent    `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"` // Go code had json:"-" but it's data
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ModalSubmitInteractionData) Reset() {
 	*x = ModalSubmitInteractionData{}
-	mi := &file_discord_v1_discordgo_proto_msgTypes[134]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13256,7 +14017,7 @@ func (x *ModalSubmitInteractionData) String() string {
 func (*ModalSubmitInteractionData) ProtoMessage() {}
 
 func (x *ModalSubmitInteractionData) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_discordgo_proto_msgTypes[134]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13269,7 +14030,7 @@ func (x *ModalSubmitInteractionData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModalSubmitInteractionData.ProtoReflect.Descriptor instead.
 func (*ModalSubmitInteractionData) Descriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{134}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{141}
 }
 
 func (x *ModalSubmitInteractionData) GetCustomId() string {
@@ -13279,7 +14040,7 @@ func (x *ModalSubmitInteractionData) GetCustomId() string {
 	return ""
 }
 
-func (x *ModalSubmitInteractionData) GetComponents() []*anypb.Any {
+func (x *ModalSubmitInteractionData) GetComponents() []*MessageComponent {
 	if x != nil {
 		return x.Components
 	}
@@ -13315,7 +14076,7 @@ type ApplicationCommandInteractionDataOption struct {
 
 func (x *ApplicationCommandInteractionDataOption) Reset() {
 	*x = ApplicationCommandInteractionDataOption{}
-	mi := &file_discord_v1_discordgo_proto_msgTypes[135]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13327,7 +14088,7 @@ func (x *ApplicationCommandInteractionDataOption) String() string {
 func (*ApplicationCommandInteractionDataOption) ProtoMessage() {}
 
 func (x *ApplicationCommandInteractionDataOption) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_discordgo_proto_msgTypes[135]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13340,7 +14101,7 @@ func (x *ApplicationCommandInteractionDataOption) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use ApplicationCommandInteractionDataOption.ProtoReflect.Descriptor instead.
 func (*ApplicationCommandInteractionDataOption) Descriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{135}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{142}
 }
 
 func (x *ApplicationCommandInteractionDataOption) GetName() string {
@@ -13537,7 +14298,7 @@ type InteractionResponse struct {
 
 func (x *InteractionResponse) Reset() {
 	*x = InteractionResponse{}
-	mi := &file_discord_v1_discordgo_proto_msgTypes[136]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13549,7 +14310,7 @@ func (x *InteractionResponse) String() string {
 func (*InteractionResponse) ProtoMessage() {}
 
 func (x *InteractionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_discordgo_proto_msgTypes[136]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13562,7 +14323,7 @@ func (x *InteractionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InteractionResponse.ProtoReflect.Descriptor instead.
 func (*InteractionResponse) Descriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{136}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{143}
 }
 
 func (x *InteractionResponse) GetType() InteractionResponseType {
@@ -13584,14 +14345,15 @@ type InteractionResponseData struct {
 	state           protoimpl.MessageState  `protogen:"open.v1"`
 	Tts             bool                    `protobuf:"varint,1,opt,name=tts,proto3" json:"tts,omitempty"`
 	Content         string                  `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Components      []*anypb.Any            `protobuf:"bytes,3,rep,name=components,proto3" json:"components,omitempty"`
+	Components      []*MessageComponent     `protobuf:"bytes,3,rep,name=components,proto3" json:"components,omitempty"`
 	Embeds          []*MessageEmbed         `protobuf:"bytes,4,rep,name=embeds,proto3" json:"embeds,omitempty"`
 	AllowedMentions *MessageAllowedMentions `protobuf:"bytes,5,opt,name=allowed_mentions,json=allowedMentions,proto3,oneof" json:"allowed_mentions,omitempty"`
 	Files           []*File                 `protobuf:"bytes,6,rep,name=files,proto3" json:"files,omitempty"`             // Go had json:"-" but it's data
 	Attachments     []*MessageAttachment    `protobuf:"bytes,7,rep,name=attachments,proto3" json:"attachments,omitempty"` // Go had pointer to slice
 	Poll            *Poll                   `protobuf:"bytes,8,opt,name=poll,proto3,oneof" json:"poll,omitempty"`
 	// NOTE: only MessageFlagsSuppressEmbeds and MessageFlagsEphemeral can be set.
-	Flags *MessageFlags `protobuf:"varint,9,opt,name=flags,proto3,enum=discord_v1.MessageFlags,oneof" json:"flags,omitempty"` // Go had int64/uint64 directly
+	// Bitmask of the discordgo MessageFlags (the MessageFlags enum holds bit positions, not values).
+	Flags int64 `protobuf:"varint,9,opt,name=flags,proto3" json:"flags,omitempty"`
 	// NOTE: autocomplete interaction only. Repeated choices.
 	Choices []*ApplicationCommandOptionChoice `protobuf:"bytes,10,rep,name=choices,proto3" json:"choices,omitempty"`
 	// NOTE: modal interaction only.
@@ -13603,7 +14365,7 @@ type InteractionResponseData struct {
 
 func (x *InteractionResponseData) Reset() {
 	*x = InteractionResponseData{}
-	mi := &file_discord_v1_discordgo_proto_msgTypes[137]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13615,7 +14377,7 @@ func (x *InteractionResponseData) String() string {
 func (*InteractionResponseData) ProtoMessage() {}
 
 func (x *InteractionResponseData) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_discordgo_proto_msgTypes[137]
+	mi := &file_discord_v1_discordgo_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13628,7 +14390,7 @@ func (x *InteractionResponseData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InteractionResponseData.ProtoReflect.Descriptor instead.
 func (*InteractionResponseData) Descriptor() ([]byte, []int) {
-	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{137}
+	return file_discord_v1_discordgo_proto_rawDescGZIP(), []int{144}
 }
 
 func (x *InteractionResponseData) GetTts() bool {
@@ -13645,7 +14407,7 @@ func (x *InteractionResponseData) GetContent() string {
 	return ""
 }
 
-func (x *InteractionResponseData) GetComponents() []*anypb.Any {
+func (x *InteractionResponseData) GetComponents() []*MessageComponent {
 	if x != nil {
 		return x.Components
 	}
@@ -13687,11 +14449,11 @@ func (x *InteractionResponseData) GetPoll() *Poll {
 	return nil
 }
 
-func (x *InteractionResponseData) GetFlags() MessageFlags {
-	if x != nil && x.Flags != nil {
-		return *x.Flags
+func (x *InteractionResponseData) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
 	}
-	return MessageFlags_MESSAGE_FLAGS_CROSS_POSTED
+	return 0
 }
 
 func (x *InteractionResponseData) GetChoices() []*ApplicationCommandOptionChoice {
@@ -13720,7 +14482,7 @@ var File_discord_v1_discordgo_proto protoreflect.FileDescriptor
 const file_discord_v1_discordgo_proto_rawDesc = "" +
 	"\n" +
 	"\x1adiscord-v1/discordgo.proto\x12\n" +
-	"discord_v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x19google/protobuf/any.proto\"\x8f\f\n" +
+	"discord_v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\"\x97\f\n" +
 	"\aMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -13734,9 +14496,9 @@ const file_discord_v1_discordgo_proto_rawDesc = "" +
 	"\x10mention_everyone\x18\t \x01(\bR\x0fmentionEveryone\x12(\n" +
 	"\x06author\x18\n" +
 	" \x01(\v2\x10.discord_v1.UserR\x06author\x12?\n" +
-	"\vattachments\x18\v \x03(\v2\x1d.discord_v1.MessageAttachmentR\vattachments\x124\n" +
+	"\vattachments\x18\v \x03(\v2\x1d.discord_v1.MessageAttachmentR\vattachments\x12<\n" +
 	"\n" +
-	"components\x18\f \x03(\v2\x14.google.protobuf.AnyR\n" +
+	"components\x18\f \x03(\v2\x1c.discord_v1.MessageComponentR\n" +
 	"components\x120\n" +
 	"\x06embeds\x18\r \x03(\v2\x18.discord_v1.MessageEmbedR\x06embeds\x12,\n" +
 	"\bmentions\x18\x0e \x03(\v2\x10.discord_v1.UserR\bmentions\x12:\n" +
@@ -13757,16 +14519,18 @@ const file_discord_v1_discordgo_proto_rawDesc = "" +
 	"\x05flags\x18\x1c \x01(\x0e2\x18.discord_v1.MessageFlagsR\x05flags\x12+\n" +
 	"\x06thread\x18\x1d \x01(\v2\x13.discord_v1.ChannelR\x06thread\x12<\n" +
 	"\rsticker_items\x18\x1e \x03(\v2\x17.discord_v1.StickerItemR\fstickerItems\x12$\n" +
-	"\x04poll\x18\x1f \x01(\v2\x10.discord_v1.PollR\x04poll\"=\n" +
+	"\x04poll\x18\x1f \x01(\v2\x10.discord_v1.PollR\x04poll\"n\n" +
 	"\x04File\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
-	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"\xcb\x03\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12\x1b\n" +
+	"\tupload_id\x18\x04 \x01(\tR\buploadId\"\xd3\x03\n" +
 	"\vMessageSend\x12\x18\n" +
 	"\acontent\x18\x01 \x01(\tR\acontent\x120\n" +
 	"\x06embeds\x18\x02 \x03(\v2\x18.discord_v1.MessageEmbedR\x06embeds\x12\x10\n" +
-	"\x03tts\x18\x03 \x01(\bR\x03tts\x124\n" +
+	"\x03tts\x18\x03 \x01(\bR\x03tts\x12<\n" +
 	"\n" +
-	"components\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\n" +
+	"components\x18\x04 \x03(\v2\x1c.discord_v1.MessageComponentR\n" +
 	"components\x12&\n" +
 	"\x05files\x18\x05 \x03(\v2\x10.discord_v1.FileR\x05files\x12M\n" +
 	"\x10allowed_mentions\x18\x06 \x01(\v2\".discord_v1.MessageAllowedMentionsR\x0fallowedMentions\x12:\n" +
@@ -13775,11 +14539,11 @@ const file_discord_v1_discordgo_proto_rawDesc = "" +
 	"stickerIds\x12.\n" +
 	"\x05flags\x18\t \x01(\x0e2\x18.discord_v1.MessageFlagsR\x05flags\x12$\n" +
 	"\x04poll\x18\n" +
-	" \x01(\v2\x10.discord_v1.PollR\x04poll\"\xa1\x03\n" +
+	" \x01(\v2\x10.discord_v1.PollR\x04poll\"\xd4\x03\n" +
 	"\vMessageEdit\x12\x18\n" +
-	"\acontent\x18\x01 \x01(\tR\acontent\x124\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12<\n" +
 	"\n" +
-	"components\x18\x02 \x03(\v2\x14.google.protobuf.AnyR\n" +
+	"components\x18\x02 \x03(\v2\x1c.discord_v1.MessageComponentR\n" +
 	"components\x120\n" +
 	"\x06embeds\x18\x03 \x03(\v2\x18.discord_v1.MessageEmbedR\x06embeds\x12M\n" +
 	"\x10allowed_mentions\x18\x04 \x01(\v2\".discord_v1.MessageAllowedMentionsR\x0fallowedMentions\x12.\n" +
@@ -13787,7 +14551,9 @@ const file_discord_v1_discordgo_proto_rawDesc = "" +
 	"\x05files\x18\x06 \x03(\v2\x10.discord_v1.FileR\x05files\x12?\n" +
 	"\vattachments\x18\a \x03(\v2\x1d.discord_v1.MessageAttachmentR\vattachments\x12\x0e\n" +
 	"\x02id\x18\b \x01(\tR\x02id\x12\x18\n" +
-	"\achannel\x18\t \x01(\tR\achannel\"\x9d\x01\n" +
+	"\achannel\x18\t \x01(\tR\achannel\x12)\n" +
+	"\x10clear_components\x18\n" +
+	" \x01(\bR\x0fclearComponents\"\x9d\x01\n" +
 	"\x16MessageAllowedMentions\x124\n" +
 	"\x05parse\x18\x01 \x03(\x0e2\x1e.discord_v1.AllowedMentionTypeR\x05parse\x12\x14\n" +
 	"\x05roles\x18\x02 \x03(\tR\x05roles\x12\x14\n" +
@@ -14753,16 +15519,16 @@ const file_discord_v1_discordgo_proto_rawDesc = "" +
 	"\x05token\x18\b \x01(\tR\x05token\x12*\n" +
 	"\x0eapplication_id\x18\t \x01(\tH\x01R\rapplicationId\x88\x01\x01B\a\n" +
 	"\x05_userB\x11\n" +
-	"\x0f_application_id\"\xe9\x04\n" +
+	"\x0f_application_id\"\xf1\x04\n" +
 	"\rWebhookParams\x12\x1d\n" +
 	"\acontent\x18\x01 \x01(\tH\x00R\acontent\x88\x01\x01\x12\x1f\n" +
 	"\busername\x18\x02 \x01(\tH\x01R\busername\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"avatar_url\x18\x03 \x01(\tH\x02R\tavatarUrl\x88\x01\x01\x12\x15\n" +
 	"\x03tts\x18\x04 \x01(\bH\x03R\x03tts\x88\x01\x01\x12&\n" +
-	"\x05files\x18\x05 \x03(\v2\x10.discord_v1.FileR\x05files\x124\n" +
+	"\x05files\x18\x05 \x03(\v2\x10.discord_v1.FileR\x05files\x12<\n" +
 	"\n" +
-	"components\x18\x06 \x03(\v2\x14.google.protobuf.AnyR\n" +
+	"components\x18\x06 \x03(\v2\x1c.discord_v1.MessageComponentR\n" +
 	"components\x120\n" +
 	"\x06embeds\x18\a \x03(\v2\x18.discord_v1.MessageEmbedR\x06embeds\x12?\n" +
 	"\vattachments\x18\b \x03(\v2\x1d.discord_v1.MessageAttachmentR\vattachments\x12R\n" +
@@ -14778,23 +15544,81 @@ const file_discord_v1_discordgo_proto_rawDesc = "" +
 	"\x04_ttsB\x13\n" +
 	"\x11_allowed_mentionsB\b\n" +
 	"\x06_flagsB\x0e\n" +
-	"\f_thread_name\"\xf2\x02\n" +
+	"\f_thread_name\"\xa5\x03\n" +
 	"\vWebhookEdit\x12\x1d\n" +
-	"\acontent\x18\x01 \x01(\tH\x00R\acontent\x88\x01\x01\x124\n" +
+	"\acontent\x18\x01 \x01(\tH\x00R\acontent\x88\x01\x01\x12<\n" +
 	"\n" +
-	"components\x18\x02 \x03(\v2\x14.google.protobuf.AnyR\n" +
+	"components\x18\x02 \x03(\v2\x1c.discord_v1.MessageComponentR\n" +
 	"components\x120\n" +
 	"\x06embeds\x18\x03 \x03(\v2\x18.discord_v1.MessageEmbedR\x06embeds\x12&\n" +
 	"\x05files\x18\x04 \x03(\v2\x10.discord_v1.FileR\x05files\x12?\n" +
 	"\vattachments\x18\x05 \x03(\v2\x1d.discord_v1.MessageAttachmentR\vattachments\x12R\n" +
-	"\x10allowed_mentions\x18\x06 \x01(\v2\".discord_v1.MessageAllowedMentionsH\x01R\x0fallowedMentions\x88\x01\x01B\n" +
+	"\x10allowed_mentions\x18\x06 \x01(\v2\".discord_v1.MessageAllowedMentionsH\x01R\x0fallowedMentions\x88\x01\x01\x12)\n" +
+	"\x10clear_components\x18\a \x01(\bR\x0fclearComponentsB\n" +
 	"\n" +
 	"\b_contentB\x13\n" +
 	"\x11_allowed_mentions\"P\n" +
 	"\x0eComponentEmoji\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1a\n" +
-	"\banimated\x18\x03 \x01(\bR\banimated\"\xa6\b\n" +
+	"\banimated\x18\x03 \x01(\bR\banimated\"\xfb\x01\n" +
+	"\x10MessageComponent\x129\n" +
+	"\vactions_row\x18\x01 \x01(\v2\x16.discord_v1.ActionsRowH\x00R\n" +
+	"actionsRow\x12,\n" +
+	"\x06button\x18\x02 \x01(\v2\x12.discord_v1.ButtonH\x00R\x06button\x129\n" +
+	"\vselect_menu\x18\x03 \x01(\v2\x16.discord_v1.SelectMenuH\x00R\n" +
+	"selectMenu\x126\n" +
+	"\n" +
+	"text_input\x18\x04 \x01(\v2\x15.discord_v1.TextInputH\x00R\ttextInputB\v\n" +
+	"\tcomponent\"J\n" +
+	"\n" +
+	"ActionsRow\x12<\n" +
+	"\n" +
+	"components\x18\x01 \x03(\v2\x1c.discord_v1.MessageComponentR\n" +
+	"components\"\xd9\x01\n" +
+	"\x06Button\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12-\n" +
+	"\x05style\x18\x02 \x01(\x0e2\x17.discord_v1.ButtonStyleR\x05style\x12\x1a\n" +
+	"\bdisabled\x18\x03 \x01(\bR\bdisabled\x125\n" +
+	"\x05emoji\x18\x04 \x01(\v2\x1a.discord_v1.ComponentEmojiH\x00R\x05emoji\x88\x01\x01\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\x12\x1b\n" +
+	"\tcustom_id\x18\x06 \x01(\tR\bcustomIdB\b\n" +
+	"\x06_emoji\"<\n" +
+	"\x16SelectMenuDefaultValue\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\"\xbb\x01\n" +
+	"\x10SelectMenuOption\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x125\n" +
+	"\x05emoji\x18\x04 \x01(\v2\x1a.discord_v1.ComponentEmojiH\x00R\x05emoji\x88\x01\x01\x12\x18\n" +
+	"\adefault\x18\x05 \x01(\bR\adefaultB\b\n" +
+	"\x06_emoji\"\xb2\x03\n" +
+	"\n" +
+	"SelectMenu\x126\n" +
+	"\tmenu_type\x18\x01 \x01(\x0e2\x19.discord_v1.ComponentTypeR\bmenuType\x12\x1b\n" +
+	"\tcustom_id\x18\x02 \x01(\tR\bcustomId\x12 \n" +
+	"\vplaceholder\x18\x03 \x01(\tR\vplaceholder\x12\"\n" +
+	"\n" +
+	"min_values\x18\x04 \x01(\x05H\x00R\tminValues\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"max_values\x18\x05 \x01(\x05R\tmaxValues\x12I\n" +
+	"\x0edefault_values\x18\x06 \x03(\v2\".discord_v1.SelectMenuDefaultValueR\rdefaultValues\x126\n" +
+	"\aoptions\x18\a \x03(\v2\x1c.discord_v1.SelectMenuOptionR\aoptions\x12\x1a\n" +
+	"\bdisabled\x18\b \x01(\bR\bdisabled\x12<\n" +
+	"\rchannel_types\x18\t \x03(\x0e2\x17.discord_v1.ChannelTypeR\fchannelTypesB\r\n" +
+	"\v_min_values\"\x82\x02\n" +
+	"\tTextInput\x12\x1b\n" +
+	"\tcustom_id\x18\x01 \x01(\tR\bcustomId\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x120\n" +
+	"\x05style\x18\x03 \x01(\x0e2\x1a.discord_v1.TextInputStyleR\x05style\x12 \n" +
+	"\vplaceholder\x18\x04 \x01(\tR\vplaceholder\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\tR\x05value\x12\x1a\n" +
+	"\brequired\x18\x06 \x01(\bR\brequired\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\a \x01(\x05R\tminLength\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\b \x01(\x05R\tmaxLength\"\xa6\b\n" +
 	"\x12ApplicationCommand\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
 	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\x12\x19\n" +
@@ -14874,14 +15698,15 @@ const file_discord_v1_discordgo_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
 	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\x12\x19\n" +
 	"\bguild_id\x18\x03 \x01(\tR\aguildId\x12K\n" +
-	"\vpermissions\x18\x04 \x03(\v2).discord_v1.ApplicationCommandPermissionsR\vpermissions\"\xf4\b\n" +
+	"\vpermissions\x18\x04 \x03(\v2).discord_v1.ApplicationCommandPermissionsR\vpermissions\"\xd2\t\n" +
 	"\vInteraction\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
 	"\x06app_id\x18\x02 \x01(\tR\x05appId\x12/\n" +
 	"\x04type\x18\x03 \x01(\x0e2\x1b.discord_v1.InteractionTypeR\x04type\x12i\n" +
 	"\x18application_command_data\x18\x04 \x01(\v2-.discord_v1.ApplicationCommandInteractionDataH\x00R\x16applicationCommandData\x12c\n" +
 	"\x16message_component_data\x18\x05 \x01(\v2+.discord_v1.MessageComponentInteractionDataH\x00R\x14messageComponentData\x12T\n" +
-	"\x11modal_submit_data\x18\x06 \x01(\v2&.discord_v1.ModalSubmitInteractionDataH\x00R\x0fmodalSubmitData\x12\x19\n" +
+	"\x11modal_submit_data\x18\x06 \x01(\v2&.discord_v1.ModalSubmitInteractionDataH\x00R\x0fmodalSubmitData\x12\\\n" +
+	"\x11autocomplete_data\x18\a \x01(\v2-.discord_v1.ApplicationCommandInteractionDataH\x00R\x10autocompleteData\x12\x19\n" +
 	"\bguild_id\x18\b \x01(\tR\aguildId\x12\x1d\n" +
 	"\n" +
 	"channel_id\x18\t \x01(\tR\tchannelId\x122\n" +
@@ -14965,11 +15790,11 @@ const file_discord_v1_discordgo_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2\x10.discord_v1.RoleR\x05value:\x028\x01\x1aP\n" +
 	"\rChannelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.discord_v1.ChannelR\x05value:\x028\x01\"o\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.discord_v1.ChannelR\x05value:\x028\x01\"w\n" +
 	"\x1aModalSubmitInteractionData\x12\x1b\n" +
-	"\tcustom_id\x18\x01 \x01(\tR\bcustomId\x124\n" +
+	"\tcustom_id\x18\x01 \x01(\tR\bcustomId\x12<\n" +
 	"\n" +
-	"components\x18\x02 \x03(\v2\x14.google.protobuf.AnyR\n" +
+	"components\x18\x02 \x03(\v2\x1c.discord_v1.MessageComponentR\n" +
 	"components\"\xe3\x04\n" +
 	"'ApplicationCommandInteractionDataOption\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12<\n" +
@@ -14990,26 +15815,25 @@ const file_discord_v1_discordgo_proto_rawDesc = "" +
 	"\x13InteractionResponse\x127\n" +
 	"\x04type\x18\x01 \x01(\x0e2#.discord_v1.InteractionResponseTypeR\x04type\x12<\n" +
 	"\x04data\x18\x02 \x01(\v2#.discord_v1.InteractionResponseDataH\x00R\x04data\x88\x01\x01B\a\n" +
-	"\x05_data\"\xeb\x04\n" +
+	"\x05_data\"\xca\x04\n" +
 	"\x17InteractionResponseData\x12\x10\n" +
 	"\x03tts\x18\x01 \x01(\bR\x03tts\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\x124\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12<\n" +
 	"\n" +
-	"components\x18\x03 \x03(\v2\x14.google.protobuf.AnyR\n" +
+	"components\x18\x03 \x03(\v2\x1c.discord_v1.MessageComponentR\n" +
 	"components\x120\n" +
 	"\x06embeds\x18\x04 \x03(\v2\x18.discord_v1.MessageEmbedR\x06embeds\x12R\n" +
 	"\x10allowed_mentions\x18\x05 \x01(\v2\".discord_v1.MessageAllowedMentionsH\x00R\x0fallowedMentions\x88\x01\x01\x12&\n" +
 	"\x05files\x18\x06 \x03(\v2\x10.discord_v1.FileR\x05files\x12?\n" +
 	"\vattachments\x18\a \x03(\v2\x1d.discord_v1.MessageAttachmentR\vattachments\x12)\n" +
-	"\x04poll\x18\b \x01(\v2\x10.discord_v1.PollH\x01R\x04poll\x88\x01\x01\x123\n" +
-	"\x05flags\x18\t \x01(\x0e2\x18.discord_v1.MessageFlagsH\x02R\x05flags\x88\x01\x01\x12D\n" +
+	"\x04poll\x18\b \x01(\v2\x10.discord_v1.PollH\x01R\x04poll\x88\x01\x01\x12\x14\n" +
+	"\x05flags\x18\t \x01(\x03R\x05flags\x12D\n" +
 	"\achoices\x18\n" +
 	" \x03(\v2*.discord_v1.ApplicationCommandOptionChoiceR\achoices\x12\x1b\n" +
 	"\tcustom_id\x18\v \x01(\tR\bcustomId\x12\x14\n" +
 	"\x05title\x18\f \x01(\tR\x05titleB\x13\n" +
 	"\x11_allowed_mentionsB\a\n" +
-	"\x05_pollB\b\n" +
-	"\x06_flags*\xaf\x06\n" +
+	"\x05_poll*\xaf\x06\n" +
 	"\vMessageType\x12\x18\n" +
 	"\x14MESSAGE_TYPE_DEFAULT\x10\x00\x12\x1e\n" +
 	"\x1aMESSAGE_TYPE_RECIPIENT_ADD\x10\x01\x12!\n" +
@@ -15098,13 +15922,28 @@ const file_discord_v1_discordgo_proto_rawDesc = "" +
 	"\vChannelType\x12\x18\n" +
 	"\x14CHANNEL_TYPE_UNKNOWN\x10\x00\x12\x1b\n" +
 	"\x17CHANNEL_TYPE_GUILD_TEXT\x10\x01\x12\x13\n" +
-	"\x0fCHANNEL_TYPE_DM\x10\x03*\xa4\x01\n" +
+	"\x0fCHANNEL_TYPE_DM\x10\x03*\xc2\x02\n" +
 	"\rComponentType\x12\x1a\n" +
 	"\x16COMPONENT_TYPE_UNKNOWN\x10\x00\x12\x1d\n" +
 	"\x19COMPONENT_TYPE_ACTION_ROW\x10\x01\x12\x19\n" +
 	"\x15COMPONENT_TYPE_BUTTON\x10\x02\x12\x1e\n" +
 	"\x1aCOMPONENT_TYPE_SELECT_MENU\x10\x03\x12\x1d\n" +
-	"\x19COMPONENT_TYPE_TEXT_INPUT\x10\x04*\x9b\x01\n" +
+	"\x19COMPONENT_TYPE_TEXT_INPUT\x10\x04\x12#\n" +
+	"\x1fCOMPONENT_TYPE_USER_SELECT_MENU\x10\x05\x12#\n" +
+	"\x1fCOMPONENT_TYPE_ROLE_SELECT_MENU\x10\x06\x12*\n" +
+	"&COMPONENT_TYPE_MENTIONABLE_SELECT_MENU\x10\a\x12&\n" +
+	"\"COMPONENT_TYPE_CHANNEL_SELECT_MENU\x10\b*\xa7\x01\n" +
+	"\vButtonStyle\x12\x18\n" +
+	"\x14BUTTON_STYLE_UNKNOWN\x10\x00\x12\x18\n" +
+	"\x14BUTTON_STYLE_PRIMARY\x10\x01\x12\x1a\n" +
+	"\x16BUTTON_STYLE_SECONDARY\x10\x02\x12\x18\n" +
+	"\x14BUTTON_STYLE_SUCCESS\x10\x03\x12\x17\n" +
+	"\x13BUTTON_STYLE_DANGER\x10\x04\x12\x15\n" +
+	"\x11BUTTON_STYLE_LINK\x10\x05*j\n" +
+	"\x0eTextInputStyle\x12\x1c\n" +
+	"\x18TEXT_INPUT_STYLE_UNKNOWN\x10\x00\x12\x1a\n" +
+	"\x16TEXT_INPUT_STYLE_SHORT\x10\x01\x12\x1e\n" +
+	"\x1aTEXT_INPUT_STYLE_PARAGRAPH\x10\x02*\x9b\x01\n" +
 	"\x16ApplicationCommandType\x12$\n" +
 	" APPLICATION_COMMAND_TYPE_UNKNOWN\x10\x00\x12\x1c\n" +
 	"\x18CHAT_APPLICATION_COMMAND\x10\x01\x12\x1c\n" +
@@ -15167,8 +16006,8 @@ func file_discord_v1_discordgo_proto_rawDescGZIP() []byte {
 	return file_discord_v1_discordgo_proto_rawDescData
 }
 
-var file_discord_v1_discordgo_proto_enumTypes = make([]protoimpl.EnumInfo, 19)
-var file_discord_v1_discordgo_proto_msgTypes = make([]protoimpl.MessageInfo, 161)
+var file_discord_v1_discordgo_proto_enumTypes = make([]protoimpl.EnumInfo, 21)
+var file_discord_v1_discordgo_proto_msgTypes = make([]protoimpl.MessageInfo, 168)
 var file_discord_v1_discordgo_proto_goTypes = []any{
 	(MessageType)(0),                                  // 0: discord_v1.MessageType
 	(MessageFlags)(0),                                 // 1: discord_v1.MessageFlags
@@ -15182,441 +16021,462 @@ var file_discord_v1_discordgo_proto_goTypes = []any{
 	(WebhookType)(0),                                  // 9: discord_v1.WebhookType
 	(ChannelType)(0),                                  // 10: discord_v1.ChannelType
 	(ComponentType)(0),                                // 11: discord_v1.ComponentType
-	(ApplicationCommandType)(0),                       // 12: discord_v1.ApplicationCommandType
-	(InteractionContextType)(0),                       // 13: discord_v1.InteractionContextType
-	(ApplicationIntegrationType)(0),                   // 14: discord_v1.ApplicationIntegrationType
-	(ApplicationCommandOptionType)(0),                 // 15: discord_v1.ApplicationCommandOptionType
-	(ApplicationCommandPermissionType)(0),             // 16: discord_v1.ApplicationCommandPermissionType
-	(InteractionType)(0),                              // 17: discord_v1.InteractionType
-	(InteractionResponseType)(0),                      // 18: discord_v1.InteractionResponseType
-	(*Message)(nil),                                   // 19: discord_v1.Message
-	(*File)(nil),                                      // 20: discord_v1.File
-	(*MessageSend)(nil),                               // 21: discord_v1.MessageSend
-	(*MessageEdit)(nil),                               // 22: discord_v1.MessageEdit
-	(*MessageAllowedMentions)(nil),                    // 23: discord_v1.MessageAllowedMentions
-	(*MessageAttachment)(nil),                         // 24: discord_v1.MessageAttachment
-	(*MessageEmbedFooter)(nil),                        // 25: discord_v1.MessageEmbedFooter
-	(*MessageEmbedImage)(nil),                         // 26: discord_v1.MessageEmbedImage
-	(*MessageEmbedThumbnail)(nil),                     // 27: discord_v1.MessageEmbedThumbnail
-	(*MessageEmbedVideo)(nil),                         // 28: discord_v1.MessageEmbedVideo
-	(*MessageEmbedProvider)(nil),                      // 29: discord_v1.MessageEmbedProvider
-	(*MessageEmbedAuthor)(nil),                        // 30: discord_v1.MessageEmbedAuthor
-	(*MessageEmbedField)(nil),                         // 31: discord_v1.MessageEmbedField
-	(*MessageEmbed)(nil),                              // 32: discord_v1.MessageEmbed
-	(*MessageReactions)(nil),                          // 33: discord_v1.MessageReactions
-	(*MessageActivity)(nil),                           // 34: discord_v1.MessageActivity
-	(*MessageApplication)(nil),                        // 35: discord_v1.MessageApplication
-	(*MessageSnapshot)(nil),                           // 36: discord_v1.MessageSnapshot
-	(*MessageReference)(nil),                          // 37: discord_v1.MessageReference
-	(*MessageInteraction)(nil),                        // 38: discord_v1.MessageInteraction
-	(*MessageInteractionMetadata)(nil),                // 39: discord_v1.MessageInteractionMetadata
-	(*Session)(nil),                                   // 40: discord_v1.Session
-	(*Identify)(nil),                                  // 41: discord_v1.Identify
-	(*IdentifyProperties)(nil),                        // 42: discord_v1.IdentifyProperties
-	(*GatewayStatusUpdate)(nil),                       // 43: discord_v1.GatewayStatusUpdate
-	(*Activity)(nil),                                  // 44: discord_v1.Activity
-	(*TimeStamps)(nil),                                // 45: discord_v1.TimeStamps
-	(*Assets)(nil),                                    // 46: discord_v1.Assets
-	(*Party)(nil),                                     // 47: discord_v1.Party
-	(*Secrets)(nil),                                   // 48: discord_v1.Secrets
-	(*ApplicationIntegrationTypeConfig)(nil),          // 49: discord_v1.ApplicationIntegrationTypeConfig
-	(*ApplicationInstallParams)(nil),                  // 50: discord_v1.ApplicationInstallParams
-	(*Application)(nil),                               // 51: discord_v1.Application
-	(*ApplicationRoleConnectionMetadata)(nil),         // 52: discord_v1.ApplicationRoleConnectionMetadata
-	(*ApplicationRoleConnection)(nil),                 // 53: discord_v1.ApplicationRoleConnection
-	(*UserConnection)(nil),                            // 54: discord_v1.UserConnection
-	(*Integration)(nil),                               // 55: discord_v1.Integration
-	(*IntegrationAccount)(nil),                        // 56: discord_v1.IntegrationAccount
-	(*VoiceRegion)(nil),                               // 57: discord_v1.VoiceRegion
-	(*Invite)(nil),                                    // 58: discord_v1.Invite
-	(*Channel)(nil),                                   // 59: discord_v1.Channel
-	(*PermissionOverwrite)(nil),                       // 60: discord_v1.PermissionOverwrite
-	(*ThreadStart)(nil),                               // 61: discord_v1.ThreadStart
-	(*ThreadMetadata)(nil),                            // 62: discord_v1.ThreadMetadata
-	(*ThreadMember)(nil),                              // 63: discord_v1.ThreadMember
-	(*ThreadsList)(nil),                               // 64: discord_v1.ThreadsList
-	(*AddedThreadMember)(nil),                         // 65: discord_v1.AddedThreadMember
-	(*ForumDefaultReaction)(nil),                      // 66: discord_v1.ForumDefaultReaction
-	(*ForumTag)(nil),                                  // 67: discord_v1.ForumTag
-	(*Emoji)(nil),                                     // 68: discord_v1.Emoji
-	(*EmojiParams)(nil),                               // 69: discord_v1.EmojiParams
-	(*Sticker)(nil),                                   // 70: discord_v1.Sticker
-	(*StickerItem)(nil),                               // 71: discord_v1.StickerItem
-	(*StickerPack)(nil),                               // 72: discord_v1.StickerPack
-	(*Guild)(nil),                                     // 73: discord_v1.Guild
-	(*GuildPreview)(nil),                              // 74: discord_v1.GuildPreview
-	(*GuildScheduledEvent)(nil),                       // 75: discord_v1.GuildScheduledEvent
-	(*GuildScheduledEventParams)(nil),                 // 76: discord_v1.GuildScheduledEventParams
-	(*GuildScheduledEventEntityMetadata)(nil),         // 77: discord_v1.GuildScheduledEventEntityMetadata
-	(*GuildScheduledEventUser)(nil),                   // 78: discord_v1.GuildScheduledEventUser
-	(*GuildOnboardingMode)(nil),                       // 79: discord_v1.GuildOnboardingMode
-	(*GuildOnboarding)(nil),                           // 80: discord_v1.GuildOnboarding
-	(*GuildOnboardingPromptType)(nil),                 // 81: discord_v1.GuildOnboardingPromptType
-	(*GuildOnboardingPrompt)(nil),                     // 82: discord_v1.GuildOnboardingPrompt
-	(*GuildOnboardingPromptOption)(nil),               // 83: discord_v1.GuildOnboardingPromptOption
-	(*GuildTemplate)(nil),                             // 84: discord_v1.GuildTemplate
-	(*GuildTemplateParams)(nil),                       // 85: discord_v1.GuildTemplateParams
-	(*UserGuild)(nil),                                 // 86: discord_v1.UserGuild
-	(*GuildParams)(nil),                               // 87: discord_v1.GuildParams
-	(*Role)(nil),                                      // 88: discord_v1.Role
-	(*RoleFlags)(nil),                                 // 89: discord_v1.RoleFlags
-	(*RoleParams)(nil),                                // 90: discord_v1.RoleParams
-	(*VoiceState)(nil),                                // 91: discord_v1.VoiceState
-	(*Presence)(nil),                                  // 92: discord_v1.Presence
-	(*MemberFlags)(nil),                               // 93: discord_v1.MemberFlags
-	(*Member)(nil),                                    // 94: discord_v1.Member
-	(*ClientStatus)(nil),                              // 95: discord_v1.ClientStatus
-	(*TooManyRequests)(nil),                           // 96: discord_v1.TooManyRequests
-	(*ReadState)(nil),                                 // 97: discord_v1.ReadState
-	(*GuildRole)(nil),                                 // 98: discord_v1.GuildRole
-	(*GuildBan)(nil),                                  // 99: discord_v1.GuildBan
-	(*AutoModerationRule)(nil),                        // 100: discord_v1.AutoModerationRule
-	(*AutoModerationTriggerMetadata)(nil),             // 101: discord_v1.AutoModerationTriggerMetadata
-	(*AutoModerationActionType)(nil),                  // 102: discord_v1.AutoModerationActionType
-	(*AutoModerationActionMetadata)(nil),              // 103: discord_v1.AutoModerationActionMetadata
-	(*AutoModerationAction)(nil),                      // 104: discord_v1.AutoModerationAction
-	(*GuildEmbed)(nil),                                // 105: discord_v1.GuildEmbed
-	(*GuildAuditLog)(nil),                             // 106: discord_v1.GuildAuditLog
-	(*AuditLogEntry)(nil),                             // 107: discord_v1.AuditLogEntry
-	(*AuditLogChange)(nil),                            // 108: discord_v1.AuditLogChange
-	(*AuditLogOptions)(nil),                           // 109: discord_v1.AuditLogOptions
-	(*GuildMemberParams)(nil),                         // 110: discord_v1.GuildMemberParams
-	(*GuildMemberAddParams)(nil),                      // 111: discord_v1.GuildMemberAddParams
-	(*APIErrorMessage)(nil),                           // 112: discord_v1.APIErrorMessage
-	(*MessageReaction)(nil),                           // 113: discord_v1.MessageReaction
-	(*GatewayBotResponse)(nil),                        // 114: discord_v1.GatewayBotResponse
-	(*SessionInformation)(nil),                        // 115: discord_v1.SessionInformation
-	(*StageInstance)(nil),                             // 116: discord_v1.StageInstance
-	(*StageInstanceParams)(nil),                       // 117: discord_v1.StageInstanceParams
-	(*PollLayoutType)(nil),                            // 118: discord_v1.PollLayoutType
-	(*PollMedia)(nil),                                 // 119: discord_v1.PollMedia
-	(*PollAnswer)(nil),                                // 120: discord_v1.PollAnswer
-	(*PollAnswerCount)(nil),                           // 121: discord_v1.PollAnswerCount
-	(*PollResults)(nil),                               // 122: discord_v1.PollResults
-	(*Poll)(nil),                                      // 123: discord_v1.Poll
-	(*SKUType)(nil),                                   // 124: discord_v1.SKUType
-	(*SKUFlags)(nil),                                  // 125: discord_v1.SKUFlags
-	(*SKU)(nil),                                       // 126: discord_v1.SKU
-	(*SubscriptionStatus)(nil),                        // 127: discord_v1.SubscriptionStatus
-	(*Subscription)(nil),                              // 128: discord_v1.Subscription
-	(*EntitlementType)(nil),                           // 129: discord_v1.EntitlementType
-	(*Entitlement)(nil),                               // 130: discord_v1.Entitlement
-	(*EntitlementOwnerType)(nil),                      // 131: discord_v1.EntitlementOwnerType
-	(*EntitlementTest)(nil),                           // 132: discord_v1.EntitlementTest
-	(*EntitlementFilterOptions)(nil),                  // 133: discord_v1.EntitlementFilterOptions
-	(*State)(nil),                                     // 134: discord_v1.State
-	(*User)(nil),                                      // 135: discord_v1.User
-	(*TeamMember)(nil),                                // 136: discord_v1.TeamMember
-	(*Team)(nil),                                      // 137: discord_v1.Team
-	(*Webhook)(nil),                                   // 138: discord_v1.Webhook
-	(*WebhookParams)(nil),                             // 139: discord_v1.WebhookParams
-	(*WebhookEdit)(nil),                               // 140: discord_v1.WebhookEdit
-	(*ComponentEmoji)(nil),                            // 141: discord_v1.ComponentEmoji
-	(*ApplicationCommand)(nil),                        // 142: discord_v1.ApplicationCommand
-	(*ApplicationCommandOption)(nil),                  // 143: discord_v1.ApplicationCommandOption
-	(*ApplicationCommandOptionChoice)(nil),            // 144: discord_v1.ApplicationCommandOptionChoice
-	(*ApplicationCommandPermissions)(nil),             // 145: discord_v1.ApplicationCommandPermissions
-	(*ApplicationCommandPermissionsList)(nil),         // 146: discord_v1.ApplicationCommandPermissionsList
-	(*GuildApplicationCommandPermissions)(nil),        // 147: discord_v1.GuildApplicationCommandPermissions
-	(*Interaction)(nil),                               // 148: discord_v1.Interaction
-	(*ApplicationCommandInteractionData)(nil),         // 149: discord_v1.ApplicationCommandInteractionData
-	(*ApplicationCommandInteractionDataResolved)(nil), // 150: discord_v1.ApplicationCommandInteractionDataResolved
-	(*MessageComponentInteractionData)(nil),           // 151: discord_v1.MessageComponentInteractionData
-	(*MessageComponentInteractionDataResolved)(nil),   // 152: discord_v1.MessageComponentInteractionDataResolved
-	(*ModalSubmitInteractionData)(nil),                // 153: discord_v1.ModalSubmitInteractionData
-	(*ApplicationCommandInteractionDataOption)(nil),   // 154: discord_v1.ApplicationCommandInteractionDataOption
-	(*InteractionResponse)(nil),                       // 155: discord_v1.InteractionResponse
-	(*InteractionResponseData)(nil),                   // 156: discord_v1.InteractionResponseData
-	nil,                                               // 157: discord_v1.MessageInteractionMetadata.AuthorizingIntegrationOwnersEntry
-	nil,                                               // 158: discord_v1.Application.IntegrationTypesConfigEntry
-	nil,                                               // 159: discord_v1.ApplicationRoleConnectionMetadata.NameLocalizationsEntry
-	nil,                                               // 160: discord_v1.ApplicationRoleConnectionMetadata.DescriptionLocalizationsEntry
-	nil,                                               // 161: discord_v1.ApplicationRoleConnection.MetadataEntry
-	nil,                                               // 162: discord_v1.State.GuildMapEntry
-	nil,                                               // 163: discord_v1.State.ChannelMapEntry
-	nil,                                               // 164: discord_v1.ApplicationCommand.NameLocalizationsEntry
-	nil,                                               // 165: discord_v1.ApplicationCommand.DescriptionLocalizationsEntry
-	nil,                                               // 166: discord_v1.ApplicationCommandOption.NameLocalizationsEntry
-	nil,                                               // 167: discord_v1.ApplicationCommandOption.DescriptionLocalizationsEntry
-	nil,                                               // 168: discord_v1.ApplicationCommandOptionChoice.NameLocalizationsEntry
-	nil,                                               // 169: discord_v1.Interaction.AuthorizingIntegrationOwnersEntry
-	nil,                                               // 170: discord_v1.ApplicationCommandInteractionDataResolved.UsersEntry
-	nil,                                               // 171: discord_v1.ApplicationCommandInteractionDataResolved.MembersEntry
-	nil,                                               // 172: discord_v1.ApplicationCommandInteractionDataResolved.RolesEntry
-	nil,                                               // 173: discord_v1.ApplicationCommandInteractionDataResolved.ChannelsEntry
-	nil,                                               // 174: discord_v1.ApplicationCommandInteractionDataResolved.MessagesEntry
-	nil,                                               // 175: discord_v1.ApplicationCommandInteractionDataResolved.AttachmentsEntry
-	nil,                                               // 176: discord_v1.MessageComponentInteractionDataResolved.UsersEntry
-	nil,                                               // 177: discord_v1.MessageComponentInteractionDataResolved.MembersEntry
-	nil,                                               // 178: discord_v1.MessageComponentInteractionDataResolved.RolesEntry
-	nil,                                               // 179: discord_v1.MessageComponentInteractionDataResolved.ChannelsEntry
-	(*timestamppb.Timestamp)(nil),                     // 180: google.protobuf.Timestamp
-	(*anypb.Any)(nil),                                 // 181: google.protobuf.Any
-	(*durationpb.Duration)(nil),                       // 182: google.protobuf.Duration
+	(ButtonStyle)(0),                                  // 12: discord_v1.ButtonStyle
+	(TextInputStyle)(0),                               // 13: discord_v1.TextInputStyle
+	(ApplicationCommandType)(0),                       // 14: discord_v1.ApplicationCommandType
+	(InteractionContextType)(0),                       // 15: discord_v1.InteractionContextType
+	(ApplicationIntegrationType)(0),                   // 16: discord_v1.ApplicationIntegrationType
+	(ApplicationCommandOptionType)(0),                 // 17: discord_v1.ApplicationCommandOptionType
+	(ApplicationCommandPermissionType)(0),             // 18: discord_v1.ApplicationCommandPermissionType
+	(InteractionType)(0),                              // 19: discord_v1.InteractionType
+	(InteractionResponseType)(0),                      // 20: discord_v1.InteractionResponseType
+	(*Message)(nil),                                   // 21: discord_v1.Message
+	(*File)(nil),                                      // 22: discord_v1.File
+	(*MessageSend)(nil),                               // 23: discord_v1.MessageSend
+	(*MessageEdit)(nil),                               // 24: discord_v1.MessageEdit
+	(*MessageAllowedMentions)(nil),                    // 25: discord_v1.MessageAllowedMentions
+	(*MessageAttachment)(nil),                         // 26: discord_v1.MessageAttachment
+	(*MessageEmbedFooter)(nil),                        // 27: discord_v1.MessageEmbedFooter
+	(*MessageEmbedImage)(nil),                         // 28: discord_v1.MessageEmbedImage
+	(*MessageEmbedThumbnail)(nil),                     // 29: discord_v1.MessageEmbedThumbnail
+	(*MessageEmbedVideo)(nil),                         // 30: discord_v1.MessageEmbedVideo
+	(*MessageEmbedProvider)(nil),                      // 31: discord_v1.MessageEmbedProvider
+	(*MessageEmbedAuthor)(nil),                        // 32: discord_v1.MessageEmbedAuthor
+	(*MessageEmbedField)(nil),                         // 33: discord_v1.MessageEmbedField
+	(*MessageEmbed)(nil),                              // 34: discord_v1.MessageEmbed
+	(*MessageReactions)(nil),                          // 35: discord_v1.MessageReactions
+	(*MessageActivity)(nil),                           // 36: discord_v1.MessageActivity
+	(*MessageApplication)(nil),                        // 37: discord_v1.MessageApplication
+	(*MessageSnapshot)(nil),                           // 38: discord_v1.MessageSnapshot
+	(*MessageReference)(nil),                          // 39: discord_v1.MessageReference
+	(*MessageInteraction)(nil),                        // 40: discord_v1.MessageInteraction
+	(*MessageInteractionMetadata)(nil),                // 41: discord_v1.MessageInteractionMetadata
+	(*Session)(nil),                                   // 42: discord_v1.Session
+	(*Identify)(nil),                                  // 43: discord_v1.Identify
+	(*IdentifyProperties)(nil),                        // 44: discord_v1.IdentifyProperties
+	(*GatewayStatusUpdate)(nil),                       // 45: discord_v1.GatewayStatusUpdate
+	(*Activity)(nil),                                  // 46: discord_v1.Activity
+	(*TimeStamps)(nil),                                // 47: discord_v1.TimeStamps
+	(*Assets)(nil),                                    // 48: discord_v1.Assets
+	(*Party)(nil),                                     // 49: discord_v1.Party
+	(*Secrets)(nil),                                   // 50: discord_v1.Secrets
+	(*ApplicationIntegrationTypeConfig)(nil),          // 51: discord_v1.ApplicationIntegrationTypeConfig
+	(*ApplicationInstallParams)(nil),                  // 52: discord_v1.ApplicationInstallParams
+	(*Application)(nil),                               // 53: discord_v1.Application
+	(*ApplicationRoleConnectionMetadata)(nil),         // 54: discord_v1.ApplicationRoleConnectionMetadata
+	(*ApplicationRoleConnection)(nil),                 // 55: discord_v1.ApplicationRoleConnection
+	(*UserConnection)(nil),                            // 56: discord_v1.UserConnection
+	(*Integration)(nil),                               // 57: discord_v1.Integration
+	(*IntegrationAccount)(nil),                        // 58: discord_v1.IntegrationAccount
+	(*VoiceRegion)(nil),                               // 59: discord_v1.VoiceRegion
+	(*Invite)(nil),                                    // 60: discord_v1.Invite
+	(*Channel)(nil),                                   // 61: discord_v1.Channel
+	(*PermissionOverwrite)(nil),                       // 62: discord_v1.PermissionOverwrite
+	(*ThreadStart)(nil),                               // 63: discord_v1.ThreadStart
+	(*ThreadMetadata)(nil),                            // 64: discord_v1.ThreadMetadata
+	(*ThreadMember)(nil),                              // 65: discord_v1.ThreadMember
+	(*ThreadsList)(nil),                               // 66: discord_v1.ThreadsList
+	(*AddedThreadMember)(nil),                         // 67: discord_v1.AddedThreadMember
+	(*ForumDefaultReaction)(nil),                      // 68: discord_v1.ForumDefaultReaction
+	(*ForumTag)(nil),                                  // 69: discord_v1.ForumTag
+	(*Emoji)(nil),                                     // 70: discord_v1.Emoji
+	(*EmojiParams)(nil),                               // 71: discord_v1.EmojiParams
+	(*Sticker)(nil),                                   // 72: discord_v1.Sticker
+	(*StickerItem)(nil),                               // 73: discord_v1.StickerItem
+	(*StickerPack)(nil),                               // 74: discord_v1.StickerPack
+	(*Guild)(nil),                                     // 75: discord_v1.Guild
+	(*GuildPreview)(nil),                              // 76: discord_v1.GuildPreview
+	(*GuildScheduledEvent)(nil),                       // 77: discord_v1.GuildScheduledEvent
+	(*GuildScheduledEventParams)(nil),                 // 78: discord_v1.GuildScheduledEventParams
+	(*GuildScheduledEventEntityMetadata)(nil),         // 79: discord_v1.GuildScheduledEventEntityMetadata
+	(*GuildScheduledEventUser)(nil),                   // 80: discord_v1.GuildScheduledEventUser
+	(*GuildOnboardingMode)(nil),                       // 81: discord_v1.GuildOnboardingMode
+	(*GuildOnboarding)(nil),                           // 82: discord_v1.GuildOnboarding
+	(*GuildOnboardingPromptType)(nil),                 // 83: discord_v1.GuildOnboardingPromptType
+	(*GuildOnboardingPrompt)(nil),                     // 84: discord_v1.GuildOnboardingPrompt
+	(*GuildOnboardingPromptOption)(nil),               // 85: discord_v1.GuildOnboardingPromptOption
+	(*GuildTemplate)(nil),                             // 86: discord_v1.GuildTemplate
+	(*GuildTemplateParams)(nil),                       // 87: discord_v1.GuildTemplateParams
+	(*UserGuild)(nil),                                 // 88: discord_v1.UserGuild
+	(*GuildParams)(nil),                               // 89: discord_v1.GuildParams
+	(*Role)(nil),                                      // 90: discord_v1.Role
+	(*RoleFlags)(nil),                                 // 91: discord_v1.RoleFlags
+	(*RoleParams)(nil),                                // 92: discord_v1.RoleParams
+	(*VoiceState)(nil),                                // 93: discord_v1.VoiceState
+	(*Presence)(nil),                                  // 94: discord_v1.Presence
+	(*MemberFlags)(nil),                               // 95: discord_v1.MemberFlags
+	(*Member)(nil),                                    // 96: discord_v1.Member
+	(*ClientStatus)(nil),                              // 97: discord_v1.ClientStatus
+	(*TooManyRequests)(nil),                           // 98: discord_v1.TooManyRequests
+	(*ReadState)(nil),                                 // 99: discord_v1.ReadState
+	(*GuildRole)(nil),                                 // 100: discord_v1.GuildRole
+	(*GuildBan)(nil),                                  // 101: discord_v1.GuildBan
+	(*AutoModerationRule)(nil),                        // 102: discord_v1.AutoModerationRule
+	(*AutoModerationTriggerMetadata)(nil),             // 103: discord_v1.AutoModerationTriggerMetadata
+	(*AutoModerationActionType)(nil),                  // 104: discord_v1.AutoModerationActionType
+	(*AutoModerationActionMetadata)(nil),              // 105: discord_v1.AutoModerationActionMetadata
+	(*AutoModerationAction)(nil),                      // 106: discord_v1.AutoModerationAction
+	(*GuildEmbed)(nil),                                // 107: discord_v1.GuildEmbed
+	(*GuildAuditLog)(nil),                             // 108: discord_v1.GuildAuditLog
+	(*AuditLogEntry)(nil),                             // 109: discord_v1.AuditLogEntry
+	(*AuditLogChange)(nil),                            // 110: discord_v1.AuditLogChange
+	(*AuditLogOptions)(nil),                           // 111: discord_v1.AuditLogOptions
+	(*GuildMemberParams)(nil),                         // 112: discord_v1.GuildMemberParams
+	(*GuildMemberAddParams)(nil),                      // 113: discord_v1.GuildMemberAddParams
+	(*APIErrorMessage)(nil),                           // 114: discord_v1.APIErrorMessage
+	(*MessageReaction)(nil),                           // 115: discord_v1.MessageReaction
+	(*GatewayBotResponse)(nil),                        // 116: discord_v1.GatewayBotResponse
+	(*SessionInformation)(nil),                        // 117: discord_v1.SessionInformation
+	(*StageInstance)(nil),                             // 118: discord_v1.StageInstance
+	(*StageInstanceParams)(nil),                       // 119: discord_v1.StageInstanceParams
+	(*PollLayoutType)(nil),                            // 120: discord_v1.PollLayoutType
+	(*PollMedia)(nil),                                 // 121: discord_v1.PollMedia
+	(*PollAnswer)(nil),                                // 122: discord_v1.PollAnswer
+	(*PollAnswerCount)(nil),                           // 123: discord_v1.PollAnswerCount
+	(*PollResults)(nil),                               // 124: discord_v1.PollResults
+	(*Poll)(nil),                                      // 125: discord_v1.Poll
+	(*SKUType)(nil),                                   // 126: discord_v1.SKUType
+	(*SKUFlags)(nil),                                  // 127: discord_v1.SKUFlags
+	(*SKU)(nil),                                       // 128: discord_v1.SKU
+	(*SubscriptionStatus)(nil),                        // 129: discord_v1.SubscriptionStatus
+	(*Subscription)(nil),                              // 130: discord_v1.Subscription
+	(*EntitlementType)(nil),                           // 131: discord_v1.EntitlementType
+	(*Entitlement)(nil),                               // 132: discord_v1.Entitlement
+	(*EntitlementOwnerType)(nil),                      // 133: discord_v1.EntitlementOwnerType
+	(*EntitlementTest)(nil),                           // 134: discord_v1.EntitlementTest
+	(*EntitlementFilterOptions)(nil),                  // 135: discord_v1.EntitlementFilterOptions
+	(*State)(nil),                                     // 136: discord_v1.State
+	(*User)(nil),                                      // 137: discord_v1.User
+	(*TeamMember)(nil),                                // 138: discord_v1.TeamMember
+	(*Team)(nil),                                      // 139: discord_v1.Team
+	(*Webhook)(nil),                                   // 140: discord_v1.Webhook
+	(*WebhookParams)(nil),                             // 141: discord_v1.WebhookParams
+	(*WebhookEdit)(nil),                               // 142: discord_v1.WebhookEdit
+	(*ComponentEmoji)(nil),                            // 143: discord_v1.ComponentEmoji
+	(*MessageComponent)(nil),                          // 144: discord_v1.MessageComponent
+	(*ActionsRow)(nil),                                // 145: discord_v1.ActionsRow
+	(*Button)(nil),                                    // 146: discord_v1.Button
+	(*SelectMenuDefaultValue)(nil),                    // 147: discord_v1.SelectMenuDefaultValue
+	(*SelectMenuOption)(nil),                          // 148: discord_v1.SelectMenuOption
+	(*SelectMenu)(nil),                                // 149: discord_v1.SelectMenu
+	(*TextInput)(nil),                                 // 150: discord_v1.TextInput
+	(*ApplicationCommand)(nil),                        // 151: discord_v1.ApplicationCommand
+	(*ApplicationCommandOption)(nil),                  // 152: discord_v1.ApplicationCommandOption
+	(*ApplicationCommandOptionChoice)(nil),            // 153: discord_v1.ApplicationCommandOptionChoice
+	(*ApplicationCommandPermissions)(nil),             // 154: discord_v1.ApplicationCommandPermissions
+	(*ApplicationCommandPermissionsList)(nil),         // 155: discord_v1.ApplicationCommandPermissionsList
+	(*GuildApplicationCommandPermissions)(nil),        // 156: discord_v1.GuildApplicationCommandPermissions
+	(*Interaction)(nil),                               // 157: discord_v1.Interaction
+	(*ApplicationCommandInteractionData)(nil),         // 158: discord_v1.ApplicationCommandInteractionData
+	(*ApplicationCommandInteractionDataResolved)(nil), // 159: discord_v1.ApplicationCommandInteractionDataResolved
+	(*MessageComponentInteractionData)(nil),           // 160: discord_v1.MessageComponentInteractionData
+	(*MessageComponentInteractionDataResolved)(nil),   // 161: discord_v1.MessageComponentInteractionDataResolved
+	(*ModalSubmitInteractionData)(nil),                // 162: discord_v1.ModalSubmitInteractionData
+	(*ApplicationCommandInteractionDataOption)(nil),   // 163: discord_v1.ApplicationCommandInteractionDataOption
+	(*InteractionResponse)(nil),                       // 164: discord_v1.InteractionResponse
+	(*InteractionResponseData)(nil),                   // 165: discord_v1.InteractionResponseData
+	nil,                                               // 166: discord_v1.MessageInteractionMetadata.AuthorizingIntegrationOwnersEntry
+	nil,                                               // 167: discord_v1.Application.IntegrationTypesConfigEntry
+	nil,                                               // 168: discord_v1.ApplicationRoleConnectionMetadata.NameLocalizationsEntry
+	nil,                                               // 169: discord_v1.ApplicationRoleConnectionMetadata.DescriptionLocalizationsEntry
+	nil,                                               // 170: discord_v1.ApplicationRoleConnection.MetadataEntry
+	nil,                                               // 171: discord_v1.State.GuildMapEntry
+	nil,                                               // 172: discord_v1.State.ChannelMapEntry
+	nil,                                               // 173: discord_v1.ApplicationCommand.NameLocalizationsEntry
+	nil,                                               // 174: discord_v1.ApplicationCommand.DescriptionLocalizationsEntry
+	nil,                                               // 175: discord_v1.ApplicationCommandOption.NameLocalizationsEntry
+	nil,                                               // 176: discord_v1.ApplicationCommandOption.DescriptionLocalizationsEntry
+	nil,                                               // 177: discord_v1.ApplicationCommandOptionChoice.NameLocalizationsEntry
+	nil,                                               // 178: discord_v1.Interaction.AuthorizingIntegrationOwnersEntry
+	nil,                                               // 179: discord_v1.ApplicationCommandInteractionDataResolved.UsersEntry
+	nil,                                               // 180: discord_v1.ApplicationCommandInteractionDataResolved.MembersEntry
+	nil,                                               // 181: discord_v1.ApplicationCommandInteractionDataResolved.RolesEntry
+	nil,                                               // 182: discord_v1.ApplicationCommandInteractionDataResolved.ChannelsEntry
+	nil,                                               // 183: discord_v1.ApplicationCommandInteractionDataResolved.MessagesEntry
+	nil,                                               // 184: discord_v1.ApplicationCommandInteractionDataResolved.AttachmentsEntry
+	nil,                                               // 185: discord_v1.MessageComponentInteractionDataResolved.UsersEntry
+	nil,                                               // 186: discord_v1.MessageComponentInteractionDataResolved.MembersEntry
+	nil,                                               // 187: discord_v1.MessageComponentInteractionDataResolved.RolesEntry
+	nil,                                               // 188: discord_v1.MessageComponentInteractionDataResolved.ChannelsEntry
+	(*timestamppb.Timestamp)(nil),                     // 189: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                       // 190: google.protobuf.Duration
 }
 var file_discord_v1_discordgo_proto_depIdxs = []int32{
-	180, // 0: discord_v1.Message.timestamp:type_name -> google.protobuf.Timestamp
-	180, // 1: discord_v1.Message.edited_timestamp:type_name -> google.protobuf.Timestamp
-	135, // 2: discord_v1.Message.author:type_name -> discord_v1.User
-	24,  // 3: discord_v1.Message.attachments:type_name -> discord_v1.MessageAttachment
-	181, // 4: discord_v1.Message.components:type_name -> google.protobuf.Any
-	32,  // 5: discord_v1.Message.embeds:type_name -> discord_v1.MessageEmbed
-	135, // 6: discord_v1.Message.mentions:type_name -> discord_v1.User
-	33,  // 7: discord_v1.Message.reactions:type_name -> discord_v1.MessageReactions
+	189, // 0: discord_v1.Message.timestamp:type_name -> google.protobuf.Timestamp
+	189, // 1: discord_v1.Message.edited_timestamp:type_name -> google.protobuf.Timestamp
+	137, // 2: discord_v1.Message.author:type_name -> discord_v1.User
+	26,  // 3: discord_v1.Message.attachments:type_name -> discord_v1.MessageAttachment
+	144, // 4: discord_v1.Message.components:type_name -> discord_v1.MessageComponent
+	34,  // 5: discord_v1.Message.embeds:type_name -> discord_v1.MessageEmbed
+	137, // 6: discord_v1.Message.mentions:type_name -> discord_v1.User
+	35,  // 7: discord_v1.Message.reactions:type_name -> discord_v1.MessageReactions
 	0,   // 8: discord_v1.Message.type:type_name -> discord_v1.MessageType
-	94,  // 9: discord_v1.Message.member:type_name -> discord_v1.Member
-	59,  // 10: discord_v1.Message.mention_channels:type_name -> discord_v1.Channel
-	34,  // 11: discord_v1.Message.activity:type_name -> discord_v1.MessageActivity
-	35,  // 12: discord_v1.Message.application:type_name -> discord_v1.MessageApplication
-	37,  // 13: discord_v1.Message.message_reference:type_name -> discord_v1.MessageReference
-	19,  // 14: discord_v1.Message.referenced_message:type_name -> discord_v1.Message
-	36,  // 15: discord_v1.Message.message_snapshots:type_name -> discord_v1.MessageSnapshot
-	38,  // 16: discord_v1.Message.interaction:type_name -> discord_v1.MessageInteraction
-	39,  // 17: discord_v1.Message.interaction_metadata:type_name -> discord_v1.MessageInteractionMetadata
+	96,  // 9: discord_v1.Message.member:type_name -> discord_v1.Member
+	61,  // 10: discord_v1.Message.mention_channels:type_name -> discord_v1.Channel
+	36,  // 11: discord_v1.Message.activity:type_name -> discord_v1.MessageActivity
+	37,  // 12: discord_v1.Message.application:type_name -> discord_v1.MessageApplication
+	39,  // 13: discord_v1.Message.message_reference:type_name -> discord_v1.MessageReference
+	21,  // 14: discord_v1.Message.referenced_message:type_name -> discord_v1.Message
+	38,  // 15: discord_v1.Message.message_snapshots:type_name -> discord_v1.MessageSnapshot
+	40,  // 16: discord_v1.Message.interaction:type_name -> discord_v1.MessageInteraction
+	41,  // 17: discord_v1.Message.interaction_metadata:type_name -> discord_v1.MessageInteractionMetadata
 	1,   // 18: discord_v1.Message.flags:type_name -> discord_v1.MessageFlags
-	59,  // 19: discord_v1.Message.thread:type_name -> discord_v1.Channel
-	71,  // 20: discord_v1.Message.sticker_items:type_name -> discord_v1.StickerItem
-	123, // 21: discord_v1.Message.poll:type_name -> discord_v1.Poll
-	32,  // 22: discord_v1.MessageSend.embeds:type_name -> discord_v1.MessageEmbed
-	181, // 23: discord_v1.MessageSend.components:type_name -> google.protobuf.Any
-	20,  // 24: discord_v1.MessageSend.files:type_name -> discord_v1.File
-	23,  // 25: discord_v1.MessageSend.allowed_mentions:type_name -> discord_v1.MessageAllowedMentions
-	37,  // 26: discord_v1.MessageSend.reference:type_name -> discord_v1.MessageReference
+	61,  // 19: discord_v1.Message.thread:type_name -> discord_v1.Channel
+	73,  // 20: discord_v1.Message.sticker_items:type_name -> discord_v1.StickerItem
+	125, // 21: discord_v1.Message.poll:type_name -> discord_v1.Poll
+	34,  // 22: discord_v1.MessageSend.embeds:type_name -> discord_v1.MessageEmbed
+	144, // 23: discord_v1.MessageSend.components:type_name -> discord_v1.MessageComponent
+	22,  // 24: discord_v1.MessageSend.files:type_name -> discord_v1.File
+	25,  // 25: discord_v1.MessageSend.allowed_mentions:type_name -> discord_v1.MessageAllowedMentions
+	39,  // 26: discord_v1.MessageSend.reference:type_name -> discord_v1.MessageReference
 	1,   // 27: discord_v1.MessageSend.flags:type_name -> discord_v1.MessageFlags
-	123, // 28: discord_v1.MessageSend.poll:type_name -> discord_v1.Poll
-	181, // 29: discord_v1.MessageEdit.components:type_name -> google.protobuf.Any
-	32,  // 30: discord_v1.MessageEdit.embeds:type_name -> discord_v1.MessageEmbed
-	23,  // 31: discord_v1.MessageEdit.allowed_mentions:type_name -> discord_v1.MessageAllowedMentions
+	125, // 28: discord_v1.MessageSend.poll:type_name -> discord_v1.Poll
+	144, // 29: discord_v1.MessageEdit.components:type_name -> discord_v1.MessageComponent
+	34,  // 30: discord_v1.MessageEdit.embeds:type_name -> discord_v1.MessageEmbed
+	25,  // 31: discord_v1.MessageEdit.allowed_mentions:type_name -> discord_v1.MessageAllowedMentions
 	1,   // 32: discord_v1.MessageEdit.flags:type_name -> discord_v1.MessageFlags
-	20,  // 33: discord_v1.MessageEdit.files:type_name -> discord_v1.File
-	24,  // 34: discord_v1.MessageEdit.attachments:type_name -> discord_v1.MessageAttachment
+	22,  // 33: discord_v1.MessageEdit.files:type_name -> discord_v1.File
+	26,  // 34: discord_v1.MessageEdit.attachments:type_name -> discord_v1.MessageAttachment
 	2,   // 35: discord_v1.MessageAllowedMentions.parse:type_name -> discord_v1.AllowedMentionType
 	3,   // 36: discord_v1.MessageEmbed.type:type_name -> discord_v1.EmbedType
-	25,  // 37: discord_v1.MessageEmbed.footer:type_name -> discord_v1.MessageEmbedFooter
-	26,  // 38: discord_v1.MessageEmbed.image:type_name -> discord_v1.MessageEmbedImage
-	27,  // 39: discord_v1.MessageEmbed.thumbnail:type_name -> discord_v1.MessageEmbedThumbnail
-	28,  // 40: discord_v1.MessageEmbed.video:type_name -> discord_v1.MessageEmbedVideo
-	29,  // 41: discord_v1.MessageEmbed.provider:type_name -> discord_v1.MessageEmbedProvider
-	30,  // 42: discord_v1.MessageEmbed.author:type_name -> discord_v1.MessageEmbedAuthor
-	31,  // 43: discord_v1.MessageEmbed.fields:type_name -> discord_v1.MessageEmbedField
-	68,  // 44: discord_v1.MessageReactions.emoji:type_name -> discord_v1.Emoji
+	27,  // 37: discord_v1.MessageEmbed.footer:type_name -> discord_v1.MessageEmbedFooter
+	28,  // 38: discord_v1.MessageEmbed.image:type_name -> discord_v1.MessageEmbedImage
+	29,  // 39: discord_v1.MessageEmbed.thumbnail:type_name -> discord_v1.MessageEmbedThumbnail
+	30,  // 40: discord_v1.MessageEmbed.video:type_name -> discord_v1.MessageEmbedVideo
+	31,  // 41: discord_v1.MessageEmbed.provider:type_name -> discord_v1.MessageEmbedProvider
+	32,  // 42: discord_v1.MessageEmbed.author:type_name -> discord_v1.MessageEmbedAuthor
+	33,  // 43: discord_v1.MessageEmbed.fields:type_name -> discord_v1.MessageEmbedField
+	70,  // 44: discord_v1.MessageReactions.emoji:type_name -> discord_v1.Emoji
 	4,   // 45: discord_v1.MessageActivity.type:type_name -> discord_v1.MessageActivityType
-	19,  // 46: discord_v1.MessageSnapshot.message:type_name -> discord_v1.Message
+	21,  // 46: discord_v1.MessageSnapshot.message:type_name -> discord_v1.Message
 	5,   // 47: discord_v1.MessageReference.type:type_name -> discord_v1.MessageReferenceType
-	135, // 48: discord_v1.MessageInteraction.user:type_name -> discord_v1.User
-	94,  // 49: discord_v1.MessageInteraction.member:type_name -> discord_v1.Member
-	135, // 50: discord_v1.MessageInteractionMetadata.user:type_name -> discord_v1.User
-	157, // 51: discord_v1.MessageInteractionMetadata.authorizing_integration_owners:type_name -> discord_v1.MessageInteractionMetadata.AuthorizingIntegrationOwnersEntry
-	39,  // 52: discord_v1.MessageInteractionMetadata.triggering_interaction_metadata:type_name -> discord_v1.MessageInteractionMetadata
-	41,  // 53: discord_v1.Session.identify:type_name -> discord_v1.Identify
-	134, // 54: discord_v1.Session.state:type_name -> discord_v1.State
-	180, // 55: discord_v1.Session.last_heartbeat_ack:type_name -> google.protobuf.Timestamp
-	180, // 56: discord_v1.Session.last_heartbeat_sent:type_name -> google.protobuf.Timestamp
-	42,  // 57: discord_v1.Identify.properties:type_name -> discord_v1.IdentifyProperties
-	43,  // 58: discord_v1.Identify.presence:type_name -> discord_v1.GatewayStatusUpdate
-	44,  // 59: discord_v1.GatewayStatusUpdate.game:type_name -> discord_v1.Activity
-	180, // 60: discord_v1.Activity.created_at:type_name -> google.protobuf.Timestamp
-	45,  // 61: discord_v1.Activity.timestamps:type_name -> discord_v1.TimeStamps
-	68,  // 62: discord_v1.Activity.emoji:type_name -> discord_v1.Emoji
-	47,  // 63: discord_v1.Activity.party:type_name -> discord_v1.Party
-	46,  // 64: discord_v1.Activity.assets:type_name -> discord_v1.Assets
-	48,  // 65: discord_v1.Activity.secrets:type_name -> discord_v1.Secrets
-	50,  // 66: discord_v1.ApplicationIntegrationTypeConfig.oauth2_install_params:type_name -> discord_v1.ApplicationInstallParams
-	135, // 67: discord_v1.Application.owner:type_name -> discord_v1.User
-	137, // 68: discord_v1.Application.team:type_name -> discord_v1.Team
-	158, // 69: discord_v1.Application.integration_types_config:type_name -> discord_v1.Application.IntegrationTypesConfigEntry
-	159, // 70: discord_v1.ApplicationRoleConnectionMetadata.name_localizations:type_name -> discord_v1.ApplicationRoleConnectionMetadata.NameLocalizationsEntry
-	160, // 71: discord_v1.ApplicationRoleConnectionMetadata.description_localizations:type_name -> discord_v1.ApplicationRoleConnectionMetadata.DescriptionLocalizationsEntry
-	161, // 72: discord_v1.ApplicationRoleConnection.metadata:type_name -> discord_v1.ApplicationRoleConnection.MetadataEntry
-	55,  // 73: discord_v1.UserConnection.integrations:type_name -> discord_v1.Integration
-	135, // 74: discord_v1.Integration.user:type_name -> discord_v1.User
-	56,  // 75: discord_v1.Integration.account:type_name -> discord_v1.IntegrationAccount
-	180, // 76: discord_v1.Integration.synced_at:type_name -> google.protobuf.Timestamp
-	73,  // 77: discord_v1.Invite.guild:type_name -> discord_v1.Guild
-	59,  // 78: discord_v1.Invite.channel:type_name -> discord_v1.Channel
-	135, // 79: discord_v1.Invite.inviter:type_name -> discord_v1.User
-	180, // 80: discord_v1.Invite.created_at:type_name -> google.protobuf.Timestamp
-	135, // 81: discord_v1.Invite.target_user:type_name -> discord_v1.User
-	51,  // 82: discord_v1.Invite.target_application:type_name -> discord_v1.Application
-	180, // 83: discord_v1.Invite.expires_at:type_name -> google.protobuf.Timestamp
-	180, // 84: discord_v1.Channel.last_pin_timestamp:type_name -> google.protobuf.Timestamp
-	135, // 85: discord_v1.Channel.recipients:type_name -> discord_v1.User
-	19,  // 86: discord_v1.Channel.messages:type_name -> discord_v1.Message
-	60,  // 87: discord_v1.Channel.permission_overwrites:type_name -> discord_v1.PermissionOverwrite
-	62,  // 88: discord_v1.Channel.thread_metadata:type_name -> discord_v1.ThreadMetadata
-	63,  // 89: discord_v1.Channel.member:type_name -> discord_v1.ThreadMember
-	63,  // 90: discord_v1.Channel.members:type_name -> discord_v1.ThreadMember
-	67,  // 91: discord_v1.Channel.available_tags:type_name -> discord_v1.ForumTag
-	66,  // 92: discord_v1.Channel.default_reaction_emoji:type_name -> discord_v1.ForumDefaultReaction
-	180, // 93: discord_v1.ThreadMetadata.archive_timestamp:type_name -> google.protobuf.Timestamp
-	180, // 94: discord_v1.ThreadMember.join_timestamp:type_name -> google.protobuf.Timestamp
-	94,  // 95: discord_v1.ThreadMember.member:type_name -> discord_v1.Member
-	59,  // 96: discord_v1.ThreadsList.threads:type_name -> discord_v1.Channel
-	63,  // 97: discord_v1.ThreadsList.members:type_name -> discord_v1.ThreadMember
-	63,  // 98: discord_v1.AddedThreadMember.thread_member:type_name -> discord_v1.ThreadMember
-	94,  // 99: discord_v1.AddedThreadMember.member:type_name -> discord_v1.Member
-	92,  // 100: discord_v1.AddedThreadMember.presence:type_name -> discord_v1.Presence
-	135, // 101: discord_v1.Emoji.user:type_name -> discord_v1.User
-	135, // 102: discord_v1.Sticker.user:type_name -> discord_v1.User
-	70,  // 103: discord_v1.StickerPack.stickers:type_name -> discord_v1.Sticker
-	180, // 104: discord_v1.Guild.joined_at:type_name -> google.protobuf.Timestamp
-	88,  // 105: discord_v1.Guild.roles:type_name -> discord_v1.Role
-	68,  // 106: discord_v1.Guild.emojis:type_name -> discord_v1.Emoji
-	70,  // 107: discord_v1.Guild.stickers:type_name -> discord_v1.Sticker
-	94,  // 108: discord_v1.Guild.members:type_name -> discord_v1.Member
-	92,  // 109: discord_v1.Guild.presences:type_name -> discord_v1.Presence
-	59,  // 110: discord_v1.Guild.channels:type_name -> discord_v1.Channel
-	59,  // 111: discord_v1.Guild.threads:type_name -> discord_v1.Channel
-	91,  // 112: discord_v1.Guild.voice_states:type_name -> discord_v1.VoiceState
-	116, // 113: discord_v1.Guild.stage_instances:type_name -> discord_v1.StageInstance
-	68,  // 114: discord_v1.GuildPreview.emojis:type_name -> discord_v1.Emoji
-	180, // 115: discord_v1.GuildScheduledEvent.scheduled_start_time:type_name -> google.protobuf.Timestamp
-	180, // 116: discord_v1.GuildScheduledEvent.scheduled_end_time:type_name -> google.protobuf.Timestamp
-	77,  // 117: discord_v1.GuildScheduledEvent.entity_metadata:type_name -> discord_v1.GuildScheduledEventEntityMetadata
-	135, // 118: discord_v1.GuildScheduledEvent.creator:type_name -> discord_v1.User
-	180, // 119: discord_v1.GuildScheduledEventParams.scheduled_start_time:type_name -> google.protobuf.Timestamp
-	180, // 120: discord_v1.GuildScheduledEventParams.scheduled_end_time:type_name -> google.protobuf.Timestamp
-	77,  // 121: discord_v1.GuildScheduledEventParams.entity_metadata:type_name -> discord_v1.GuildScheduledEventEntityMetadata
-	135, // 122: discord_v1.GuildScheduledEventUser.user:type_name -> discord_v1.User
-	94,  // 123: discord_v1.GuildScheduledEventUser.member:type_name -> discord_v1.Member
-	82,  // 124: discord_v1.GuildOnboarding.prompts:type_name -> discord_v1.GuildOnboardingPrompt
-	79,  // 125: discord_v1.GuildOnboarding.mode:type_name -> discord_v1.GuildOnboardingMode
-	81,  // 126: discord_v1.GuildOnboardingPrompt.type:type_name -> discord_v1.GuildOnboardingPromptType
-	83,  // 127: discord_v1.GuildOnboardingPrompt.options:type_name -> discord_v1.GuildOnboardingPromptOption
-	68,  // 128: discord_v1.GuildOnboardingPromptOption.emoji:type_name -> discord_v1.Emoji
-	135, // 129: discord_v1.GuildTemplate.creator:type_name -> discord_v1.User
-	180, // 130: discord_v1.GuildTemplate.created_at:type_name -> google.protobuf.Timestamp
-	180, // 131: discord_v1.GuildTemplate.updated_at:type_name -> google.protobuf.Timestamp
-	73,  // 132: discord_v1.GuildTemplate.serialized_source_guild:type_name -> discord_v1.Guild
-	94,  // 133: discord_v1.VoiceState.member:type_name -> discord_v1.Member
-	180, // 134: discord_v1.VoiceState.request_to_speak_timestamp:type_name -> google.protobuf.Timestamp
-	135, // 135: discord_v1.Presence.user:type_name -> discord_v1.User
-	44,  // 136: discord_v1.Presence.activities:type_name -> discord_v1.Activity
-	95,  // 137: discord_v1.Presence.client_status:type_name -> discord_v1.ClientStatus
-	180, // 138: discord_v1.Member.joined_at:type_name -> google.protobuf.Timestamp
-	135, // 139: discord_v1.Member.user:type_name -> discord_v1.User
-	180, // 140: discord_v1.Member.premium_since:type_name -> google.protobuf.Timestamp
-	180, // 141: discord_v1.Member.communication_disabled_until:type_name -> google.protobuf.Timestamp
-	182, // 142: discord_v1.TooManyRequests.retry_after:type_name -> google.protobuf.Duration
-	88,  // 143: discord_v1.GuildRole.role:type_name -> discord_v1.Role
-	135, // 144: discord_v1.GuildBan.user:type_name -> discord_v1.User
-	101, // 145: discord_v1.AutoModerationRule.trigger_metadata:type_name -> discord_v1.AutoModerationTriggerMetadata
-	104, // 146: discord_v1.AutoModerationRule.actions:type_name -> discord_v1.AutoModerationAction
-	103, // 147: discord_v1.AutoModerationAction.metadata:type_name -> discord_v1.AutoModerationActionMetadata
-	138, // 148: discord_v1.GuildAuditLog.webhooks:type_name -> discord_v1.Webhook
-	135, // 149: discord_v1.GuildAuditLog.users:type_name -> discord_v1.User
-	107, // 150: discord_v1.GuildAuditLog.audit_log_entries:type_name -> discord_v1.AuditLogEntry
-	55,  // 151: discord_v1.GuildAuditLog.integrations:type_name -> discord_v1.Integration
-	108, // 152: discord_v1.AuditLogEntry.changes:type_name -> discord_v1.AuditLogChange
-	109, // 153: discord_v1.AuditLogEntry.options:type_name -> discord_v1.AuditLogOptions
-	180, // 154: discord_v1.GuildMemberParams.communication_disabled_until:type_name -> google.protobuf.Timestamp
-	68,  // 155: discord_v1.MessageReaction.emoji:type_name -> discord_v1.Emoji
-	115, // 156: discord_v1.GatewayBotResponse.session_start_limit:type_name -> discord_v1.SessionInformation
-	141, // 157: discord_v1.PollMedia.emoji:type_name -> discord_v1.ComponentEmoji
-	119, // 158: discord_v1.PollAnswer.media:type_name -> discord_v1.PollMedia
-	121, // 159: discord_v1.PollResults.answer_counts:type_name -> discord_v1.PollAnswerCount
-	119, // 160: discord_v1.Poll.question:type_name -> discord_v1.PollMedia
-	120, // 161: discord_v1.Poll.answers:type_name -> discord_v1.PollAnswer
-	122, // 162: discord_v1.Poll.results:type_name -> discord_v1.PollResults
-	180, // 163: discord_v1.Poll.expiry:type_name -> google.protobuf.Timestamp
-	180, // 164: discord_v1.Subscription.current_period_start:type_name -> google.protobuf.Timestamp
-	180, // 165: discord_v1.Subscription.current_period_end:type_name -> google.protobuf.Timestamp
-	180, // 166: discord_v1.Subscription.canceled_at:type_name -> google.protobuf.Timestamp
-	180, // 167: discord_v1.Entitlement.starts_at:type_name -> google.protobuf.Timestamp
-	180, // 168: discord_v1.Entitlement.ends_at:type_name -> google.protobuf.Timestamp
-	180, // 169: discord_v1.EntitlementFilterOptions.before:type_name -> google.protobuf.Timestamp
-	180, // 170: discord_v1.EntitlementFilterOptions.after:type_name -> google.protobuf.Timestamp
-	162, // 171: discord_v1.State.guild_map:type_name -> discord_v1.State.GuildMapEntry
-	163, // 172: discord_v1.State.channel_map:type_name -> discord_v1.State.ChannelMapEntry
+	137, // 48: discord_v1.MessageInteraction.user:type_name -> discord_v1.User
+	96,  // 49: discord_v1.MessageInteraction.member:type_name -> discord_v1.Member
+	137, // 50: discord_v1.MessageInteractionMetadata.user:type_name -> discord_v1.User
+	166, // 51: discord_v1.MessageInteractionMetadata.authorizing_integration_owners:type_name -> discord_v1.MessageInteractionMetadata.AuthorizingIntegrationOwnersEntry
+	41,  // 52: discord_v1.MessageInteractionMetadata.triggering_interaction_metadata:type_name -> discord_v1.MessageInteractionMetadata
+	43,  // 53: discord_v1.Session.identify:type_name -> discord_v1.Identify
+	136, // 54: discord_v1.Session.state:type_name -> discord_v1.State
+	189, // 55: discord_v1.Session.last_heartbeat_ack:type_name -> google.protobuf.Timestamp
+	189, // 56: discord_v1.Session.last_heartbeat_sent:type_name -> google.protobuf.Timestamp
+	44,  // 57: discord_v1.Identify.properties:type_name -> discord_v1.IdentifyProperties
+	45,  // 58: discord_v1.Identify.presence:type_name -> discord_v1.GatewayStatusUpdate
+	46,  // 59: discord_v1.GatewayStatusUpdate.game:type_name -> discord_v1.Activity
+	189, // 60: discord_v1.Activity.created_at:type_name -> google.protobuf.Timestamp
+	47,  // 61: discord_v1.Activity.timestamps:type_name -> discord_v1.TimeStamps
+	70,  // 62: discord_v1.Activity.emoji:type_name -> discord_v1.Emoji
+	49,  // 63: discord_v1.Activity.party:type_name -> discord_v1.Party
+	48,  // 64: discord_v1.Activity.assets:type_name -> discord_v1.Assets
+	50,  // 65: discord_v1.Activity.secrets:type_name -> discord_v1.Secrets
+	52,  // 66: discord_v1.ApplicationIntegrationTypeConfig.oauth2_install_params:type_name -> discord_v1.ApplicationInstallParams
+	137, // 67: discord_v1.Application.owner:type_name -> discord_v1.User
+	139, // 68: discord_v1.Application.team:type_name -> discord_v1.Team
+	167, // 69: discord_v1.Application.integration_types_config:type_name -> discord_v1.Application.IntegrationTypesConfigEntry
+	168, // 70: discord_v1.ApplicationRoleConnectionMetadata.name_localizations:type_name -> discord_v1.ApplicationRoleConnectionMetadata.NameLocalizationsEntry
+	169, // 71: discord_v1.ApplicationRoleConnectionMetadata.description_localizations:type_name -> discord_v1.ApplicationRoleConnectionMetadata.DescriptionLocalizationsEntry
+	170, // 72: discord_v1.ApplicationRoleConnection.metadata:type_name -> discord_v1.ApplicationRoleConnection.MetadataEntry
+	57,  // 73: discord_v1.UserConnection.integrations:type_name -> discord_v1.Integration
+	137, // 74: discord_v1.Integration.user:type_name -> discord_v1.User
+	58,  // 75: discord_v1.Integration.account:type_name -> discord_v1.IntegrationAccount
+	189, // 76: discord_v1.Integration.synced_at:type_name -> google.protobuf.Timestamp
+	75,  // 77: discord_v1.Invite.guild:type_name -> discord_v1.Guild
+	61,  // 78: discord_v1.Invite.channel:type_name -> discord_v1.Channel
+	137, // 79: discord_v1.Invite.inviter:type_name -> discord_v1.User
+	189, // 80: discord_v1.Invite.created_at:type_name -> google.protobuf.Timestamp
+	137, // 81: discord_v1.Invite.target_user:type_name -> discord_v1.User
+	53,  // 82: discord_v1.Invite.target_application:type_name -> discord_v1.Application
+	189, // 83: discord_v1.Invite.expires_at:type_name -> google.protobuf.Timestamp
+	189, // 84: discord_v1.Channel.last_pin_timestamp:type_name -> google.protobuf.Timestamp
+	137, // 85: discord_v1.Channel.recipients:type_name -> discord_v1.User
+	21,  // 86: discord_v1.Channel.messages:type_name -> discord_v1.Message
+	62,  // 87: discord_v1.Channel.permission_overwrites:type_name -> discord_v1.PermissionOverwrite
+	64,  // 88: discord_v1.Channel.thread_metadata:type_name -> discord_v1.ThreadMetadata
+	65,  // 89: discord_v1.Channel.member:type_name -> discord_v1.ThreadMember
+	65,  // 90: discord_v1.Channel.members:type_name -> discord_v1.ThreadMember
+	69,  // 91: discord_v1.Channel.available_tags:type_name -> discord_v1.ForumTag
+	68,  // 92: discord_v1.Channel.default_reaction_emoji:type_name -> discord_v1.ForumDefaultReaction
+	189, // 93: discord_v1.ThreadMetadata.archive_timestamp:type_name -> google.protobuf.Timestamp
+	189, // 94: discord_v1.ThreadMember.join_timestamp:type_name -> google.protobuf.Timestamp
+	96,  // 95: discord_v1.ThreadMember.member:type_name -> discord_v1.Member
+	61,  // 96: discord_v1.ThreadsList.threads:type_name -> discord_v1.Channel
+	65,  // 97: discord_v1.ThreadsList.members:type_name -> discord_v1.ThreadMember
+	65,  // 98: discord_v1.AddedThreadMember.thread_member:type_name -> discord_v1.ThreadMember
+	96,  // 99: discord_v1.AddedThreadMember.member:type_name -> discord_v1.Member
+	94,  // 100: discord_v1.AddedThreadMember.presence:type_name -> discord_v1.Presence
+	137, // 101: discord_v1.Emoji.user:type_name -> discord_v1.User
+	137, // 102: discord_v1.Sticker.user:type_name -> discord_v1.User
+	72,  // 103: discord_v1.StickerPack.stickers:type_name -> discord_v1.Sticker
+	189, // 104: discord_v1.Guild.joined_at:type_name -> google.protobuf.Timestamp
+	90,  // 105: discord_v1.Guild.roles:type_name -> discord_v1.Role
+	70,  // 106: discord_v1.Guild.emojis:type_name -> discord_v1.Emoji
+	72,  // 107: discord_v1.Guild.stickers:type_name -> discord_v1.Sticker
+	96,  // 108: discord_v1.Guild.members:type_name -> discord_v1.Member
+	94,  // 109: discord_v1.Guild.presences:type_name -> discord_v1.Presence
+	61,  // 110: discord_v1.Guild.channels:type_name -> discord_v1.Channel
+	61,  // 111: discord_v1.Guild.threads:type_name -> discord_v1.Channel
+	93,  // 112: discord_v1.Guild.voice_states:type_name -> discord_v1.VoiceState
+	118, // 113: discord_v1.Guild.stage_instances:type_name -> discord_v1.StageInstance
+	70,  // 114: discord_v1.GuildPreview.emojis:type_name -> discord_v1.Emoji
+	189, // 115: discord_v1.GuildScheduledEvent.scheduled_start_time:type_name -> google.protobuf.Timestamp
+	189, // 116: discord_v1.GuildScheduledEvent.scheduled_end_time:type_name -> google.protobuf.Timestamp
+	79,  // 117: discord_v1.GuildScheduledEvent.entity_metadata:type_name -> discord_v1.GuildScheduledEventEntityMetadata
+	137, // 118: discord_v1.GuildScheduledEvent.creator:type_name -> discord_v1.User
+	189, // 119: discord_v1.GuildScheduledEventParams.scheduled_start_time:type_name -> google.protobuf.Timestamp
+	189, // 120: discord_v1.GuildScheduledEventParams.scheduled_end_time:type_name -> google.protobuf.Timestamp
+	79,  // 121: discord_v1.GuildScheduledEventParams.entity_metadata:type_name -> discord_v1.GuildScheduledEventEntityMetadata
+	137, // 122: discord_v1.GuildScheduledEventUser.user:type_name -> discord_v1.User
+	96,  // 123: discord_v1.GuildScheduledEventUser.member:type_name -> discord_v1.Member
+	84,  // 124: discord_v1.GuildOnboarding.prompts:type_name -> discord_v1.GuildOnboardingPrompt
+	81,  // 125: discord_v1.GuildOnboarding.mode:type_name -> discord_v1.GuildOnboardingMode
+	83,  // 126: discord_v1.GuildOnboardingPrompt.type:type_name -> discord_v1.GuildOnboardingPromptType
+	85,  // 127: discord_v1.GuildOnboardingPrompt.options:type_name -> discord_v1.GuildOnboardingPromptOption
+	70,  // 128: discord_v1.GuildOnboardingPromptOption.emoji:type_name -> discord_v1.Emoji
+	137, // 129: discord_v1.GuildTemplate.creator:type_name -> discord_v1.User
+	189, // 130: discord_v1.GuildTemplate.created_at:type_name -> google.protobuf.Timestamp
+	189, // 131: discord_v1.GuildTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	75,  // 132: discord_v1.GuildTemplate.serialized_source_guild:type_name -> discord_v1.Guild
+	96,  // 133: discord_v1.VoiceState.member:type_name -> discord_v1.Member
+	189, // 134: discord_v1.VoiceState.request_to_speak_timestamp:type_name -> google.protobuf.Timestamp
+	137, // 135: discord_v1.Presence.user:type_name -> discord_v1.User
+	46,  // 136: discord_v1.Presence.activities:type_name -> discord_v1.Activity
+	97,  // 137: discord_v1.Presence.client_status:type_name -> discord_v1.ClientStatus
+	189, // 138: discord_v1.Member.joined_at:type_name -> google.protobuf.Timestamp
+	137, // 139: discord_v1.Member.user:type_name -> discord_v1.User
+	189, // 140: discord_v1.Member.premium_since:type_name -> google.protobuf.Timestamp
+	189, // 141: discord_v1.Member.communication_disabled_until:type_name -> google.protobuf.Timestamp
+	190, // 142: discord_v1.TooManyRequests.retry_after:type_name -> google.protobuf.Duration
+	90,  // 143: discord_v1.GuildRole.role:type_name -> discord_v1.Role
+	137, // 144: discord_v1.GuildBan.user:type_name -> discord_v1.User
+	103, // 145: discord_v1.AutoModerationRule.trigger_metadata:type_name -> discord_v1.AutoModerationTriggerMetadata
+	106, // 146: discord_v1.AutoModerationRule.actions:type_name -> discord_v1.AutoModerationAction
+	105, // 147: discord_v1.AutoModerationAction.metadata:type_name -> discord_v1.AutoModerationActionMetadata
+	140, // 148: discord_v1.GuildAuditLog.webhooks:type_name -> discord_v1.Webhook
+	137, // 149: discord_v1.GuildAuditLog.users:type_name -> discord_v1.User
+	109, // 150: discord_v1.GuildAuditLog.audit_log_entries:type_name -> discord_v1.AuditLogEntry
+	57,  // 151: discord_v1.GuildAuditLog.integrations:type_name -> discord_v1.Integration
+	110, // 152: discord_v1.AuditLogEntry.changes:type_name -> discord_v1.AuditLogChange
+	111, // 153: discord_v1.AuditLogEntry.options:type_name -> discord_v1.AuditLogOptions
+	189, // 154: discord_v1.GuildMemberParams.communication_disabled_until:type_name -> google.protobuf.Timestamp
+	70,  // 155: discord_v1.MessageReaction.emoji:type_name -> discord_v1.Emoji
+	117, // 156: discord_v1.GatewayBotResponse.session_start_limit:type_name -> discord_v1.SessionInformation
+	143, // 157: discord_v1.PollMedia.emoji:type_name -> discord_v1.ComponentEmoji
+	121, // 158: discord_v1.PollAnswer.media:type_name -> discord_v1.PollMedia
+	123, // 159: discord_v1.PollResults.answer_counts:type_name -> discord_v1.PollAnswerCount
+	121, // 160: discord_v1.Poll.question:type_name -> discord_v1.PollMedia
+	122, // 161: discord_v1.Poll.answers:type_name -> discord_v1.PollAnswer
+	124, // 162: discord_v1.Poll.results:type_name -> discord_v1.PollResults
+	189, // 163: discord_v1.Poll.expiry:type_name -> google.protobuf.Timestamp
+	189, // 164: discord_v1.Subscription.current_period_start:type_name -> google.protobuf.Timestamp
+	189, // 165: discord_v1.Subscription.current_period_end:type_name -> google.protobuf.Timestamp
+	189, // 166: discord_v1.Subscription.canceled_at:type_name -> google.protobuf.Timestamp
+	189, // 167: discord_v1.Entitlement.starts_at:type_name -> google.protobuf.Timestamp
+	189, // 168: discord_v1.Entitlement.ends_at:type_name -> google.protobuf.Timestamp
+	189, // 169: discord_v1.EntitlementFilterOptions.before:type_name -> google.protobuf.Timestamp
+	189, // 170: discord_v1.EntitlementFilterOptions.after:type_name -> google.protobuf.Timestamp
+	171, // 171: discord_v1.State.guild_map:type_name -> discord_v1.State.GuildMapEntry
+	172, // 172: discord_v1.State.channel_map:type_name -> discord_v1.State.ChannelMapEntry
 	7,   // 173: discord_v1.User.premium_type:type_name -> discord_v1.UserPremiumType
-	135, // 174: discord_v1.TeamMember.user:type_name -> discord_v1.User
+	137, // 174: discord_v1.TeamMember.user:type_name -> discord_v1.User
 	8,   // 175: discord_v1.TeamMember.membership_state:type_name -> discord_v1.MembershipState
-	136, // 176: discord_v1.Team.members:type_name -> discord_v1.TeamMember
+	138, // 176: discord_v1.Team.members:type_name -> discord_v1.TeamMember
 	9,   // 177: discord_v1.Webhook.type:type_name -> discord_v1.WebhookType
-	135, // 178: discord_v1.Webhook.user:type_name -> discord_v1.User
-	20,  // 179: discord_v1.WebhookParams.files:type_name -> discord_v1.File
-	181, // 180: discord_v1.WebhookParams.components:type_name -> google.protobuf.Any
-	32,  // 181: discord_v1.WebhookParams.embeds:type_name -> discord_v1.MessageEmbed
-	24,  // 182: discord_v1.WebhookParams.attachments:type_name -> discord_v1.MessageAttachment
-	23,  // 183: discord_v1.WebhookParams.allowed_mentions:type_name -> discord_v1.MessageAllowedMentions
+	137, // 178: discord_v1.Webhook.user:type_name -> discord_v1.User
+	22,  // 179: discord_v1.WebhookParams.files:type_name -> discord_v1.File
+	144, // 180: discord_v1.WebhookParams.components:type_name -> discord_v1.MessageComponent
+	34,  // 181: discord_v1.WebhookParams.embeds:type_name -> discord_v1.MessageEmbed
+	26,  // 182: discord_v1.WebhookParams.attachments:type_name -> discord_v1.MessageAttachment
+	25,  // 183: discord_v1.WebhookParams.allowed_mentions:type_name -> discord_v1.MessageAllowedMentions
 	1,   // 184: discord_v1.WebhookParams.flags:type_name -> discord_v1.MessageFlags
-	181, // 185: discord_v1.WebhookEdit.components:type_name -> google.protobuf.Any
-	32,  // 186: discord_v1.WebhookEdit.embeds:type_name -> discord_v1.MessageEmbed
-	20,  // 187: discord_v1.WebhookEdit.files:type_name -> discord_v1.File
-	24,  // 188: discord_v1.WebhookEdit.attachments:type_name -> discord_v1.MessageAttachment
-	23,  // 189: discord_v1.WebhookEdit.allowed_mentions:type_name -> discord_v1.MessageAllowedMentions
-	12,  // 190: discord_v1.ApplicationCommand.type:type_name -> discord_v1.ApplicationCommandType
-	164, // 191: discord_v1.ApplicationCommand.name_localizations:type_name -> discord_v1.ApplicationCommand.NameLocalizationsEntry
-	13,  // 192: discord_v1.ApplicationCommand.contexts:type_name -> discord_v1.InteractionContextType
-	14,  // 193: discord_v1.ApplicationCommand.integration_types:type_name -> discord_v1.ApplicationIntegrationType
-	165, // 194: discord_v1.ApplicationCommand.description_localizations:type_name -> discord_v1.ApplicationCommand.DescriptionLocalizationsEntry
-	143, // 195: discord_v1.ApplicationCommand.options:type_name -> discord_v1.ApplicationCommandOption
-	15,  // 196: discord_v1.ApplicationCommandOption.type:type_name -> discord_v1.ApplicationCommandOptionType
-	166, // 197: discord_v1.ApplicationCommandOption.name_localizations:type_name -> discord_v1.ApplicationCommandOption.NameLocalizationsEntry
-	167, // 198: discord_v1.ApplicationCommandOption.description_localizations:type_name -> discord_v1.ApplicationCommandOption.DescriptionLocalizationsEntry
-	10,  // 199: discord_v1.ApplicationCommandOption.channel_types:type_name -> discord_v1.ChannelType
-	143, // 200: discord_v1.ApplicationCommandOption.options:type_name -> discord_v1.ApplicationCommandOption
-	144, // 201: discord_v1.ApplicationCommandOption.choices:type_name -> discord_v1.ApplicationCommandOptionChoice
-	168, // 202: discord_v1.ApplicationCommandOptionChoice.name_localizations:type_name -> discord_v1.ApplicationCommandOptionChoice.NameLocalizationsEntry
-	16,  // 203: discord_v1.ApplicationCommandPermissions.type:type_name -> discord_v1.ApplicationCommandPermissionType
-	145, // 204: discord_v1.ApplicationCommandPermissionsList.permissions:type_name -> discord_v1.ApplicationCommandPermissions
-	145, // 205: discord_v1.GuildApplicationCommandPermissions.permissions:type_name -> discord_v1.ApplicationCommandPermissions
-	17,  // 206: discord_v1.Interaction.type:type_name -> discord_v1.InteractionType
-	149, // 207: discord_v1.Interaction.application_command_data:type_name -> discord_v1.ApplicationCommandInteractionData
-	151, // 208: discord_v1.Interaction.message_component_data:type_name -> discord_v1.MessageComponentInteractionData
-	153, // 209: discord_v1.Interaction.modal_submit_data:type_name -> discord_v1.ModalSubmitInteractionData
-	19,  // 210: discord_v1.Interaction.message:type_name -> discord_v1.Message
-	94,  // 211: discord_v1.Interaction.member:type_name -> discord_v1.Member
-	135, // 212: discord_v1.Interaction.user:type_name -> discord_v1.User
-	13,  // 213: discord_v1.Interaction.context:type_name -> discord_v1.InteractionContextType
-	169, // 214: discord_v1.Interaction.authorizing_integration_owners:type_name -> discord_v1.Interaction.AuthorizingIntegrationOwnersEntry
-	130, // 215: discord_v1.Interaction.entitlements:type_name -> discord_v1.Entitlement
-	12,  // 216: discord_v1.ApplicationCommandInteractionData.command_type:type_name -> discord_v1.ApplicationCommandType
-	150, // 217: discord_v1.ApplicationCommandInteractionData.resolved:type_name -> discord_v1.ApplicationCommandInteractionDataResolved
-	154, // 218: discord_v1.ApplicationCommandInteractionData.options:type_name -> discord_v1.ApplicationCommandInteractionDataOption
-	170, // 219: discord_v1.ApplicationCommandInteractionDataResolved.users:type_name -> discord_v1.ApplicationCommandInteractionDataResolved.UsersEntry
-	171, // 220: discord_v1.ApplicationCommandInteractionDataResolved.members:type_name -> discord_v1.ApplicationCommandInteractionDataResolved.MembersEntry
-	172, // 221: discord_v1.ApplicationCommandInteractionDataResolved.roles:type_name -> discord_v1.ApplicationCommandInteractionDataResolved.RolesEntry
-	173, // 222: discord_v1.ApplicationCommandInteractionDataResolved.channels:type_name -> discord_v1.ApplicationCommandInteractionDataResolved.ChannelsEntry
-	174, // 223: discord_v1.ApplicationCommandInteractionDataResolved.messages:type_name -> discord_v1.ApplicationCommandInteractionDataResolved.MessagesEntry
-	175, // 224: discord_v1.ApplicationCommandInteractionDataResolved.attachments:type_name -> discord_v1.ApplicationCommandInteractionDataResolved.AttachmentsEntry
-	11,  // 225: discord_v1.MessageComponentInteractionData.component_type:type_name -> discord_v1.ComponentType
-	152, // 226: discord_v1.MessageComponentInteractionData.resolved:type_name -> discord_v1.MessageComponentInteractionDataResolved
-	176, // 227: discord_v1.MessageComponentInteractionDataResolved.users:type_name -> discord_v1.MessageComponentInteractionDataResolved.UsersEntry
-	177, // 228: discord_v1.MessageComponentInteractionDataResolved.members:type_name -> discord_v1.MessageComponentInteractionDataResolved.MembersEntry
-	178, // 229: discord_v1.MessageComponentInteractionDataResolved.roles:type_name -> discord_v1.MessageComponentInteractionDataResolved.RolesEntry
-	179, // 230: discord_v1.MessageComponentInteractionDataResolved.channels:type_name -> discord_v1.MessageComponentInteractionDataResolved.ChannelsEntry
-	181, // 231: discord_v1.ModalSubmitInteractionData.components:type_name -> google.protobuf.Any
-	15,  // 232: discord_v1.ApplicationCommandInteractionDataOption.type:type_name -> discord_v1.ApplicationCommandOptionType
-	154, // 233: discord_v1.ApplicationCommandInteractionDataOption.options:type_name -> discord_v1.ApplicationCommandInteractionDataOption
-	18,  // 234: discord_v1.InteractionResponse.type:type_name -> discord_v1.InteractionResponseType
-	156, // 235: discord_v1.InteractionResponse.data:type_name -> discord_v1.InteractionResponseData
-	181, // 236: discord_v1.InteractionResponseData.components:type_name -> google.protobuf.Any
-	32,  // 237: discord_v1.InteractionResponseData.embeds:type_name -> discord_v1.MessageEmbed
-	23,  // 238: discord_v1.InteractionResponseData.allowed_mentions:type_name -> discord_v1.MessageAllowedMentions
-	20,  // 239: discord_v1.InteractionResponseData.files:type_name -> discord_v1.File
-	24,  // 240: discord_v1.InteractionResponseData.attachments:type_name -> discord_v1.MessageAttachment
-	123, // 241: discord_v1.InteractionResponseData.poll:type_name -> discord_v1.Poll
-	1,   // 242: discord_v1.InteractionResponseData.flags:type_name -> discord_v1.MessageFlags
-	144, // 243: discord_v1.InteractionResponseData.choices:type_name -> discord_v1.ApplicationCommandOptionChoice
-	49,  // 244: discord_v1.Application.IntegrationTypesConfigEntry.value:type_name -> discord_v1.ApplicationIntegrationTypeConfig
-	73,  // 245: discord_v1.State.GuildMapEntry.value:type_name -> discord_v1.Guild
-	59,  // 246: discord_v1.State.ChannelMapEntry.value:type_name -> discord_v1.Channel
-	135, // 247: discord_v1.ApplicationCommandInteractionDataResolved.UsersEntry.value:type_name -> discord_v1.User
-	94,  // 248: discord_v1.ApplicationCommandInteractionDataResolved.MembersEntry.value:type_name -> discord_v1.Member
-	88,  // 249: discord_v1.ApplicationCommandInteractionDataResolved.RolesEntry.value:type_name -> discord_v1.Role
-	59,  // 250: discord_v1.ApplicationCommandInteractionDataResolved.ChannelsEntry.value:type_name -> discord_v1.Channel
-	19,  // 251: discord_v1.ApplicationCommandInteractionDataResolved.MessagesEntry.value:type_name -> discord_v1.Message
-	24,  // 252: discord_v1.ApplicationCommandInteractionDataResolved.AttachmentsEntry.value:type_name -> discord_v1.MessageAttachment
-	135, // 253: discord_v1.MessageComponentInteractionDataResolved.UsersEntry.value:type_name -> discord_v1.User
-	94,  // 254: discord_v1.MessageComponentInteractionDataResolved.MembersEntry.value:type_name -> discord_v1.Member
-	88,  // 255: discord_v1.MessageComponentInteractionDataResolved.RolesEntry.value:type_name -> discord_v1.Role
-	59,  // 256: discord_v1.MessageComponentInteractionDataResolved.ChannelsEntry.value:type_name -> discord_v1.Channel
-	257, // [257:257] is the sub-list for method output_type
-	257, // [257:257] is the sub-list for method input_type
-	257, // [257:257] is the sub-list for extension type_name
-	257, // [257:257] is the sub-list for extension extendee
-	0,   // [0:257] is the sub-list for field type_name
+	144, // 185: discord_v1.WebhookEdit.components:type_name -> discord_v1.MessageComponent
+	34,  // 186: discord_v1.WebhookEdit.embeds:type_name -> discord_v1.MessageEmbed
+	22,  // 187: discord_v1.WebhookEdit.files:type_name -> discord_v1.File
+	26,  // 188: discord_v1.WebhookEdit.attachments:type_name -> discord_v1.MessageAttachment
+	25,  // 189: discord_v1.WebhookEdit.allowed_mentions:type_name -> discord_v1.MessageAllowedMentions
+	145, // 190: discord_v1.MessageComponent.actions_row:type_name -> discord_v1.ActionsRow
+	146, // 191: discord_v1.MessageComponent.button:type_name -> discord_v1.Button
+	149, // 192: discord_v1.MessageComponent.select_menu:type_name -> discord_v1.SelectMenu
+	150, // 193: discord_v1.MessageComponent.text_input:type_name -> discord_v1.TextInput
+	144, // 194: discord_v1.ActionsRow.components:type_name -> discord_v1.MessageComponent
+	12,  // 195: discord_v1.Button.style:type_name -> discord_v1.ButtonStyle
+	143, // 196: discord_v1.Button.emoji:type_name -> discord_v1.ComponentEmoji
+	143, // 197: discord_v1.SelectMenuOption.emoji:type_name -> discord_v1.ComponentEmoji
+	11,  // 198: discord_v1.SelectMenu.menu_type:type_name -> discord_v1.ComponentType
+	147, // 199: discord_v1.SelectMenu.default_values:type_name -> discord_v1.SelectMenuDefaultValue
+	148, // 200: discord_v1.SelectMenu.options:type_name -> discord_v1.SelectMenuOption
+	10,  // 201: discord_v1.SelectMenu.channel_types:type_name -> discord_v1.ChannelType
+	13,  // 202: discord_v1.TextInput.style:type_name -> discord_v1.TextInputStyle
+	14,  // 203: discord_v1.ApplicationCommand.type:type_name -> discord_v1.ApplicationCommandType
+	173, // 204: discord_v1.ApplicationCommand.name_localizations:type_name -> discord_v1.ApplicationCommand.NameLocalizationsEntry
+	15,  // 205: discord_v1.ApplicationCommand.contexts:type_name -> discord_v1.InteractionContextType
+	16,  // 206: discord_v1.ApplicationCommand.integration_types:type_name -> discord_v1.ApplicationIntegrationType
+	174, // 207: discord_v1.ApplicationCommand.description_localizations:type_name -> discord_v1.ApplicationCommand.DescriptionLocalizationsEntry
+	152, // 208: discord_v1.ApplicationCommand.options:type_name -> discord_v1.ApplicationCommandOption
+	17,  // 209: discord_v1.ApplicationCommandOption.type:type_name -> discord_v1.ApplicationCommandOptionType
+	175, // 210: discord_v1.ApplicationCommandOption.name_localizations:type_name -> discord_v1.ApplicationCommandOption.NameLocalizationsEntry
+	176, // 211: discord_v1.ApplicationCommandOption.description_localizations:type_name -> discord_v1.ApplicationCommandOption.DescriptionLocalizationsEntry
+	10,  // 212: discord_v1.ApplicationCommandOption.channel_types:type_name -> discord_v1.ChannelType
+	152, // 213: discord_v1.ApplicationCommandOption.options:type_name -> discord_v1.ApplicationCommandOption
+	153, // 214: discord_v1.ApplicationCommandOption.choices:type_name -> discord_v1.ApplicationCommandOptionChoice
+	177, // 215: discord_v1.ApplicationCommandOptionChoice.name_localizations:type_name -> discord_v1.ApplicationCommandOptionChoice.NameLocalizationsEntry
+	18,  // 216: discord_v1.ApplicationCommandPermissions.type:type_name -> discord_v1.ApplicationCommandPermissionType
+	154, // 217: discord_v1.ApplicationCommandPermissionsList.permissions:type_name -> discord_v1.ApplicationCommandPermissions
+	154, // 218: discord_v1.GuildApplicationCommandPermissions.permissions:type_name -> discord_v1.ApplicationCommandPermissions
+	19,  // 219: discord_v1.Interaction.type:type_name -> discord_v1.InteractionType
+	158, // 220: discord_v1.Interaction.application_command_data:type_name -> discord_v1.ApplicationCommandInteractionData
+	160, // 221: discord_v1.Interaction.message_component_data:type_name -> discord_v1.MessageComponentInteractionData
+	162, // 222: discord_v1.Interaction.modal_submit_data:type_name -> discord_v1.ModalSubmitInteractionData
+	158, // 223: discord_v1.Interaction.autocomplete_data:type_name -> discord_v1.ApplicationCommandInteractionData
+	21,  // 224: discord_v1.Interaction.message:type_name -> discord_v1.Message
+	96,  // 225: discord_v1.Interaction.member:type_name -> discord_v1.Member
+	137, // 226: discord_v1.Interaction.user:type_name -> discord_v1.User
+	15,  // 227: discord_v1.Interaction.context:type_name -> discord_v1.InteractionContextType
+	178, // 228: discord_v1.Interaction.authorizing_integration_owners:type_name -> discord_v1.Interaction.AuthorizingIntegrationOwnersEntry
+	132, // 229: discord_v1.Interaction.entitlements:type_name -> discord_v1.Entitlement
+	14,  // 230: discord_v1.ApplicationCommandInteractionData.command_type:type_name -> discord_v1.ApplicationCommandType
+	159, // 231: discord_v1.ApplicationCommandInteractionData.resolved:type_name -> discord_v1.ApplicationCommandInteractionDataResolved
+	163, // 232: discord_v1.ApplicationCommandInteractionData.options:type_name -> discord_v1.ApplicationCommandInteractionDataOption
+	179, // 233: discord_v1.ApplicationCommandInteractionDataResolved.users:type_name -> discord_v1.ApplicationCommandInteractionDataResolved.UsersEntry
+	180, // 234: discord_v1.ApplicationCommandInteractionDataResolved.members:type_name -> discord_v1.ApplicationCommandInteractionDataResolved.MembersEntry
+	181, // 235: discord_v1.ApplicationCommandInteractionDataResolved.roles:type_name -> discord_v1.ApplicationCommandInteractionDataResolved.RolesEntry
+	182, // 236: discord_v1.ApplicationCommandInteractionDataResolved.channels:type_name -> discord_v1.ApplicationCommandInteractionDataResolved.ChannelsEntry
+	183, // 237: discord_v1.ApplicationCommandInteractionDataResolved.messages:type_name -> discord_v1.ApplicationCommandInteractionDataResolved.MessagesEntry
+	184, // 238: discord_v1.ApplicationCommandInteractionDataResolved.attachments:type_name -> discord_v1.ApplicationCommandInteractionDataResolved.AttachmentsEntry
+	11,  // 239: discord_v1.MessageComponentInteractionData.component_type:type_name -> discord_v1.ComponentType
+	161, // 240: discord_v1.MessageComponentInteractionData.resolved:type_name -> discord_v1.MessageComponentInteractionDataResolved
+	185, // 241: discord_v1.MessageComponentInteractionDataResolved.users:type_name -> discord_v1.MessageComponentInteractionDataResolved.UsersEntry
+	186, // 242: discord_v1.MessageComponentInteractionDataResolved.members:type_name -> discord_v1.MessageComponentInteractionDataResolved.MembersEntry
+	187, // 243: discord_v1.MessageComponentInteractionDataResolved.roles:type_name -> discord_v1.MessageComponentInteractionDataResolved.RolesEntry
+	188, // 244: discord_v1.MessageComponentInteractionDataResolved.channels:type_name -> discord_v1.MessageComponentInteractionDataResolved.ChannelsEntry
+	144, // 245: discord_v1.ModalSubmitInteractionData.components:type_name -> discord_v1.MessageComponent
+	17,  // 246: discord_v1.ApplicationCommandInteractionDataOption.type:type_name -> discord_v1.ApplicationCommandOptionType
+	163, // 247: discord_v1.ApplicationCommandInteractionDataOption.options:type_name -> discord_v1.ApplicationCommandInteractionDataOption
+	20,  // 248: discord_v1.InteractionResponse.type:type_name -> discord_v1.InteractionResponseType
+	165, // 249: discord_v1.InteractionResponse.data:type_name -> discord_v1.InteractionResponseData
+	144, // 250: discord_v1.InteractionResponseData.components:type_name -> discord_v1.MessageComponent
+	34,  // 251: discord_v1.InteractionResponseData.embeds:type_name -> discord_v1.MessageEmbed
+	25,  // 252: discord_v1.InteractionResponseData.allowed_mentions:type_name -> discord_v1.MessageAllowedMentions
+	22,  // 253: discord_v1.InteractionResponseData.files:type_name -> discord_v1.File
+	26,  // 254: discord_v1.InteractionResponseData.attachments:type_name -> discord_v1.MessageAttachment
+	125, // 255: discord_v1.InteractionResponseData.poll:type_name -> discord_v1.Poll
+	153, // 256: discord_v1.InteractionResponseData.choices:type_name -> discord_v1.ApplicationCommandOptionChoice
+	51,  // 257: discord_v1.Application.IntegrationTypesConfigEntry.value:type_name -> discord_v1.ApplicationIntegrationTypeConfig
+	75,  // 258: discord_v1.State.GuildMapEntry.value:type_name -> discord_v1.Guild
+	61,  // 259: discord_v1.State.ChannelMapEntry.value:type_name -> discord_v1.Channel
+	137, // 260: discord_v1.ApplicationCommandInteractionDataResolved.UsersEntry.value:type_name -> discord_v1.User
+	96,  // 261: discord_v1.ApplicationCommandInteractionDataResolved.MembersEntry.value:type_name -> discord_v1.Member
+	90,  // 262: discord_v1.ApplicationCommandInteractionDataResolved.RolesEntry.value:type_name -> discord_v1.Role
+	61,  // 263: discord_v1.ApplicationCommandInteractionDataResolved.ChannelsEntry.value:type_name -> discord_v1.Channel
+	21,  // 264: discord_v1.ApplicationCommandInteractionDataResolved.MessagesEntry.value:type_name -> discord_v1.Message
+	26,  // 265: discord_v1.ApplicationCommandInteractionDataResolved.AttachmentsEntry.value:type_name -> discord_v1.MessageAttachment
+	137, // 266: discord_v1.MessageComponentInteractionDataResolved.UsersEntry.value:type_name -> discord_v1.User
+	96,  // 267: discord_v1.MessageComponentInteractionDataResolved.MembersEntry.value:type_name -> discord_v1.Member
+	90,  // 268: discord_v1.MessageComponentInteractionDataResolved.RolesEntry.value:type_name -> discord_v1.Role
+	61,  // 269: discord_v1.MessageComponentInteractionDataResolved.ChannelsEntry.value:type_name -> discord_v1.Channel
+	270, // [270:270] is the sub-list for method output_type
+	270, // [270:270] is the sub-list for method input_type
+	270, // [270:270] is the sub-list for extension type_name
+	270, // [270:270] is the sub-list for extension extendee
+	0,   // [0:270] is the sub-list for field type_name
 }
 
 func init() { file_discord_v1_discordgo_proto_init() }
@@ -15627,21 +16487,31 @@ func file_discord_v1_discordgo_proto_init() {
 	file_discord_v1_discordgo_proto_msgTypes[119].OneofWrappers = []any{}
 	file_discord_v1_discordgo_proto_msgTypes[120].OneofWrappers = []any{}
 	file_discord_v1_discordgo_proto_msgTypes[121].OneofWrappers = []any{}
-	file_discord_v1_discordgo_proto_msgTypes[123].OneofWrappers = []any{}
-	file_discord_v1_discordgo_proto_msgTypes[124].OneofWrappers = []any{}
-	file_discord_v1_discordgo_proto_msgTypes[125].OneofWrappers = []any{
+	file_discord_v1_discordgo_proto_msgTypes[123].OneofWrappers = []any{
+		(*MessageComponent_ActionsRow)(nil),
+		(*MessageComponent_Button)(nil),
+		(*MessageComponent_SelectMenu)(nil),
+		(*MessageComponent_TextInput)(nil),
+	}
+	file_discord_v1_discordgo_proto_msgTypes[125].OneofWrappers = []any{}
+	file_discord_v1_discordgo_proto_msgTypes[127].OneofWrappers = []any{}
+	file_discord_v1_discordgo_proto_msgTypes[128].OneofWrappers = []any{}
+	file_discord_v1_discordgo_proto_msgTypes[130].OneofWrappers = []any{}
+	file_discord_v1_discordgo_proto_msgTypes[131].OneofWrappers = []any{}
+	file_discord_v1_discordgo_proto_msgTypes[132].OneofWrappers = []any{
 		(*ApplicationCommandOptionChoice_StringValue)(nil),
 		(*ApplicationCommandOptionChoice_IntegerValue)(nil),
 		(*ApplicationCommandOptionChoice_NumberValue)(nil),
 	}
-	file_discord_v1_discordgo_proto_msgTypes[129].OneofWrappers = []any{
+	file_discord_v1_discordgo_proto_msgTypes[136].OneofWrappers = []any{
 		(*Interaction_ApplicationCommandData)(nil),
 		(*Interaction_MessageComponentData)(nil),
 		(*Interaction_ModalSubmitData)(nil),
+		(*Interaction_AutocompleteData)(nil),
 	}
-	file_discord_v1_discordgo_proto_msgTypes[130].OneofWrappers = []any{}
-	file_discord_v1_discordgo_proto_msgTypes[132].OneofWrappers = []any{}
-	file_discord_v1_discordgo_proto_msgTypes[135].OneofWrappers = []any{
+	file_discord_v1_discordgo_proto_msgTypes[137].OneofWrappers = []any{}
+	file_discord_v1_discordgo_proto_msgTypes[139].OneofWrappers = []any{}
+	file_discord_v1_discordgo_proto_msgTypes[142].OneofWrappers = []any{
 		(*ApplicationCommandInteractionDataOption_StringValue)(nil),
 		(*ApplicationCommandInteractionDataOption_IntegerValue)(nil),
 		(*ApplicationCommandInteractionDataOption_BooleanValue)(nil),
@@ -15652,15 +16522,15 @@ func file_discord_v1_discordgo_proto_init() {
 		(*ApplicationCommandInteractionDataOption_NumberValue)(nil),
 		(*ApplicationCommandInteractionDataOption_AttachmentValueId)(nil),
 	}
-	file_discord_v1_discordgo_proto_msgTypes[136].OneofWrappers = []any{}
-	file_discord_v1_discordgo_proto_msgTypes[137].OneofWrappers = []any{}
+	file_discord_v1_discordgo_proto_msgTypes[143].OneofWrappers = []any{}
+	file_discord_v1_discordgo_proto_msgTypes[144].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_discord_v1_discordgo_proto_rawDesc), len(file_discord_v1_discordgo_proto_rawDesc)),
-			NumEnums:      19,
-			NumMessages:   161,
+			NumEnums:      21,
+			NumMessages:   168,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
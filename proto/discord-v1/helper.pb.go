@@ -3071,6 +3071,295 @@ func (x *VoiceRegionsResponse) GetRegions() []*VoiceRegion {
 	return nil
 }
 
+type GuildVoiceStatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildVoiceStatesRequest) Reset() {
+	*x = GuildVoiceStatesRequest{}
+	mi := &file_discord_v1_helper_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildVoiceStatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildVoiceStatesRequest) ProtoMessage() {}
+
+func (x *GuildVoiceStatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_helper_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildVoiceStatesRequest.ProtoReflect.Descriptor instead.
+func (*GuildVoiceStatesRequest) Descriptor() ([]byte, []int) {
+	return file_discord_v1_helper_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GuildVoiceStatesRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+type GuildVoiceStatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VoiceStates   []*VoiceState          `protobuf:"bytes,1,rep,name=voice_states,json=voiceStates,proto3" json:"voice_states,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildVoiceStatesResponse) Reset() {
+	*x = GuildVoiceStatesResponse{}
+	mi := &file_discord_v1_helper_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildVoiceStatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildVoiceStatesResponse) ProtoMessage() {}
+
+func (x *GuildVoiceStatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_helper_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildVoiceStatesResponse.ProtoReflect.Descriptor instead.
+func (*GuildVoiceStatesResponse) Descriptor() ([]byte, []int) {
+	return file_discord_v1_helper_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GuildVoiceStatesResponse) GetVoiceStates() []*VoiceState {
+	if x != nil {
+		return x.VoiceStates
+	}
+	return nil
+}
+
+type UploadFileChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadFileChunk) Reset() {
+	*x = UploadFileChunk{}
+	mi := &file_discord_v1_helper_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFileChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileChunk) ProtoMessage() {}
+
+func (x *UploadFileChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_helper_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFileChunk.ProtoReflect.Descriptor instead.
+func (*UploadFileChunk) Descriptor() ([]byte, []int) {
+	return file_discord_v1_helper_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UploadFileChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UploadFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadFileResponse) Reset() {
+	*x = UploadFileResponse{}
+	mi := &file_discord_v1_helper_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileResponse) ProtoMessage() {}
+
+func (x *UploadFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_helper_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFileResponse.ProtoReflect.Descriptor instead.
+func (*UploadFileResponse) Descriptor() ([]byte, []int) {
+	return file_discord_v1_helper_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UploadFileResponse) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadFileResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type AttachmentDownloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *MessageAttachment     `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentDownloadRequest) Reset() {
+	*x = AttachmentDownloadRequest{}
+	mi := &file_discord_v1_helper_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentDownloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentDownloadRequest) ProtoMessage() {}
+
+func (x *AttachmentDownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_helper_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentDownloadRequest.ProtoReflect.Descriptor instead.
+func (*AttachmentDownloadRequest) Descriptor() ([]byte, []int) {
+	return file_discord_v1_helper_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AttachmentDownloadRequest) GetAttachment() *MessageAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+// The first chunk has the content type and size (-1 if unknown) of the attachment, and no data
+type AttachmentDownloadChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentDownloadChunk) Reset() {
+	*x = AttachmentDownloadChunk{}
+	mi := &file_discord_v1_helper_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentDownloadChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentDownloadChunk) ProtoMessage() {}
+
+func (x *AttachmentDownloadChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_helper_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentDownloadChunk.ProtoReflect.Descriptor instead.
+func (*AttachmentDownloadChunk) Descriptor() ([]byte, []int) {
+	return file_discord_v1_helper_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AttachmentDownloadChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AttachmentDownloadChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AttachmentDownloadChunk) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
 type WebhookCreateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
@@ -3082,7 +3371,7 @@ type WebhookCreateRequest struct {
 
 func (x *WebhookCreateRequest) Reset() {
 	*x = WebhookCreateRequest{}
-	mi := &file_discord_v1_helper_proto_msgTypes[60]
+	mi := &file_discord_v1_helper_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3094,7 +3383,7 @@ func (x *WebhookCreateRequest) String() string {
 func (*WebhookCreateRequest) ProtoMessage() {}
 
 func (x *WebhookCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_helper_proto_msgTypes[60]
+	mi := &file_discord_v1_helper_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3107,7 +3396,7 @@ func (x *WebhookCreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebhookCreateRequest.ProtoReflect.Descriptor instead.
 func (*WebhookCreateRequest) Descriptor() ([]byte, []int) {
-	return file_discord_v1_helper_proto_rawDescGZIP(), []int{60}
+	return file_discord_v1_helper_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *WebhookCreateRequest) GetChannelId() string {
@@ -3140,7 +3429,7 @@ type WebhookCreateResponse struct {
 
 func (x *WebhookCreateResponse) Reset() {
 	*x = WebhookCreateResponse{}
-	mi := &file_discord_v1_helper_proto_msgTypes[61]
+	mi := &file_discord_v1_helper_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3152,7 +3441,7 @@ func (x *WebhookCreateResponse) String() string {
 func (*WebhookCreateResponse) ProtoMessage() {}
 
 func (x *WebhookCreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_helper_proto_msgTypes[61]
+	mi := &file_discord_v1_helper_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3165,7 +3454,7 @@ func (x *WebhookCreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebhookCreateResponse.ProtoReflect.Descriptor instead.
 func (*WebhookCreateResponse) Descriptor() ([]byte, []int) {
-	return file_discord_v1_helper_proto_rawDescGZIP(), []int{61}
+	return file_discord_v1_helper_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *WebhookCreateResponse) GetWebhook() *Webhook {
@@ -3187,7 +3476,7 @@ type WebhookExecuteRequest struct {
 
 func (x *WebhookExecuteRequest) Reset() {
 	*x = WebhookExecuteRequest{}
-	mi := &file_discord_v1_helper_proto_msgTypes[62]
+	mi := &file_discord_v1_helper_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3199,7 +3488,7 @@ func (x *WebhookExecuteRequest) String() string {
 func (*WebhookExecuteRequest) ProtoMessage() {}
 
 func (x *WebhookExecuteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_helper_proto_msgTypes[62]
+	mi := &file_discord_v1_helper_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3212,7 +3501,7 @@ func (x *WebhookExecuteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebhookExecuteRequest.ProtoReflect.Descriptor instead.
 func (*WebhookExecuteRequest) Descriptor() ([]byte, []int) {
-	return file_discord_v1_helper_proto_rawDescGZIP(), []int{62}
+	return file_discord_v1_helper_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *WebhookExecuteRequest) GetWebhookId() string {
@@ -3252,7 +3541,7 @@ type WebhookExecuteResponse struct {
 
 func (x *WebhookExecuteResponse) Reset() {
 	*x = WebhookExecuteResponse{}
-	mi := &file_discord_v1_helper_proto_msgTypes[63]
+	mi := &file_discord_v1_helper_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3264,7 +3553,7 @@ func (x *WebhookExecuteResponse) String() string {
 func (*WebhookExecuteResponse) ProtoMessage() {}
 
 func (x *WebhookExecuteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_helper_proto_msgTypes[63]
+	mi := &file_discord_v1_helper_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3277,7 +3566,7 @@ func (x *WebhookExecuteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebhookExecuteResponse.ProtoReflect.Descriptor instead.
 func (*WebhookExecuteResponse) Descriptor() ([]byte, []int) {
-	return file_discord_v1_helper_proto_rawDescGZIP(), []int{63}
+	return file_discord_v1_helper_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *WebhookExecuteResponse) GetMessage() *Message {
@@ -3297,7 +3586,7 @@ type UserChannelPermissionsRequest struct {
 
 func (x *UserChannelPermissionsRequest) Reset() {
 	*x = UserChannelPermissionsRequest{}
-	mi := &file_discord_v1_helper_proto_msgTypes[64]
+	mi := &file_discord_v1_helper_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3309,7 +3598,7 @@ func (x *UserChannelPermissionsRequest) String() string {
 func (*UserChannelPermissionsRequest) ProtoMessage() {}
 
 func (x *UserChannelPermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_helper_proto_msgTypes[64]
+	mi := &file_discord_v1_helper_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3322,7 +3611,7 @@ func (x *UserChannelPermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserChannelPermissionsRequest.ProtoReflect.Descriptor instead.
 func (*UserChannelPermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_discord_v1_helper_proto_rawDescGZIP(), []int{64}
+	return file_discord_v1_helper_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *UserChannelPermissionsRequest) GetUserId() string {
@@ -3348,7 +3637,7 @@ type UserChannelPermissionsResponse struct {
 
 func (x *UserChannelPermissionsResponse) Reset() {
 	*x = UserChannelPermissionsResponse{}
-	mi := &file_discord_v1_helper_proto_msgTypes[65]
+	mi := &file_discord_v1_helper_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3360,7 +3649,7 @@ func (x *UserChannelPermissionsResponse) String() string {
 func (*UserChannelPermissionsResponse) ProtoMessage() {}
 
 func (x *UserChannelPermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_helper_proto_msgTypes[65]
+	mi := &file_discord_v1_helper_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3373,7 +3662,7 @@ func (x *UserChannelPermissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserChannelPermissionsResponse.ProtoReflect.Descriptor instead.
 func (*UserChannelPermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_discord_v1_helper_proto_rawDescGZIP(), []int{65}
+	return file_discord_v1_helper_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *UserChannelPermissionsResponse) GetPermissions() int64 {
@@ -3391,7 +3680,7 @@ type GatewayRequest struct {
 
 func (x *GatewayRequest) Reset() {
 	*x = GatewayRequest{}
-	mi := &file_discord_v1_helper_proto_msgTypes[66]
+	mi := &file_discord_v1_helper_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3403,7 +3692,7 @@ func (x *GatewayRequest) String() string {
 func (*GatewayRequest) ProtoMessage() {}
 
 func (x *GatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_helper_proto_msgTypes[66]
+	mi := &file_discord_v1_helper_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3416,7 +3705,7 @@ func (x *GatewayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewayRequest.ProtoReflect.Descriptor instead.
 func (*GatewayRequest) Descriptor() ([]byte, []int) {
-	return file_discord_v1_helper_proto_rawDescGZIP(), []int{66}
+	return file_discord_v1_helper_proto_rawDescGZIP(), []int{72}
 }
 
 type GatewayResponse struct {
@@ -3428,7 +3717,7 @@ type GatewayResponse struct {
 
 func (x *GatewayResponse) Reset() {
 	*x = GatewayResponse{}
-	mi := &file_discord_v1_helper_proto_msgTypes[67]
+	mi := &file_discord_v1_helper_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3440,7 +3729,7 @@ func (x *GatewayResponse) String() string {
 func (*GatewayResponse) ProtoMessage() {}
 
 func (x *GatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_helper_proto_msgTypes[67]
+	mi := &file_discord_v1_helper_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3453,7 +3742,7 @@ func (x *GatewayResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewayResponse.ProtoReflect.Descriptor instead.
 func (*GatewayResponse) Descriptor() ([]byte, []int) {
-	return file_discord_v1_helper_proto_rawDescGZIP(), []int{67}
+	return file_discord_v1_helper_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *GatewayResponse) GetUrl() string {
@@ -3471,7 +3760,7 @@ type GatewayBotRequest struct {
 
 func (x *GatewayBotRequest) Reset() {
 	*x = GatewayBotRequest{}
-	mi := &file_discord_v1_helper_proto_msgTypes[68]
+	mi := &file_discord_v1_helper_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3483,7 +3772,7 @@ func (x *GatewayBotRequest) String() string {
 func (*GatewayBotRequest) ProtoMessage() {}
 
 func (x *GatewayBotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_helper_proto_msgTypes[68]
+	mi := &file_discord_v1_helper_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3496,7 +3785,7 @@ func (x *GatewayBotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewayBotRequest.ProtoReflect.Descriptor instead.
 func (*GatewayBotRequest) Descriptor() ([]byte, []int) {
-	return file_discord_v1_helper_proto_rawDescGZIP(), []int{68}
+	return file_discord_v1_helper_proto_rawDescGZIP(), []int{74}
 }
 
 var File_discord_v1_helper_proto protoreflect.FileDescriptor
@@ -3717,7 +4006,24 @@ const file_discord_v1_helper_proto_rawDesc = "" +
 	"\tmember_id\x18\x02 \x01(\tR\bmemberId\"\x15\n" +
 	"\x13VoiceRegionsRequest\"I\n" +
 	"\x14VoiceRegionsResponse\x121\n" +
-	"\aregions\x18\x01 \x03(\v2\x17.discord_v1.VoiceRegionR\aregions\"a\n" +
+	"\aregions\x18\x01 \x03(\v2\x17.discord_v1.VoiceRegionR\aregions\"4\n" +
+	"\x17GuildVoiceStatesRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\"U\n" +
+	"\x18GuildVoiceStatesResponse\x129\n" +
+	"\fvoice_states\x18\x01 \x03(\v2\x16.discord_v1.VoiceStateR\vvoiceStates\"%\n" +
+	"\x0fUploadFileChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"E\n" +
+	"\x12UploadFileResponse\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\"Z\n" +
+	"\x19AttachmentDownloadRequest\x12=\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x1d.discord_v1.MessageAttachmentR\n" +
+	"attachment\"d\n" +
+	"\x17AttachmentDownloadChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\"a\n" +
 	"\x14WebhookCreateRequest\x12\x1d\n" +
 	"\n" +
 	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x12\n" +
@@ -3742,7 +4048,7 @@ const file_discord_v1_helper_proto_rawDesc = "" +
 	"\x0eGatewayRequest\"#\n" +
 	"\x0fGatewayResponse\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\"\x13\n" +
-	"\x11GatewayBotRequest2\xc3\x1b\n" +
+	"\x11GatewayBotRequest2\xd3\x1d\n" +
 	"\x06Helper\x12c\n" +
 	"\x12ChannelMessageSend\x12%.discord_v1.ChannelMessageSendRequest\x1a&.discord_v1.ChannelMessageSendResponse\x12x\n" +
 	"\x19ChannelMessageSendComplex\x12,.discord_v1.ChannelMessageSendComplexRequest\x1a-.discord_v1.ChannelMessageSendComplexResponse\x12r\n" +
@@ -3764,7 +4070,10 @@ const file_discord_v1_helper_proto_rawDesc = "" +
 	"\n" +
 	"GuildRoles\x12\x1d.discord_v1.GuildRolesRequest\x1a\x1e.discord_v1.GuildRolesResponse\x129\n" +
 	"\x04User\x12\x17.discord_v1.UserRequest\x1a\x18.discord_v1.UserResponse\x12`\n" +
-	"\x11UserChannelCreate\x12$.discord_v1.UserChannelCreateRequest\x1a%.discord_v1.UserChannelCreateResponse\x12J\n" +
+	"\x11UserChannelCreate\x12$.discord_v1.UserChannelCreateRequest\x1a%.discord_v1.UserChannelCreateResponse\x12K\n" +
+	"\n" +
+	"UploadFile\x12\x1b.discord_v1.UploadFileChunk\x1a\x1e.discord_v1.UploadFileResponse(\x01\x12b\n" +
+	"\x12AttachmentDownload\x12%.discord_v1.AttachmentDownloadRequest\x1a#.discord_v1.AttachmentDownloadChunk0\x01\x12J\n" +
 	"\x12InteractionRespond\x12%.discord_v1.InteractionRespondRequest\x1a\r.common.Empty\x12r\n" +
 	"\x17InteractionResponseEdit\x12*.discord_v1.InteractionResponseEditRequest\x1a+.discord_v1.InteractionResponseEditResponse\x12u\n" +
 	"\x18ApplicationCommandCreate\x12+.discord_v1.ApplicationCommandCreateRequest\x1a,.discord_v1.ApplicationCommandCreateResponse\x12o\n" +
@@ -3780,7 +4089,8 @@ const file_discord_v1_helper_proto_rawDesc = "" +
 	"\vThreadLeave\x12\x1e.discord_v1.ThreadLeaveRequest\x1a\r.common.Empty\x12D\n" +
 	"\x0fThreadMemberAdd\x12\".discord_v1.ThreadMemberAddRequest\x1a\r.common.Empty\x12J\n" +
 	"\x12ThreadMemberRemove\x12%.discord_v1.ThreadMemberRemoveRequest\x1a\r.common.Empty\x12Q\n" +
-	"\fVoiceRegions\x12\x1f.discord_v1.VoiceRegionsRequest\x1a .discord_v1.VoiceRegionsResponse\x12T\n" +
+	"\fVoiceRegions\x12\x1f.discord_v1.VoiceRegionsRequest\x1a .discord_v1.VoiceRegionsResponse\x12]\n" +
+	"\x10GuildVoiceStates\x12#.discord_v1.GuildVoiceStatesRequest\x1a$.discord_v1.GuildVoiceStatesResponse\x12T\n" +
 	"\rWebhookCreate\x12 .discord_v1.WebhookCreateRequest\x1a!.discord_v1.WebhookCreateResponse\x12W\n" +
 	"\x0eWebhookExecute\x12!.discord_v1.WebhookExecuteRequest\x1a\".discord_v1.WebhookExecuteResponse\x12o\n" +
 	"\x16UserChannelPermissions\x12).discord_v1.UserChannelPermissionsRequest\x1a*.discord_v1.UserChannelPermissionsResponse\x12B\n" +
@@ -3800,7 +4110,7 @@ func file_discord_v1_helper_proto_rawDescGZIP() []byte {
 	return file_discord_v1_helper_proto_rawDescData
 }
 
-var file_discord_v1_helper_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
+var file_discord_v1_helper_proto_msgTypes = make([]protoimpl.MessageInfo, 75)
 var file_discord_v1_helper_proto_goTypes = []any{
 	(*ChannelMessageSendRequest)(nil),         // 0: discord_v1.ChannelMessageSendRequest
 	(*ChannelMessageSendResponse)(nil),        // 1: discord_v1.ChannelMessageSendResponse
@@ -3862,160 +4172,176 @@ var file_discord_v1_helper_proto_goTypes = []any{
 	(*ThreadMemberRemoveRequest)(nil),         // 57: discord_v1.ThreadMemberRemoveRequest
 	(*VoiceRegionsRequest)(nil),               // 58: discord_v1.VoiceRegionsRequest
 	(*VoiceRegionsResponse)(nil),              // 59: discord_v1.VoiceRegionsResponse
-	(*WebhookCreateRequest)(nil),              // 60: discord_v1.WebhookCreateRequest
-	(*WebhookCreateResponse)(nil),             // 61: discord_v1.WebhookCreateResponse
-	(*WebhookExecuteRequest)(nil),             // 62: discord_v1.WebhookExecuteRequest
-	(*WebhookExecuteResponse)(nil),            // 63: discord_v1.WebhookExecuteResponse
-	(*UserChannelPermissionsRequest)(nil),     // 64: discord_v1.UserChannelPermissionsRequest
-	(*UserChannelPermissionsResponse)(nil),    // 65: discord_v1.UserChannelPermissionsResponse
-	(*GatewayRequest)(nil),                    // 66: discord_v1.GatewayRequest
-	(*GatewayResponse)(nil),                   // 67: discord_v1.GatewayResponse
-	(*GatewayBotRequest)(nil),                 // 68: discord_v1.GatewayBotRequest
-	(*Message)(nil),                           // 69: discord_v1.Message
-	(*MessageSend)(nil),                       // 70: discord_v1.MessageSend
-	(*MessageEmbed)(nil),                      // 71: discord_v1.MessageEmbed
-	(*MessageEdit)(nil),                       // 72: discord_v1.MessageEdit
-	(*Channel)(nil),                           // 73: discord_v1.Channel
-	(*PermissionOverwrite)(nil),               // 74: discord_v1.PermissionOverwrite
-	(*Guild)(nil),                             // 75: discord_v1.Guild
-	(*Member)(nil),                            // 76: discord_v1.Member
-	(*Role)(nil),                              // 77: discord_v1.Role
-	(*User)(nil),                              // 78: discord_v1.User
-	(*Interaction)(nil),                       // 79: discord_v1.Interaction
-	(*InteractionResponse)(nil),               // 80: discord_v1.InteractionResponse
-	(*WebhookEdit)(nil),                       // 81: discord_v1.WebhookEdit
-	(*ApplicationCommand)(nil),                // 82: discord_v1.ApplicationCommand
-	(*VoiceRegion)(nil),                       // 83: discord_v1.VoiceRegion
-	(*Webhook)(nil),                           // 84: discord_v1.Webhook
-	(*WebhookParams)(nil),                     // 85: discord_v1.WebhookParams
-	(*proto.Empty)(nil),                       // 86: common.Empty
-	(*GatewayBotResponse)(nil),                // 87: discord_v1.GatewayBotResponse
+	(*GuildVoiceStatesRequest)(nil),           // 60: discord_v1.GuildVoiceStatesRequest
+	(*GuildVoiceStatesResponse)(nil),          // 61: discord_v1.GuildVoiceStatesResponse
+	(*UploadFileChunk)(nil),                   // 62: discord_v1.UploadFileChunk
+	(*UploadFileResponse)(nil),                // 63: discord_v1.UploadFileResponse
+	(*AttachmentDownloadRequest)(nil),         // 64: discord_v1.AttachmentDownloadRequest
+	(*AttachmentDownloadChunk)(nil),           // 65: discord_v1.AttachmentDownloadChunk
+	(*WebhookCreateRequest)(nil),              // 66: discord_v1.WebhookCreateRequest
+	(*WebhookCreateResponse)(nil),             // 67: discord_v1.WebhookCreateResponse
+	(*WebhookExecuteRequest)(nil),             // 68: discord_v1.WebhookExecuteRequest
+	(*WebhookExecuteResponse)(nil),            // 69: discord_v1.WebhookExecuteResponse
+	(*UserChannelPermissionsRequest)(nil),     // 70: discord_v1.UserChannelPermissionsRequest
+	(*UserChannelPermissionsResponse)(nil),    // 71: discord_v1.UserChannelPermissionsResponse
+	(*GatewayRequest)(nil),                    // 72: discord_v1.GatewayRequest
+	(*GatewayResponse)(nil),                   // 73: discord_v1.GatewayResponse
+	(*GatewayBotRequest)(nil),                 // 74: discord_v1.GatewayBotRequest
+	(*Message)(nil),                           // 75: discord_v1.Message
+	(*MessageSend)(nil),                       // 76: discord_v1.MessageSend
+	(*MessageEmbed)(nil),                      // 77: discord_v1.MessageEmbed
+	(*MessageEdit)(nil),                       // 78: discord_v1.MessageEdit
+	(*Channel)(nil),                           // 79: discord_v1.Channel
+	(*PermissionOverwrite)(nil),               // 80: discord_v1.PermissionOverwrite
+	(*Guild)(nil),                             // 81: discord_v1.Guild
+	(*Member)(nil),                            // 82: discord_v1.Member
+	(*Role)(nil),                              // 83: discord_v1.Role
+	(*User)(nil),                              // 84: discord_v1.User
+	(*Interaction)(nil),                       // 85: discord_v1.Interaction
+	(*InteractionResponse)(nil),               // 86: discord_v1.InteractionResponse
+	(*WebhookEdit)(nil),                       // 87: discord_v1.WebhookEdit
+	(*ApplicationCommand)(nil),                // 88: discord_v1.ApplicationCommand
+	(*VoiceRegion)(nil),                       // 89: discord_v1.VoiceRegion
+	(*VoiceState)(nil),                        // 90: discord_v1.VoiceState
+	(*MessageAttachment)(nil),                 // 91: discord_v1.MessageAttachment
+	(*Webhook)(nil),                           // 92: discord_v1.Webhook
+	(*WebhookParams)(nil),                     // 93: discord_v1.WebhookParams
+	(*proto.Empty)(nil),                       // 94: common.Empty
+	(*GatewayBotResponse)(nil),                // 95: discord_v1.GatewayBotResponse
 }
 var file_discord_v1_helper_proto_depIdxs = []int32{
-	69, // 0: discord_v1.ChannelMessageSendResponse.message:type_name -> discord_v1.Message
-	70, // 1: discord_v1.ChannelMessageSendComplexRequest.data:type_name -> discord_v1.MessageSend
-	69, // 2: discord_v1.ChannelMessageSendComplexResponse.message:type_name -> discord_v1.Message
-	71, // 3: discord_v1.ChannelMessageSendEmbedRequest.embed:type_name -> discord_v1.MessageEmbed
-	69, // 4: discord_v1.ChannelMessageSendEmbedResponse.message:type_name -> discord_v1.Message
-	71, // 5: discord_v1.ChannelMessageSendEmbedsRequest.embeds:type_name -> discord_v1.MessageEmbed
-	69, // 6: discord_v1.ChannelMessageSendEmbedsResponse.message:type_name -> discord_v1.Message
-	69, // 7: discord_v1.ChannelMessageEditResponse.message:type_name -> discord_v1.Message
-	72, // 8: discord_v1.ChannelMessageEditComplexRequest.message_edit:type_name -> discord_v1.MessageEdit
-	69, // 9: discord_v1.ChannelMessageEditComplexResponse.message:type_name -> discord_v1.Message
-	69, // 10: discord_v1.ChannelMessagesResponse.messages:type_name -> discord_v1.Message
-	69, // 11: discord_v1.ChannelMessageResponse.message:type_name -> discord_v1.Message
-	73, // 12: discord_v1.ChannelResponse.channel:type_name -> discord_v1.Channel
-	74, // 13: discord_v1.ChannelEdit.permission_overwrites:type_name -> discord_v1.PermissionOverwrite
+	75, // 0: discord_v1.ChannelMessageSendResponse.message:type_name -> discord_v1.Message
+	76, // 1: discord_v1.ChannelMessageSendComplexRequest.data:type_name -> discord_v1.MessageSend
+	75, // 2: discord_v1.ChannelMessageSendComplexResponse.message:type_name -> discord_v1.Message
+	77, // 3: discord_v1.ChannelMessageSendEmbedRequest.embed:type_name -> discord_v1.MessageEmbed
+	75, // 4: discord_v1.ChannelMessageSendEmbedResponse.message:type_name -> discord_v1.Message
+	77, // 5: discord_v1.ChannelMessageSendEmbedsRequest.embeds:type_name -> discord_v1.MessageEmbed
+	75, // 6: discord_v1.ChannelMessageSendEmbedsResponse.message:type_name -> discord_v1.Message
+	75, // 7: discord_v1.ChannelMessageEditResponse.message:type_name -> discord_v1.Message
+	78, // 8: discord_v1.ChannelMessageEditComplexRequest.message_edit:type_name -> discord_v1.MessageEdit
+	75, // 9: discord_v1.ChannelMessageEditComplexResponse.message:type_name -> discord_v1.Message
+	75, // 10: discord_v1.ChannelMessagesResponse.messages:type_name -> discord_v1.Message
+	75, // 11: discord_v1.ChannelMessageResponse.message:type_name -> discord_v1.Message
+	79, // 12: discord_v1.ChannelResponse.channel:type_name -> discord_v1.Channel
+	80, // 13: discord_v1.ChannelEdit.permission_overwrites:type_name -> discord_v1.PermissionOverwrite
 	19, // 14: discord_v1.ChannelEditRequest.data:type_name -> discord_v1.ChannelEdit
-	73, // 15: discord_v1.ChannelEditResponse.channel:type_name -> discord_v1.Channel
-	73, // 16: discord_v1.ChannelDeleteResponse.channel:type_name -> discord_v1.Channel
-	75, // 17: discord_v1.GuildResponse.guild:type_name -> discord_v1.Guild
-	73, // 18: discord_v1.GuildChannelsResponse.channels:type_name -> discord_v1.Channel
-	76, // 19: discord_v1.GuildMembersResponse.members:type_name -> discord_v1.Member
-	76, // 20: discord_v1.GuildMemberResponse.member:type_name -> discord_v1.Member
-	77, // 21: discord_v1.GuildRolesResponse.roles:type_name -> discord_v1.Role
-	78, // 22: discord_v1.UserResponse.user:type_name -> discord_v1.User
-	73, // 23: discord_v1.UserChannelCreateResponse.channel:type_name -> discord_v1.Channel
-	79, // 24: discord_v1.InteractionRespondRequest.interaction:type_name -> discord_v1.Interaction
-	80, // 25: discord_v1.InteractionRespondRequest.response:type_name -> discord_v1.InteractionResponse
-	79, // 26: discord_v1.InteractionResponseEditRequest.interaction:type_name -> discord_v1.Interaction
-	81, // 27: discord_v1.InteractionResponseEditRequest.webhook_edit:type_name -> discord_v1.WebhookEdit
-	69, // 28: discord_v1.InteractionResponseEditResponse.message:type_name -> discord_v1.Message
-	82, // 29: discord_v1.ApplicationCommandCreateRequest.command:type_name -> discord_v1.ApplicationCommand
-	82, // 30: discord_v1.ApplicationCommandCreateResponse.command:type_name -> discord_v1.ApplicationCommand
-	82, // 31: discord_v1.ApplicationCommandEditRequest.command:type_name -> discord_v1.ApplicationCommand
-	82, // 32: discord_v1.ApplicationCommandEditResponse.command:type_name -> discord_v1.ApplicationCommand
-	82, // 33: discord_v1.ApplicationCommandsResponse.commands:type_name -> discord_v1.ApplicationCommand
-	73, // 34: discord_v1.ThreadStartResponse.channel:type_name -> discord_v1.Channel
-	83, // 35: discord_v1.VoiceRegionsResponse.regions:type_name -> discord_v1.VoiceRegion
-	84, // 36: discord_v1.WebhookCreateResponse.webhook:type_name -> discord_v1.Webhook
-	85, // 37: discord_v1.WebhookExecuteRequest.data:type_name -> discord_v1.WebhookParams
-	69, // 38: discord_v1.WebhookExecuteResponse.message:type_name -> discord_v1.Message
-	0,  // 39: discord_v1.Helper.ChannelMessageSend:input_type -> discord_v1.ChannelMessageSendRequest
-	2,  // 40: discord_v1.Helper.ChannelMessageSendComplex:input_type -> discord_v1.ChannelMessageSendComplexRequest
-	4,  // 41: discord_v1.Helper.ChannelMessageSendEmbed:input_type -> discord_v1.ChannelMessageSendEmbedRequest
-	6,  // 42: discord_v1.Helper.ChannelMessageSendEmbeds:input_type -> discord_v1.ChannelMessageSendEmbedsRequest
-	8,  // 43: discord_v1.Helper.ChannelMessageEdit:input_type -> discord_v1.ChannelMessageEditRequest
-	10, // 44: discord_v1.Helper.ChannelMessageEditComplex:input_type -> discord_v1.ChannelMessageEditComplexRequest
-	12, // 45: discord_v1.Helper.ChannelMessageDelete:input_type -> discord_v1.ChannelMessageDeleteRequest
-	13, // 46: discord_v1.Helper.ChannelMessages:input_type -> discord_v1.ChannelMessagesRequest
-	15, // 47: discord_v1.Helper.ChannelMessage:input_type -> discord_v1.ChannelMessageRequest
-	17, // 48: discord_v1.Helper.Channel:input_type -> discord_v1.ChannelRequest
-	20, // 49: discord_v1.Helper.ChannelEdit:input_type -> discord_v1.ChannelEditRequest
-	22, // 50: discord_v1.Helper.ChannelDelete:input_type -> discord_v1.ChannelDeleteRequest
-	24, // 51: discord_v1.Helper.ChannelTyping:input_type -> discord_v1.ChannelTypingRequest
-	25, // 52: discord_v1.Helper.Guild:input_type -> discord_v1.GuildRequest
-	27, // 53: discord_v1.Helper.GuildChannels:input_type -> discord_v1.GuildChannelsRequest
-	29, // 54: discord_v1.Helper.GuildMembers:input_type -> discord_v1.GuildMembersRequest
-	31, // 55: discord_v1.Helper.GuildMember:input_type -> discord_v1.GuildMemberRequest
-	33, // 56: discord_v1.Helper.GuildRoles:input_type -> discord_v1.GuildRolesRequest
-	35, // 57: discord_v1.Helper.User:input_type -> discord_v1.UserRequest
-	37, // 58: discord_v1.Helper.UserChannelCreate:input_type -> discord_v1.UserChannelCreateRequest
-	39, // 59: discord_v1.Helper.InteractionRespond:input_type -> discord_v1.InteractionRespondRequest
-	40, // 60: discord_v1.Helper.InteractionResponseEdit:input_type -> discord_v1.InteractionResponseEditRequest
-	42, // 61: discord_v1.Helper.ApplicationCommandCreate:input_type -> discord_v1.ApplicationCommandCreateRequest
-	44, // 62: discord_v1.Helper.ApplicationCommandEdit:input_type -> discord_v1.ApplicationCommandEditRequest
-	46, // 63: discord_v1.Helper.ApplicationCommandDelete:input_type -> discord_v1.ApplicationCommandDeleteRequest
-	47, // 64: discord_v1.Helper.ApplicationCommands:input_type -> discord_v1.ApplicationCommandsRequest
-	49, // 65: discord_v1.Helper.MessageReactionAdd:input_type -> discord_v1.MessageReactionAddRequest
-	50, // 66: discord_v1.Helper.MessageReactionRemove:input_type -> discord_v1.MessageReactionRemoveRequest
-	51, // 67: discord_v1.Helper.MessageReactionsRemoveAll:input_type -> discord_v1.MessageReactionsRemoveAllRequest
-	52, // 68: discord_v1.Helper.ThreadStart:input_type -> discord_v1.ThreadStartRequest
-	54, // 69: discord_v1.Helper.ThreadJoin:input_type -> discord_v1.ThreadJoinRequest
-	55, // 70: discord_v1.Helper.ThreadLeave:input_type -> discord_v1.ThreadLeaveRequest
-	56, // 71: discord_v1.Helper.ThreadMemberAdd:input_type -> discord_v1.ThreadMemberAddRequest
-	57, // 72: discord_v1.Helper.ThreadMemberRemove:input_type -> discord_v1.ThreadMemberRemoveRequest
-	58, // 73: discord_v1.Helper.VoiceRegions:input_type -> discord_v1.VoiceRegionsRequest
-	60, // 74: discord_v1.Helper.WebhookCreate:input_type -> discord_v1.WebhookCreateRequest
-	62, // 75: discord_v1.Helper.WebhookExecute:input_type -> discord_v1.WebhookExecuteRequest
-	64, // 76: discord_v1.Helper.UserChannelPermissions:input_type -> discord_v1.UserChannelPermissionsRequest
-	66, // 77: discord_v1.Helper.Gateway:input_type -> discord_v1.GatewayRequest
-	68, // 78: discord_v1.Helper.GatewayBot:input_type -> discord_v1.GatewayBotRequest
-	1,  // 79: discord_v1.Helper.ChannelMessageSend:output_type -> discord_v1.ChannelMessageSendResponse
-	3,  // 80: discord_v1.Helper.ChannelMessageSendComplex:output_type -> discord_v1.ChannelMessageSendComplexResponse
-	5,  // 81: discord_v1.Helper.ChannelMessageSendEmbed:output_type -> discord_v1.ChannelMessageSendEmbedResponse
-	7,  // 82: discord_v1.Helper.ChannelMessageSendEmbeds:output_type -> discord_v1.ChannelMessageSendEmbedsResponse
-	9,  // 83: discord_v1.Helper.ChannelMessageEdit:output_type -> discord_v1.ChannelMessageEditResponse
-	11, // 84: discord_v1.Helper.ChannelMessageEditComplex:output_type -> discord_v1.ChannelMessageEditComplexResponse
-	86, // 85: discord_v1.Helper.ChannelMessageDelete:output_type -> common.Empty
-	14, // 86: discord_v1.Helper.ChannelMessages:output_type -> discord_v1.ChannelMessagesResponse
-	16, // 87: discord_v1.Helper.ChannelMessage:output_type -> discord_v1.ChannelMessageResponse
-	18, // 88: discord_v1.Helper.Channel:output_type -> discord_v1.ChannelResponse
-	21, // 89: discord_v1.Helper.ChannelEdit:output_type -> discord_v1.ChannelEditResponse
-	23, // 90: discord_v1.Helper.ChannelDelete:output_type -> discord_v1.ChannelDeleteResponse
-	86, // 91: discord_v1.Helper.ChannelTyping:output_type -> common.Empty
-	26, // 92: discord_v1.Helper.Guild:output_type -> discord_v1.GuildResponse
-	28, // 93: discord_v1.Helper.GuildChannels:output_type -> discord_v1.GuildChannelsResponse
-	30, // 94: discord_v1.Helper.GuildMembers:output_type -> discord_v1.GuildMembersResponse
-	32, // 95: discord_v1.Helper.GuildMember:output_type -> discord_v1.GuildMemberResponse
-	34, // 96: discord_v1.Helper.GuildRoles:output_type -> discord_v1.GuildRolesResponse
-	36, // 97: discord_v1.Helper.User:output_type -> discord_v1.UserResponse
-	38, // 98: discord_v1.Helper.UserChannelCreate:output_type -> discord_v1.UserChannelCreateResponse
-	86, // 99: discord_v1.Helper.InteractionRespond:output_type -> common.Empty
-	41, // 100: discord_v1.Helper.InteractionResponseEdit:output_type -> discord_v1.InteractionResponseEditResponse
-	43, // 101: discord_v1.Helper.ApplicationCommandCreate:output_type -> discord_v1.ApplicationCommandCreateResponse
-	45, // 102: discord_v1.Helper.ApplicationCommandEdit:output_type -> discord_v1.ApplicationCommandEditResponse
-	86, // 103: discord_v1.Helper.ApplicationCommandDelete:output_type -> common.Empty
-	48, // 104: discord_v1.Helper.ApplicationCommands:output_type -> discord_v1.ApplicationCommandsResponse
-	86, // 105: discord_v1.Helper.MessageReactionAdd:output_type -> common.Empty
-	86, // 106: discord_v1.Helper.MessageReactionRemove:output_type -> common.Empty
-	86, // 107: discord_v1.Helper.MessageReactionsRemoveAll:output_type -> common.Empty
-	53, // 108: discord_v1.Helper.ThreadStart:output_type -> discord_v1.ThreadStartResponse
-	86, // 109: discord_v1.Helper.ThreadJoin:output_type -> common.Empty
-	86, // 110: discord_v1.Helper.ThreadLeave:output_type -> common.Empty
-	86, // 111: discord_v1.Helper.ThreadMemberAdd:output_type -> common.Empty
-	86, // 112: discord_v1.Helper.ThreadMemberRemove:output_type -> common.Empty
-	59, // 113: discord_v1.Helper.VoiceRegions:output_type -> discord_v1.VoiceRegionsResponse
-	61, // 114: discord_v1.Helper.WebhookCreate:output_type -> discord_v1.WebhookCreateResponse
-	63, // 115: discord_v1.Helper.WebhookExecute:output_type -> discord_v1.WebhookExecuteResponse
-	65, // 116: discord_v1.Helper.UserChannelPermissions:output_type -> discord_v1.UserChannelPermissionsResponse
-	67, // 117: discord_v1.Helper.Gateway:output_type -> discord_v1.GatewayResponse
-	87, // 118: discord_v1.Helper.GatewayBot:output_type -> discord_v1.GatewayBotResponse
-	79, // [79:119] is the sub-list for method output_type
-	39, // [39:79] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
+	79, // 15: discord_v1.ChannelEditResponse.channel:type_name -> discord_v1.Channel
+	79, // 16: discord_v1.ChannelDeleteResponse.channel:type_name -> discord_v1.Channel
+	81, // 17: discord_v1.GuildResponse.guild:type_name -> discord_v1.Guild
+	79, // 18: discord_v1.GuildChannelsResponse.channels:type_name -> discord_v1.Channel
+	82, // 19: discord_v1.GuildMembersResponse.members:type_name -> discord_v1.Member
+	82, // 20: discord_v1.GuildMemberResponse.member:type_name -> discord_v1.Member
+	83, // 21: discord_v1.GuildRolesResponse.roles:type_name -> discord_v1.Role
+	84, // 22: discord_v1.UserResponse.user:type_name -> discord_v1.User
+	79, // 23: discord_v1.UserChannelCreateResponse.channel:type_name -> discord_v1.Channel
+	85, // 24: discord_v1.InteractionRespondRequest.interaction:type_name -> discord_v1.Interaction
+	86, // 25: discord_v1.InteractionRespondRequest.response:type_name -> discord_v1.InteractionResponse
+	85, // 26: discord_v1.InteractionResponseEditRequest.interaction:type_name -> discord_v1.Interaction
+	87, // 27: discord_v1.InteractionResponseEditRequest.webhook_edit:type_name -> discord_v1.WebhookEdit
+	75, // 28: discord_v1.InteractionResponseEditResponse.message:type_name -> discord_v1.Message
+	88, // 29: discord_v1.ApplicationCommandCreateRequest.command:type_name -> discord_v1.ApplicationCommand
+	88, // 30: discord_v1.ApplicationCommandCreateResponse.command:type_name -> discord_v1.ApplicationCommand
+	88, // 31: discord_v1.ApplicationCommandEditRequest.command:type_name -> discord_v1.ApplicationCommand
+	88, // 32: discord_v1.ApplicationCommandEditResponse.command:type_name -> discord_v1.ApplicationCommand
+	88, // 33: discord_v1.ApplicationCommandsResponse.commands:type_name -> discord_v1.ApplicationCommand
+	79, // 34: discord_v1.ThreadStartResponse.channel:type_name -> discord_v1.Channel
+	89, // 35: discord_v1.VoiceRegionsResponse.regions:type_name -> discord_v1.VoiceRegion
+	90, // 36: discord_v1.GuildVoiceStatesResponse.voice_states:type_name -> discord_v1.VoiceState
+	91, // 37: discord_v1.AttachmentDownloadRequest.attachment:type_name -> discord_v1.MessageAttachment
+	92, // 38: discord_v1.WebhookCreateResponse.webhook:type_name -> discord_v1.Webhook
+	93, // 39: discord_v1.WebhookExecuteRequest.data:type_name -> discord_v1.WebhookParams
+	75, // 40: discord_v1.WebhookExecuteResponse.message:type_name -> discord_v1.Message
+	0,  // 41: discord_v1.Helper.ChannelMessageSend:input_type -> discord_v1.ChannelMessageSendRequest
+	2,  // 42: discord_v1.Helper.ChannelMessageSendComplex:input_type -> discord_v1.ChannelMessageSendComplexRequest
+	4,  // 43: discord_v1.Helper.ChannelMessageSendEmbed:input_type -> discord_v1.ChannelMessageSendEmbedRequest
+	6,  // 44: discord_v1.Helper.ChannelMessageSendEmbeds:input_type -> discord_v1.ChannelMessageSendEmbedsRequest
+	8,  // 45: discord_v1.Helper.ChannelMessageEdit:input_type -> discord_v1.ChannelMessageEditRequest
+	10, // 46: discord_v1.Helper.ChannelMessageEditComplex:input_type -> discord_v1.ChannelMessageEditComplexRequest
+	12, // 47: discord_v1.Helper.ChannelMessageDelete:input_type -> discord_v1.ChannelMessageDeleteRequest
+	13, // 48: discord_v1.Helper.ChannelMessages:input_type -> discord_v1.ChannelMessagesRequest
+	15, // 49: discord_v1.Helper.ChannelMessage:input_type -> discord_v1.ChannelMessageRequest
+	17, // 50: discord_v1.Helper.Channel:input_type -> discord_v1.ChannelRequest
+	20, // 51: discord_v1.Helper.ChannelEdit:input_type -> discord_v1.ChannelEditRequest
+	22, // 52: discord_v1.Helper.ChannelDelete:input_type -> discord_v1.ChannelDeleteRequest
+	24, // 53: discord_v1.Helper.ChannelTyping:input_type -> discord_v1.ChannelTypingRequest
+	25, // 54: discord_v1.Helper.Guild:input_type -> discord_v1.GuildRequest
+	27, // 55: discord_v1.Helper.GuildChannels:input_type -> discord_v1.GuildChannelsRequest
+	29, // 56: discord_v1.Helper.GuildMembers:input_type -> discord_v1.GuildMembersRequest
+	31, // 57: discord_v1.Helper.GuildMember:input_type -> discord_v1.GuildMemberRequest
+	33, // 58: discord_v1.Helper.GuildRoles:input_type -> discord_v1.GuildRolesRequest
+	35, // 59: discord_v1.Helper.User:input_type -> discord_v1.UserRequest
+	37, // 60: discord_v1.Helper.UserChannelCreate:input_type -> discord_v1.UserChannelCreateRequest
+	62, // 61: discord_v1.Helper.UploadFile:input_type -> discord_v1.UploadFileChunk
+	64, // 62: discord_v1.Helper.AttachmentDownload:input_type -> discord_v1.AttachmentDownloadRequest
+	39, // 63: discord_v1.Helper.InteractionRespond:input_type -> discord_v1.InteractionRespondRequest
+	40, // 64: discord_v1.Helper.InteractionResponseEdit:input_type -> discord_v1.InteractionResponseEditRequest
+	42, // 65: discord_v1.Helper.ApplicationCommandCreate:input_type -> discord_v1.ApplicationCommandCreateRequest
+	44, // 66: discord_v1.Helper.ApplicationCommandEdit:input_type -> discord_v1.ApplicationCommandEditRequest
+	46, // 67: discord_v1.Helper.ApplicationCommandDelete:input_type -> discord_v1.ApplicationCommandDeleteRequest
+	47, // 68: discord_v1.Helper.ApplicationCommands:input_type -> discord_v1.ApplicationCommandsRequest
+	49, // 69: discord_v1.Helper.MessageReactionAdd:input_type -> discord_v1.MessageReactionAddRequest
+	50, // 70: discord_v1.Helper.MessageReactionRemove:input_type -> discord_v1.MessageReactionRemoveRequest
+	51, // 71: discord_v1.Helper.MessageReactionsRemoveAll:input_type -> discord_v1.MessageReactionsRemoveAllRequest
+	52, // 72: discord_v1.Helper.ThreadStart:input_type -> discord_v1.ThreadStartRequest
+	54, // 73: discord_v1.Helper.ThreadJoin:input_type -> discord_v1.ThreadJoinRequest
+	55, // 74: discord_v1.Helper.ThreadLeave:input_type -> discord_v1.ThreadLeaveRequest
+	56, // 75: discord_v1.Helper.ThreadMemberAdd:input_type -> discord_v1.ThreadMemberAddRequest
+	57, // 76: discord_v1.Helper.ThreadMemberRemove:input_type -> discord_v1.ThreadMemberRemoveRequest
+	58, // 77: discord_v1.Helper.VoiceRegions:input_type -> discord_v1.VoiceRegionsRequest
+	60, // 78: discord_v1.Helper.GuildVoiceStates:input_type -> discord_v1.GuildVoiceStatesRequest
+	66, // 79: discord_v1.Helper.WebhookCreate:input_type -> discord_v1.WebhookCreateRequest
+	68, // 80: discord_v1.Helper.WebhookExecute:input_type -> discord_v1.WebhookExecuteRequest
+	70, // 81: discord_v1.Helper.UserChannelPermissions:input_type -> discord_v1.UserChannelPermissionsRequest
+	72, // 82: discord_v1.Helper.Gateway:input_type -> discord_v1.GatewayRequest
+	74, // 83: discord_v1.Helper.GatewayBot:input_type -> discord_v1.GatewayBotRequest
+	1,  // 84: discord_v1.Helper.ChannelMessageSend:output_type -> discord_v1.ChannelMessageSendResponse
+	3,  // 85: discord_v1.Helper.ChannelMessageSendComplex:output_type -> discord_v1.ChannelMessageSendComplexResponse
+	5,  // 86: discord_v1.Helper.ChannelMessageSendEmbed:output_type -> discord_v1.ChannelMessageSendEmbedResponse
+	7,  // 87: discord_v1.Helper.ChannelMessageSendEmbeds:output_type -> discord_v1.ChannelMessageSendEmbedsResponse
+	9,  // 88: discord_v1.Helper.ChannelMessageEdit:output_type -> discord_v1.ChannelMessageEditResponse
+	11, // 89: discord_v1.Helper.ChannelMessageEditComplex:output_type -> discord_v1.ChannelMessageEditComplexResponse
+	94, // 90: discord_v1.Helper.ChannelMessageDelete:output_type -> common.Empty
+	14, // 91: discord_v1.Helper.ChannelMessages:output_type -> discord_v1.ChannelMessagesResponse
+	16, // 92: discord_v1.Helper.ChannelMessage:output_type -> discord_v1.ChannelMessageResponse
+	18, // 93: discord_v1.Helper.Channel:output_type -> discord_v1.ChannelResponse
+	21, // 94: discord_v1.Helper.ChannelEdit:output_type -> discord_v1.ChannelEditResponse
+	23, // 95: discord_v1.Helper.ChannelDelete:output_type -> discord_v1.ChannelDeleteResponse
+	94, // 96: discord_v1.Helper.ChannelTyping:output_type -> common.Empty
+	26, // 97: discord_v1.Helper.Guild:output_type -> discord_v1.GuildResponse
+	28, // 98: discord_v1.Helper.GuildChannels:output_type -> discord_v1.GuildChannelsResponse
+	30, // 99: discord_v1.Helper.GuildMembers:output_type -> discord_v1.GuildMembersResponse
+	32, // 100: discord_v1.Helper.GuildMember:output_type -> discord_v1.GuildMemberResponse
+	34, // 101: discord_v1.Helper.GuildRoles:output_type -> discord_v1.GuildRolesResponse
+	36, // 102: discord_v1.Helper.User:output_type -> discord_v1.UserResponse
+	38, // 103: discord_v1.Helper.UserChannelCreate:output_type -> discord_v1.UserChannelCreateResponse
+	63, // 104: discord_v1.Helper.UploadFile:output_type -> discord_v1.UploadFileResponse
+	65, // 105: discord_v1.Helper.AttachmentDownload:output_type -> discord_v1.AttachmentDownloadChunk
+	94, // 106: discord_v1.Helper.InteractionRespond:output_type -> common.Empty
+	41, // 107: discord_v1.Helper.InteractionResponseEdit:output_type -> discord_v1.InteractionResponseEditResponse
+	43, // 108: discord_v1.Helper.ApplicationCommandCreate:output_type -> discord_v1.ApplicationCommandCreateResponse
+	45, // 109: discord_v1.Helper.ApplicationCommandEdit:output_type -> discord_v1.ApplicationCommandEditResponse
+	94, // 110: discord_v1.Helper.ApplicationCommandDelete:output_type -> common.Empty
+	48, // 111: discord_v1.Helper.ApplicationCommands:output_type -> discord_v1.ApplicationCommandsResponse
+	94, // 112: discord_v1.Helper.MessageReactionAdd:output_type -> common.Empty
+	94, // 113: discord_v1.Helper.MessageReactionRemove:output_type -> common.Empty
+	94, // 114: discord_v1.Helper.MessageReactionsRemoveAll:output_type -> common.Empty
+	53, // 115: discord_v1.Helper.ThreadStart:output_type -> discord_v1.ThreadStartResponse
+	94, // 116: discord_v1.Helper.ThreadJoin:output_type -> common.Empty
+	94, // 117: discord_v1.Helper.ThreadLeave:output_type -> common.Empty
+	94, // 118: discord_v1.Helper.ThreadMemberAdd:output_type -> common.Empty
+	94, // 119: discord_v1.Helper.ThreadMemberRemove:output_type -> common.Empty
+	59, // 120: discord_v1.Helper.VoiceRegions:output_type -> discord_v1.VoiceRegionsResponse
+	61, // 121: discord_v1.Helper.GuildVoiceStates:output_type -> discord_v1.GuildVoiceStatesResponse
+	67, // 122: discord_v1.Helper.WebhookCreate:output_type -> discord_v1.WebhookCreateResponse
+	69, // 123: discord_v1.Helper.WebhookExecute:output_type -> discord_v1.WebhookExecuteResponse
+	71, // 124: discord_v1.Helper.UserChannelPermissions:output_type -> discord_v1.UserChannelPermissionsResponse
+	73, // 125: discord_v1.Helper.Gateway:output_type -> discord_v1.GatewayResponse
+	95, // 126: discord_v1.Helper.GatewayBot:output_type -> discord_v1.GatewayBotResponse
+	84, // [84:127] is the sub-list for method output_type
+	41, // [41:84] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_discord_v1_helper_proto_init() }
@@ -4031,7 +4357,7 @@ func file_discord_v1_helper_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_discord_v1_helper_proto_rawDesc), len(file_discord_v1_helper_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   69,
+			NumMessages:   75,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -40,6 +40,8 @@ const (
 	Helper_GuildRoles_FullMethodName                = "/discord_v1.Helper/GuildRoles"
 	Helper_User_FullMethodName                      = "/discord_v1.Helper/User"
 	Helper_UserChannelCreate_FullMethodName         = "/discord_v1.Helper/UserChannelCreate"
+	Helper_UploadFile_FullMethodName                = "/discord_v1.Helper/UploadFile"
+	Helper_AttachmentDownload_FullMethodName        = "/discord_v1.Helper/AttachmentDownload"
 	Helper_InteractionRespond_FullMethodName        = "/discord_v1.Helper/InteractionRespond"
 	Helper_InteractionResponseEdit_FullMethodName   = "/discord_v1.Helper/InteractionResponseEdit"
 	Helper_ApplicationCommandCreate_FullMethodName  = "/discord_v1.Helper/ApplicationCommandCreate"
@@ -55,6 +57,7 @@ const (
 	Helper_ThreadMemberAdd_FullMethodName           = "/discord_v1.Helper/ThreadMemberAdd"
 	Helper_ThreadMemberRemove_FullMethodName        = "/discord_v1.Helper/ThreadMemberRemove"
 	Helper_VoiceRegions_FullMethodName              = "/discord_v1.Helper/VoiceRegions"
+	Helper_GuildVoiceStates_FullMethodName          = "/discord_v1.Helper/GuildVoiceStates"
 	Helper_WebhookCreate_FullMethodName             = "/discord_v1.Helper/WebhookCreate"
 	Helper_WebhookExecute_FullMethodName            = "/discord_v1.Helper/WebhookExecute"
 	Helper_UserChannelPermissions_FullMethodName    = "/discord_v1.Helper/UserChannelPermissions"
@@ -90,6 +93,11 @@ type HelperClient interface {
 	// User operations
 	User(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*UserResponse, error)
 	UserChannelCreate(ctx context.Context, in *UserChannelCreateRequest, opts ...grpc.CallOption) (*UserChannelCreateResponse, error)
+	// File operations
+	// Uploads the content of a large file, which the next call references with File.upload_id.
+	UploadFile(ctx context.Context, opts ...grpc.CallOption) (Helper_UploadFileClient, error)
+	// Streams the content of an attachment, fetched (or cached) by the runtime.
+	AttachmentDownload(ctx context.Context, in *AttachmentDownloadRequest, opts ...grpc.CallOption) (Helper_AttachmentDownloadClient, error)
 	// Interaction operations
 	InteractionRespond(ctx context.Context, in *InteractionRespondRequest, opts ...grpc.CallOption) (*proto.Empty, error)
 	InteractionResponseEdit(ctx context.Context, in *InteractionResponseEditRequest, opts ...grpc.CallOption) (*InteractionResponseEditResponse, error)
@@ -110,6 +118,7 @@ type HelperClient interface {
 	ThreadMemberRemove(ctx context.Context, in *ThreadMemberRemoveRequest, opts ...grpc.CallOption) (*proto.Empty, error)
 	// Voice operations
 	VoiceRegions(ctx context.Context, in *VoiceRegionsRequest, opts ...grpc.CallOption) (*VoiceRegionsResponse, error)
+	GuildVoiceStates(ctx context.Context, in *GuildVoiceStatesRequest, opts ...grpc.CallOption) (*GuildVoiceStatesResponse, error)
 	// Webhook operations
 	WebhookCreate(ctx context.Context, in *WebhookCreateRequest, opts ...grpc.CallOption) (*WebhookCreateResponse, error)
 	WebhookExecute(ctx context.Context, in *WebhookExecuteRequest, opts ...grpc.CallOption) (*WebhookExecuteResponse, error)
@@ -308,6 +317,72 @@ func (c *helperClient) UserChannelCreate(ctx context.Context, in *UserChannelCre
 	return out, nil
 }
 
+func (c *helperClient) UploadFile(ctx context.Context, opts ...grpc.CallOption) (Helper_UploadFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Helper_ServiceDesc.Streams[0], Helper_UploadFile_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &helperUploadFileClient{stream}
+	return x, nil
+}
+
+type Helper_UploadFileClient interface {
+	Send(*UploadFileChunk) error
+	CloseAndRecv() (*UploadFileResponse, error)
+	grpc.ClientStream
+}
+
+type helperUploadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *helperUploadFileClient) Send(m *UploadFileChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *helperUploadFileClient) CloseAndRecv() (*UploadFileResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *helperClient) AttachmentDownload(ctx context.Context, in *AttachmentDownloadRequest, opts ...grpc.CallOption) (Helper_AttachmentDownloadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Helper_ServiceDesc.Streams[1], Helper_AttachmentDownload_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &helperAttachmentDownloadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Helper_AttachmentDownloadClient interface {
+	Recv() (*AttachmentDownloadChunk, error)
+	grpc.ClientStream
+}
+
+type helperAttachmentDownloadClient struct {
+	grpc.ClientStream
+}
+
+func (x *helperAttachmentDownloadClient) Recv() (*AttachmentDownloadChunk, error) {
+	m := new(AttachmentDownloadChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *helperClient) InteractionRespond(ctx context.Context, in *InteractionRespondRequest, opts ...grpc.CallOption) (*proto.Empty, error) {
 	out := new(proto.Empty)
 	err := c.cc.Invoke(ctx, Helper_InteractionRespond_FullMethodName, in, out, opts...)
@@ -443,6 +518,15 @@ func (c *helperClient) VoiceRegions(ctx context.Context, in *VoiceRegionsRequest
 	return out, nil
 }
 
+func (c *helperClient) GuildVoiceStates(ctx context.Context, in *GuildVoiceStatesRequest, opts ...grpc.CallOption) (*GuildVoiceStatesResponse, error) {
+	out := new(GuildVoiceStatesResponse)
+	err := c.cc.Invoke(ctx, Helper_GuildVoiceStates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *helperClient) WebhookCreate(ctx context.Context, in *WebhookCreateRequest, opts ...grpc.CallOption) (*WebhookCreateResponse, error) {
 	out := new(WebhookCreateResponse)
 	err := c.cc.Invoke(ctx, Helper_WebhookCreate_FullMethodName, in, out, opts...)
@@ -516,6 +600,11 @@ type HelperServer interface {
 	// User operations
 	User(context.Context, *UserRequest) (*UserResponse, error)
 	UserChannelCreate(context.Context, *UserChannelCreateRequest) (*UserChannelCreateResponse, error)
+	// File operations
+	// Uploads the content of a large file, which the next call references with File.upload_id.
+	UploadFile(Helper_UploadFileServer) error
+	// Streams the content of an attachment, fetched (or cached) by the runtime.
+	AttachmentDownload(*AttachmentDownloadRequest, Helper_AttachmentDownloadServer) error
 	// Interaction operations
 	InteractionRespond(context.Context, *InteractionRespondRequest) (*proto.Empty, error)
 	InteractionResponseEdit(context.Context, *InteractionResponseEditRequest) (*InteractionResponseEditResponse, error)
@@ -536,6 +625,7 @@ type HelperServer interface {
 	ThreadMemberRemove(context.Context, *ThreadMemberRemoveRequest) (*proto.Empty, error)
 	// Voice operations
 	VoiceRegions(context.Context, *VoiceRegionsRequest) (*VoiceRegionsResponse, error)
+	GuildVoiceStates(context.Context, *GuildVoiceStatesRequest) (*GuildVoiceStatesResponse, error)
 	// Webhook operations
 	WebhookCreate(context.Context, *WebhookCreateRequest) (*WebhookCreateResponse, error)
 	WebhookExecute(context.Context, *WebhookExecuteRequest) (*WebhookExecuteResponse, error)
@@ -610,6 +700,12 @@ func (UnimplementedHelperServer) User(context.Context, *UserRequest) (*UserRespo
 func (UnimplementedHelperServer) UserChannelCreate(context.Context, *UserChannelCreateRequest) (*UserChannelCreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserChannelCreate not implemented")
 }
+func (UnimplementedHelperServer) UploadFile(Helper_UploadFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadFile not implemented")
+}
+func (UnimplementedHelperServer) AttachmentDownload(*AttachmentDownloadRequest, Helper_AttachmentDownloadServer) error {
+	return status.Errorf(codes.Unimplemented, "method AttachmentDownload not implemented")
+}
 func (UnimplementedHelperServer) InteractionRespond(context.Context, *InteractionRespondRequest) (*proto.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InteractionRespond not implemented")
 }
@@ -655,6 +751,9 @@ func (UnimplementedHelperServer) ThreadMemberRemove(context.Context, *ThreadMemb
 func (UnimplementedHelperServer) VoiceRegions(context.Context, *VoiceRegionsRequest) (*VoiceRegionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VoiceRegions not implemented")
 }
+func (UnimplementedHelperServer) GuildVoiceStates(context.Context, *GuildVoiceStatesRequest) (*GuildVoiceStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuildVoiceStates not implemented")
+}
 func (UnimplementedHelperServer) WebhookCreate(context.Context, *WebhookCreateRequest) (*WebhookCreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WebhookCreate not implemented")
 }
@@ -1042,6 +1141,53 @@ func _Helper_UserChannelCreate_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Helper_UploadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(HelperServer).UploadFile(&helperUploadFileServer{stream})
+}
+
+type Helper_UploadFileServer interface {
+	SendAndClose(*UploadFileResponse) error
+	Recv() (*UploadFileChunk, error)
+	grpc.ServerStream
+}
+
+type helperUploadFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *helperUploadFileServer) SendAndClose(m *UploadFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *helperUploadFileServer) Recv() (*UploadFileChunk, error) {
+	m := new(UploadFileChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Helper_AttachmentDownload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AttachmentDownloadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HelperServer).AttachmentDownload(m, &helperAttachmentDownloadServer{stream})
+}
+
+type Helper_AttachmentDownloadServer interface {
+	Send(*AttachmentDownloadChunk) error
+	grpc.ServerStream
+}
+
+type helperAttachmentDownloadServer struct {
+	grpc.ServerStream
+}
+
+func (x *helperAttachmentDownloadServer) Send(m *AttachmentDownloadChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Helper_InteractionRespond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InteractionRespondRequest)
 	if err := dec(in); err != nil {
@@ -1312,6 +1458,24 @@ func _Helper_VoiceRegions_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Helper_GuildVoiceStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuildVoiceStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelperServer).GuildVoiceStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Helper_GuildVoiceStates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelperServer).GuildVoiceStates(ctx, req.(*GuildVoiceStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Helper_WebhookCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WebhookCreateRequest)
 	if err := dec(in); err != nil {
@@ -1549,6 +1713,10 @@ var Helper_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VoiceRegions",
 			Handler:    _Helper_VoiceRegions_Handler,
 		},
+		{
+			MethodName: "GuildVoiceStates",
+			Handler:    _Helper_GuildVoiceStates_Handler,
+		},
 		{
 			MethodName: "WebhookCreate",
 			Handler:    _Helper_WebhookCreate_Handler,
@@ -1570,6 +1738,17 @@ var Helper_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Helper_GatewayBot_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadFile",
+			Handler:       _Helper_UploadFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "AttachmentDownload",
+			Handler:       _Helper_AttachmentDownload_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "discord-v1/helper.proto",
 }
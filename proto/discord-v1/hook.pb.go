@@ -22,28 +22,1445 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type OnEventRequest struct {
+// GatewayEvent carries a gateway event that has no dedicated hook.
+//
+// Events of a type that isn't modeled below are sent as raw, with the JSON payload from the gateway.
+type GatewayEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Type      string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                            // Gateway event name (e.g. "CHANNEL_CREATE")
+	GuildId   string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`       // Guild where the event happened, empty for events outside guilds
+	ChannelId string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Channel where the event happened, if any
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*GatewayEvent_ChannelCreate
+	//	*GatewayEvent_ChannelUpdate
+	//	*GatewayEvent_ChannelDelete
+	//	*GatewayEvent_ChannelPinsUpdate
+	//	*GatewayEvent_ThreadCreate
+	//	*GatewayEvent_ThreadUpdate
+	//	*GatewayEvent_ThreadDelete
+	//	*GatewayEvent_ThreadListSync
+	//	*GatewayEvent_ThreadMemberUpdate
+	//	*GatewayEvent_ThreadMembersUpdate
+	//	*GatewayEvent_GuildRoleCreate
+	//	*GatewayEvent_GuildRoleUpdate
+	//	*GatewayEvent_GuildRoleDelete
+	//	*GatewayEvent_GuildEmojisUpdate
+	//	*GatewayEvent_GuildIntegrationsUpdate
+	//	*GatewayEvent_WebhooksUpdate
+	//	*GatewayEvent_UserUpdate
+	//	*GatewayEvent_PresenceUpdate
+	//	*GatewayEvent_TypingStart
+	//	*GatewayEvent_InviteCreate
+	//	*GatewayEvent_InviteDelete
+	//	*GatewayEvent_GuildScheduledEventCreate
+	//	*GatewayEvent_GuildScheduledEventUpdate
+	//	*GatewayEvent_GuildScheduledEventDelete
+	//	*GatewayEvent_GuildScheduledEventUserAdd
+	//	*GatewayEvent_GuildScheduledEventUserRemove
+	//	*GatewayEvent_StageInstanceCreate
+	//	*GatewayEvent_StageInstanceUpdate
+	//	*GatewayEvent_StageInstanceDelete
+	//	*GatewayEvent_AutoModerationRuleCreate
+	//	*GatewayEvent_AutoModerationRuleUpdate
+	//	*GatewayEvent_AutoModerationRuleDelete
+	//	*GatewayEvent_AutoModerationActionExecution
+	//	*GatewayEvent_Raw
+	Payload       isGatewayEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayEvent) Reset() {
+	*x = GatewayEvent{}
+	mi := &file_discord_v1_hook_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayEvent) ProtoMessage() {}
+
+func (x *GatewayEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayEvent.ProtoReflect.Descriptor instead.
+func (*GatewayEvent) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GatewayEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GatewayEvent) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *GatewayEvent) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *GatewayEvent) GetPayload() isGatewayEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetChannelCreate() *Channel {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_ChannelCreate); ok {
+			return x.ChannelCreate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetChannelUpdate() *Channel {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_ChannelUpdate); ok {
+			return x.ChannelUpdate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetChannelDelete() *Channel {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_ChannelDelete); ok {
+			return x.ChannelDelete
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetChannelPinsUpdate() *ChannelPinsUpdateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_ChannelPinsUpdate); ok {
+			return x.ChannelPinsUpdate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetThreadCreate() *ThreadCreateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_ThreadCreate); ok {
+			return x.ThreadCreate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetThreadUpdate() *ThreadUpdateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_ThreadUpdate); ok {
+			return x.ThreadUpdate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetThreadDelete() *Channel {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_ThreadDelete); ok {
+			return x.ThreadDelete
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetThreadListSync() *ThreadListSyncEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_ThreadListSync); ok {
+			return x.ThreadListSync
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetThreadMemberUpdate() *ThreadMember {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_ThreadMemberUpdate); ok {
+			return x.ThreadMemberUpdate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetThreadMembersUpdate() *ThreadMembersUpdateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_ThreadMembersUpdate); ok {
+			return x.ThreadMembersUpdate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetGuildRoleCreate() *Role {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_GuildRoleCreate); ok {
+			return x.GuildRoleCreate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetGuildRoleUpdate() *Role {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_GuildRoleUpdate); ok {
+			return x.GuildRoleUpdate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetGuildRoleDelete() string {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_GuildRoleDelete); ok {
+			return x.GuildRoleDelete
+		}
+	}
+	return ""
+}
+
+func (x *GatewayEvent) GetGuildEmojisUpdate() *GuildEmojisUpdateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_GuildEmojisUpdate); ok {
+			return x.GuildEmojisUpdate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetGuildIntegrationsUpdate() *proto.Empty {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_GuildIntegrationsUpdate); ok {
+			return x.GuildIntegrationsUpdate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetWebhooksUpdate() *proto.Empty {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_WebhooksUpdate); ok {
+			return x.WebhooksUpdate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetUserUpdate() *User {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_UserUpdate); ok {
+			return x.UserUpdate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetPresenceUpdate() *Presence {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_PresenceUpdate); ok {
+			return x.PresenceUpdate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetTypingStart() *TypingStartEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_TypingStart); ok {
+			return x.TypingStart
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetInviteCreate() *InviteCreateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_InviteCreate); ok {
+			return x.InviteCreate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetInviteDelete() *InviteDeleteEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_InviteDelete); ok {
+			return x.InviteDelete
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetGuildScheduledEventCreate() *GuildScheduledEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_GuildScheduledEventCreate); ok {
+			return x.GuildScheduledEventCreate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetGuildScheduledEventUpdate() *GuildScheduledEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_GuildScheduledEventUpdate); ok {
+			return x.GuildScheduledEventUpdate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetGuildScheduledEventDelete() *GuildScheduledEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_GuildScheduledEventDelete); ok {
+			return x.GuildScheduledEventDelete
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetGuildScheduledEventUserAdd() *GuildScheduledEventUserEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_GuildScheduledEventUserAdd); ok {
+			return x.GuildScheduledEventUserAdd
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetGuildScheduledEventUserRemove() *GuildScheduledEventUserEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_GuildScheduledEventUserRemove); ok {
+			return x.GuildScheduledEventUserRemove
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetStageInstanceCreate() *StageInstance {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_StageInstanceCreate); ok {
+			return x.StageInstanceCreate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetStageInstanceUpdate() *StageInstance {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_StageInstanceUpdate); ok {
+			return x.StageInstanceUpdate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetStageInstanceDelete() *StageInstance {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_StageInstanceDelete); ok {
+			return x.StageInstanceDelete
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetAutoModerationRuleCreate() *AutoModerationRule {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_AutoModerationRuleCreate); ok {
+			return x.AutoModerationRuleCreate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetAutoModerationRuleUpdate() *AutoModerationRule {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_AutoModerationRuleUpdate); ok {
+			return x.AutoModerationRuleUpdate
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetAutoModerationRuleDelete() *AutoModerationRule {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_AutoModerationRuleDelete); ok {
+			return x.AutoModerationRuleDelete
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetAutoModerationActionExecution() *AutoModerationActionExecutionEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_AutoModerationActionExecution); ok {
+			return x.AutoModerationActionExecution
+		}
+	}
+	return nil
+}
+
+func (x *GatewayEvent) GetRaw() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*GatewayEvent_Raw); ok {
+			return x.Raw
+		}
+	}
+	return nil
+}
+
+type isGatewayEvent_Payload interface {
+	isGatewayEvent_Payload()
+}
+
+type GatewayEvent_ChannelCreate struct {
+	ChannelCreate *Channel `protobuf:"bytes,10,opt,name=channel_create,json=channelCreate,proto3,oneof"`
+}
+
+type GatewayEvent_ChannelUpdate struct {
+	ChannelUpdate *Channel `protobuf:"bytes,11,opt,name=channel_update,json=channelUpdate,proto3,oneof"`
+}
+
+type GatewayEvent_ChannelDelete struct {
+	ChannelDelete *Channel `protobuf:"bytes,12,opt,name=channel_delete,json=channelDelete,proto3,oneof"`
+}
+
+type GatewayEvent_ChannelPinsUpdate struct {
+	ChannelPinsUpdate *ChannelPinsUpdateEvent `protobuf:"bytes,13,opt,name=channel_pins_update,json=channelPinsUpdate,proto3,oneof"`
+}
+
+type GatewayEvent_ThreadCreate struct {
+	ThreadCreate *ThreadCreateEvent `protobuf:"bytes,20,opt,name=thread_create,json=threadCreate,proto3,oneof"`
+}
+
+type GatewayEvent_ThreadUpdate struct {
+	ThreadUpdate *ThreadUpdateEvent `protobuf:"bytes,21,opt,name=thread_update,json=threadUpdate,proto3,oneof"`
+}
+
+type GatewayEvent_ThreadDelete struct {
+	ThreadDelete *Channel `protobuf:"bytes,22,opt,name=thread_delete,json=threadDelete,proto3,oneof"`
+}
+
+type GatewayEvent_ThreadListSync struct {
+	ThreadListSync *ThreadListSyncEvent `protobuf:"bytes,23,opt,name=thread_list_sync,json=threadListSync,proto3,oneof"`
+}
+
+type GatewayEvent_ThreadMemberUpdate struct {
+	ThreadMemberUpdate *ThreadMember `protobuf:"bytes,24,opt,name=thread_member_update,json=threadMemberUpdate,proto3,oneof"`
+}
+
+type GatewayEvent_ThreadMembersUpdate struct {
+	ThreadMembersUpdate *ThreadMembersUpdateEvent `protobuf:"bytes,25,opt,name=thread_members_update,json=threadMembersUpdate,proto3,oneof"`
+}
+
+type GatewayEvent_GuildRoleCreate struct {
+	GuildRoleCreate *Role `protobuf:"bytes,30,opt,name=guild_role_create,json=guildRoleCreate,proto3,oneof"`
+}
+
+type GatewayEvent_GuildRoleUpdate struct {
+	GuildRoleUpdate *Role `protobuf:"bytes,31,opt,name=guild_role_update,json=guildRoleUpdate,proto3,oneof"`
+}
+
+type GatewayEvent_GuildRoleDelete struct {
+	GuildRoleDelete string `protobuf:"bytes,32,opt,name=guild_role_delete,json=guildRoleDelete,proto3,oneof"` // Role ID
+}
+
+type GatewayEvent_GuildEmojisUpdate struct {
+	GuildEmojisUpdate *GuildEmojisUpdateEvent `protobuf:"bytes,33,opt,name=guild_emojis_update,json=guildEmojisUpdate,proto3,oneof"`
+}
+
+type GatewayEvent_GuildIntegrationsUpdate struct {
+	GuildIntegrationsUpdate *proto.Empty `protobuf:"bytes,34,opt,name=guild_integrations_update,json=guildIntegrationsUpdate,proto3,oneof"`
+}
+
+type GatewayEvent_WebhooksUpdate struct {
+	WebhooksUpdate *proto.Empty `protobuf:"bytes,35,opt,name=webhooks_update,json=webhooksUpdate,proto3,oneof"`
+}
+
+type GatewayEvent_UserUpdate struct {
+	UserUpdate *User `protobuf:"bytes,36,opt,name=user_update,json=userUpdate,proto3,oneof"`
+}
+
+type GatewayEvent_PresenceUpdate struct {
+	PresenceUpdate *Presence `protobuf:"bytes,40,opt,name=presence_update,json=presenceUpdate,proto3,oneof"`
+}
+
+type GatewayEvent_TypingStart struct {
+	TypingStart *TypingStartEvent `protobuf:"bytes,41,opt,name=typing_start,json=typingStart,proto3,oneof"`
+}
+
+type GatewayEvent_InviteCreate struct {
+	InviteCreate *InviteCreateEvent `protobuf:"bytes,50,opt,name=invite_create,json=inviteCreate,proto3,oneof"`
+}
+
+type GatewayEvent_InviteDelete struct {
+	InviteDelete *InviteDeleteEvent `protobuf:"bytes,51,opt,name=invite_delete,json=inviteDelete,proto3,oneof"`
+}
+
+type GatewayEvent_GuildScheduledEventCreate struct {
+	GuildScheduledEventCreate *GuildScheduledEvent `protobuf:"bytes,60,opt,name=guild_scheduled_event_create,json=guildScheduledEventCreate,proto3,oneof"`
+}
+
+type GatewayEvent_GuildScheduledEventUpdate struct {
+	GuildScheduledEventUpdate *GuildScheduledEvent `protobuf:"bytes,61,opt,name=guild_scheduled_event_update,json=guildScheduledEventUpdate,proto3,oneof"`
+}
+
+type GatewayEvent_GuildScheduledEventDelete struct {
+	GuildScheduledEventDelete *GuildScheduledEvent `protobuf:"bytes,62,opt,name=guild_scheduled_event_delete,json=guildScheduledEventDelete,proto3,oneof"`
+}
+
+type GatewayEvent_GuildScheduledEventUserAdd struct {
+	GuildScheduledEventUserAdd *GuildScheduledEventUserEvent `protobuf:"bytes,63,opt,name=guild_scheduled_event_user_add,json=guildScheduledEventUserAdd,proto3,oneof"`
+}
+
+type GatewayEvent_GuildScheduledEventUserRemove struct {
+	GuildScheduledEventUserRemove *GuildScheduledEventUserEvent `protobuf:"bytes,64,opt,name=guild_scheduled_event_user_remove,json=guildScheduledEventUserRemove,proto3,oneof"`
+}
+
+type GatewayEvent_StageInstanceCreate struct {
+	StageInstanceCreate *StageInstance `protobuf:"bytes,70,opt,name=stage_instance_create,json=stageInstanceCreate,proto3,oneof"`
+}
+
+type GatewayEvent_StageInstanceUpdate struct {
+	StageInstanceUpdate *StageInstance `protobuf:"bytes,71,opt,name=stage_instance_update,json=stageInstanceUpdate,proto3,oneof"`
+}
+
+type GatewayEvent_StageInstanceDelete struct {
+	StageInstanceDelete *StageInstance `protobuf:"bytes,72,opt,name=stage_instance_delete,json=stageInstanceDelete,proto3,oneof"`
+}
+
+type GatewayEvent_AutoModerationRuleCreate struct {
+	AutoModerationRuleCreate *AutoModerationRule `protobuf:"bytes,80,opt,name=auto_moderation_rule_create,json=autoModerationRuleCreate,proto3,oneof"`
+}
+
+type GatewayEvent_AutoModerationRuleUpdate struct {
+	AutoModerationRuleUpdate *AutoModerationRule `protobuf:"bytes,81,opt,name=auto_moderation_rule_update,json=autoModerationRuleUpdate,proto3,oneof"`
+}
+
+type GatewayEvent_AutoModerationRuleDelete struct {
+	AutoModerationRuleDelete *AutoModerationRule `protobuf:"bytes,82,opt,name=auto_moderation_rule_delete,json=autoModerationRuleDelete,proto3,oneof"`
+}
+
+type GatewayEvent_AutoModerationActionExecution struct {
+	AutoModerationActionExecution *AutoModerationActionExecutionEvent `protobuf:"bytes,83,opt,name=auto_moderation_action_execution,json=autoModerationActionExecution,proto3,oneof"`
+}
+
+type GatewayEvent_Raw struct {
+	Raw []byte `protobuf:"bytes,100,opt,name=raw,proto3,oneof"` // JSON payload of an event type that isn't modeled
+}
+
+func (*GatewayEvent_ChannelCreate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_ChannelUpdate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_ChannelDelete) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_ChannelPinsUpdate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_ThreadCreate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_ThreadUpdate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_ThreadDelete) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_ThreadListSync) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_ThreadMemberUpdate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_ThreadMembersUpdate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_GuildRoleCreate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_GuildRoleUpdate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_GuildRoleDelete) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_GuildEmojisUpdate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_GuildIntegrationsUpdate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_WebhooksUpdate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_UserUpdate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_PresenceUpdate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_TypingStart) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_InviteCreate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_InviteDelete) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_GuildScheduledEventCreate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_GuildScheduledEventUpdate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_GuildScheduledEventDelete) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_GuildScheduledEventUserAdd) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_GuildScheduledEventUserRemove) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_StageInstanceCreate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_StageInstanceUpdate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_StageInstanceDelete) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_AutoModerationRuleCreate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_AutoModerationRuleUpdate) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_AutoModerationRuleDelete) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_AutoModerationActionExecution) isGatewayEvent_Payload() {}
+
+func (*GatewayEvent_Raw) isGatewayEvent_Payload() {}
+
+type ChannelPinsUpdateEvent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LastPinTimestamp string                 `protobuf:"bytes,1,opt,name=last_pin_timestamp,json=lastPinTimestamp,proto3" json:"last_pin_timestamp,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ChannelPinsUpdateEvent) Reset() {
+	*x = ChannelPinsUpdateEvent{}
+	mi := &file_discord_v1_hook_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPinsUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPinsUpdateEvent) ProtoMessage() {}
+
+func (x *ChannelPinsUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPinsUpdateEvent.ProtoReflect.Descriptor instead.
+func (*ChannelPinsUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChannelPinsUpdateEvent) GetLastPinTimestamp() string {
+	if x != nil {
+		return x.LastPinTimestamp
+	}
+	return ""
+}
+
+type ThreadCreateEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Thread        *Channel               `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	NewlyCreated  bool                   `protobuf:"varint,2,opt,name=newly_created,json=newlyCreated,proto3" json:"newly_created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ThreadCreateEvent) Reset() {
+	*x = ThreadCreateEvent{}
+	mi := &file_discord_v1_hook_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThreadCreateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadCreateEvent) ProtoMessage() {}
+
+func (x *ThreadCreateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadCreateEvent.ProtoReflect.Descriptor instead.
+func (*ThreadCreateEvent) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ThreadCreateEvent) GetThread() *Channel {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+func (x *ThreadCreateEvent) GetNewlyCreated() bool {
+	if x != nil {
+		return x.NewlyCreated
+	}
+	return false
+}
+
+// The previous version of the thread is only set when the runtime had it cached.
+type ThreadUpdateEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Thread        *Channel               `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Before        *Channel               `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ThreadUpdateEvent) Reset() {
+	*x = ThreadUpdateEvent{}
+	mi := &file_discord_v1_hook_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThreadUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadUpdateEvent) ProtoMessage() {}
+
+func (x *ThreadUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadUpdateEvent.ProtoReflect.Descriptor instead.
+func (*ThreadUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ThreadUpdateEvent) GetThread() *Channel {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+func (x *ThreadUpdateEvent) GetBefore() *Channel {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+type ThreadListSyncEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelIds    []string               `protobuf:"bytes,1,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	Threads       []*Channel             `protobuf:"bytes,2,rep,name=threads,proto3" json:"threads,omitempty"`
+	Members       []*ThreadMember        `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ThreadListSyncEvent) Reset() {
+	*x = ThreadListSyncEvent{}
+	mi := &file_discord_v1_hook_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThreadListSyncEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadListSyncEvent) ProtoMessage() {}
+
+func (x *ThreadListSyncEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadListSyncEvent.ProtoReflect.Descriptor instead.
+func (*ThreadListSyncEvent) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ThreadListSyncEvent) GetChannelIds() []string {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+func (x *ThreadListSyncEvent) GetThreads() []*Channel {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+func (x *ThreadListSyncEvent) GetMembers() []*ThreadMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ThreadMembersUpdateEvent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId         string                 `protobuf:"bytes,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	MemberCount      int32                  `protobuf:"varint,2,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	AddedMembers     []*AddedThreadMember   `protobuf:"bytes,3,rep,name=added_members,json=addedMembers,proto3" json:"added_members,omitempty"`
+	RemovedMemberIds []string               `protobuf:"bytes,4,rep,name=removed_member_ids,json=removedMemberIds,proto3" json:"removed_member_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ThreadMembersUpdateEvent) Reset() {
+	*x = ThreadMembersUpdateEvent{}
+	mi := &file_discord_v1_hook_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThreadMembersUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadMembersUpdateEvent) ProtoMessage() {}
+
+func (x *ThreadMembersUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadMembersUpdateEvent.ProtoReflect.Descriptor instead.
+func (*ThreadMembersUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ThreadMembersUpdateEvent) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *ThreadMembersUpdateEvent) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *ThreadMembersUpdateEvent) GetAddedMembers() []*AddedThreadMember {
+	if x != nil {
+		return x.AddedMembers
+	}
+	return nil
+}
+
+func (x *ThreadMembersUpdateEvent) GetRemovedMemberIds() []string {
+	if x != nil {
+		return x.RemovedMemberIds
+	}
+	return nil
+}
+
+type GuildEmojisUpdateEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emojis        []*Emoji               `protobuf:"bytes,1,rep,name=emojis,proto3" json:"emojis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildEmojisUpdateEvent) Reset() {
+	*x = GuildEmojisUpdateEvent{}
+	mi := &file_discord_v1_hook_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildEmojisUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildEmojisUpdateEvent) ProtoMessage() {}
+
+func (x *GuildEmojisUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildEmojisUpdateEvent.ProtoReflect.Descriptor instead.
+func (*GuildEmojisUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GuildEmojisUpdateEvent) GetEmojis() []*Emoji {
+	if x != nil {
+		return x.Emojis
+	}
+	return nil
+}
+
+type TypingStartEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // Unix time in seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypingStartEvent) Reset() {
+	*x = TypingStartEvent{}
+	mi := &file_discord_v1_hook_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypingStartEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypingStartEvent) ProtoMessage() {}
+
+func (x *TypingStartEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypingStartEvent.ProtoReflect.Descriptor instead.
+func (*TypingStartEvent) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TypingStartEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TypingStartEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type InviteCreateEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteCreateEvent) Reset() {
+	*x = InviteCreateEvent{}
+	mi := &file_discord_v1_hook_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteCreateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteCreateEvent) ProtoMessage() {}
+
+func (x *InviteCreateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteCreateEvent.ProtoReflect.Descriptor instead.
+func (*InviteCreateEvent) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InviteCreateEvent) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type InviteDeleteEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteDeleteEvent) Reset() {
+	*x = InviteDeleteEvent{}
+	mi := &file_discord_v1_hook_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteDeleteEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteDeleteEvent) ProtoMessage() {}
+
+func (x *InviteDeleteEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteDeleteEvent.ProtoReflect.Descriptor instead.
+func (*InviteDeleteEvent) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InviteDeleteEvent) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type GuildScheduledEventUserEvent struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	GuildScheduledEventId string                 `protobuf:"bytes,1,opt,name=guild_scheduled_event_id,json=guildScheduledEventId,proto3" json:"guild_scheduled_event_id,omitempty"`
+	UserId                string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GuildScheduledEventUserEvent) Reset() {
+	*x = GuildScheduledEventUserEvent{}
+	mi := &file_discord_v1_hook_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildScheduledEventUserEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildScheduledEventUserEvent) ProtoMessage() {}
+
+func (x *GuildScheduledEventUserEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildScheduledEventUserEvent.ProtoReflect.Descriptor instead.
+func (*GuildScheduledEventUserEvent) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GuildScheduledEventUserEvent) GetGuildScheduledEventId() string {
+	if x != nil {
+		return x.GuildScheduledEventId
+	}
+	return ""
+}
+
+func (x *GuildScheduledEventUserEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AutoModerationActionExecutionEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Action               *AutoModerationAction  `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	RuleId               string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleTriggerType      int32                  `protobuf:"varint,3,opt,name=rule_trigger_type,json=ruleTriggerType,proto3" json:"rule_trigger_type,omitempty"`
+	UserId               string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MessageId            string                 `protobuf:"bytes,5,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	AlertSystemMessageId string                 `protobuf:"bytes,6,opt,name=alert_system_message_id,json=alertSystemMessageId,proto3" json:"alert_system_message_id,omitempty"`
+	Content              string                 `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
+	MatchedKeyword       string                 `protobuf:"bytes,8,opt,name=matched_keyword,json=matchedKeyword,proto3" json:"matched_keyword,omitempty"`
+	MatchedContent       string                 `protobuf:"bytes,9,opt,name=matched_content,json=matchedContent,proto3" json:"matched_content,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AutoModerationActionExecutionEvent) Reset() {
+	*x = AutoModerationActionExecutionEvent{}
+	mi := &file_discord_v1_hook_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutoModerationActionExecutionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoModerationActionExecutionEvent) ProtoMessage() {}
+
+func (x *AutoModerationActionExecutionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoModerationActionExecutionEvent.ProtoReflect.Descriptor instead.
+func (*AutoModerationActionExecutionEvent) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AutoModerationActionExecutionEvent) GetAction() *AutoModerationAction {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *AutoModerationActionExecutionEvent) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *AutoModerationActionExecutionEvent) GetRuleTriggerType() int32 {
+	if x != nil {
+		return x.RuleTriggerType
+	}
+	return 0
+}
+
+func (x *AutoModerationActionExecutionEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AutoModerationActionExecutionEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *AutoModerationActionExecutionEvent) GetAlertSystemMessageId() string {
+	if x != nil {
+		return x.AlertSystemMessageId
+	}
+	return ""
+}
+
+func (x *AutoModerationActionExecutionEvent) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *AutoModerationActionExecutionEvent) GetMatchedKeyword() string {
+	if x != nil {
+		return x.MatchedKeyword
+	}
+	return ""
+}
+
+func (x *AutoModerationActionExecutionEvent) GetMatchedContent() string {
+	if x != nil {
+		return x.MatchedContent
+	}
+	return ""
+}
+
+// The previous version of the message is only set when the runtime had it cached.
+type OnUpdateMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Before        *Message               `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnUpdateMessageRequest) Reset() {
+	*x = OnUpdateMessageRequest{}
+	mi := &file_discord_v1_hook_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnUpdateMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnUpdateMessageRequest) ProtoMessage() {}
+
+func (x *OnUpdateMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnUpdateMessageRequest.ProtoReflect.Descriptor instead.
+func (*OnUpdateMessageRequest) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OnUpdateMessageRequest) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *OnUpdateMessageRequest) GetBefore() *Message {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+type OnDeleteMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Before        *Message               `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnDeleteMessageRequest) Reset() {
+	*x = OnDeleteMessageRequest{}
+	mi := &file_discord_v1_hook_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnDeleteMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnDeleteMessageRequest) ProtoMessage() {}
+
+func (x *OnDeleteMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnDeleteMessageRequest.ProtoReflect.Descriptor instead.
+func (*OnDeleteMessageRequest) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *OnDeleteMessageRequest) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *OnDeleteMessageRequest) GetBefore() *Message {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+type OnBulkDeleteMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageIds    []string               `protobuf:"bytes,1,rep,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	GuildId       string                 `protobuf:"bytes,3,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Before        []*Message             `protobuf:"bytes,4,rep,name=before,proto3" json:"before,omitempty"` // The cached ones only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnBulkDeleteMessagesRequest) Reset() {
+	*x = OnBulkDeleteMessagesRequest{}
+	mi := &file_discord_v1_hook_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnBulkDeleteMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnBulkDeleteMessagesRequest) ProtoMessage() {}
+
+func (x *OnBulkDeleteMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnBulkDeleteMessagesRequest.ProtoReflect.Descriptor instead.
+func (*OnBulkDeleteMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *OnBulkDeleteMessagesRequest) GetMessageIds() []string {
+	if x != nil {
+		return x.MessageIds
+	}
+	return nil
+}
+
+func (x *OnBulkDeleteMessagesRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *OnBulkDeleteMessagesRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *OnBulkDeleteMessagesRequest) GetBefore() []*Message {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+// Discord only sends the member of the reacting user with added reactions (in guilds).
+type OnAddReactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         string                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Reaction      *MessageReaction       `protobuf:"bytes,1,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	Member        *Member                `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OnEventRequest) Reset() {
-	*x = OnEventRequest{}
-	mi := &file_discord_v1_hook_proto_msgTypes[0]
+func (x *OnAddReactionRequest) Reset() {
+	*x = OnAddReactionRequest{}
+	mi := &file_discord_v1_hook_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OnEventRequest) String() string {
+func (x *OnAddReactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OnEventRequest) ProtoMessage() {}
+func (*OnAddReactionRequest) ProtoMessage() {}
 
-func (x *OnEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_hook_proto_msgTypes[0]
+func (x *OnAddReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -54,28 +1471,358 @@ func (x *OnEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OnEventRequest.ProtoReflect.Descriptor instead.
-func (*OnEventRequest) Descriptor() ([]byte, []int) {
-	return file_discord_v1_hook_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use OnAddReactionRequest.ProtoReflect.Descriptor instead.
+func (*OnAddReactionRequest) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *OnAddReactionRequest) GetReaction() *MessageReaction {
+	if x != nil {
+		return x.Reaction
+	}
+	return nil
+}
+
+func (x *OnAddReactionRequest) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+// The previous version of the member is only set when the runtime had it cached.
+type OnUpdateGuildMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Before        *Member                `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnUpdateGuildMemberRequest) Reset() {
+	*x = OnUpdateGuildMemberRequest{}
+	mi := &file_discord_v1_hook_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnUpdateGuildMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnUpdateGuildMemberRequest) ProtoMessage() {}
+
+func (x *OnUpdateGuildMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnUpdateGuildMemberRequest.ProtoReflect.Descriptor instead.
+func (*OnUpdateGuildMemberRequest) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OnUpdateGuildMemberRequest) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *OnUpdateGuildMemberRequest) GetBefore() *Member {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+type GuildBanEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildBanEvent) Reset() {
+	*x = GuildBanEvent{}
+	mi := &file_discord_v1_hook_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildBanEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildBanEvent) ProtoMessage() {}
+
+func (x *GuildBanEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildBanEvent.ProtoReflect.Descriptor instead.
+func (*GuildBanEvent) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GuildBanEvent) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *GuildBanEvent) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// The previous voice state is only set when the runtime had it cached.
+// Its channel_id is the channel the user was in (empty if the user just joined).
+type OnVoiceStateUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VoiceState    *VoiceState            `protobuf:"bytes,1,opt,name=voice_state,json=voiceState,proto3" json:"voice_state,omitempty"`
+	Before        *VoiceState            `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnVoiceStateUpdateRequest) Reset() {
+	*x = OnVoiceStateUpdateRequest{}
+	mi := &file_discord_v1_hook_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnVoiceStateUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnVoiceStateUpdateRequest) ProtoMessage() {}
+
+func (x *OnVoiceStateUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnVoiceStateUpdateRequest.ProtoReflect.Descriptor instead.
+func (*OnVoiceStateUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OnVoiceStateUpdateRequest) GetVoiceState() *VoiceState {
+	if x != nil {
+		return x.VoiceState
+	}
+	return nil
+}
+
+func (x *OnVoiceStateUpdateRequest) GetBefore() *VoiceState {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+// Only the guilds where the module is enabled are sent (as unavailable guilds with their ID only).
+type OnReadyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Guilds        []*Guild               `protobuf:"bytes,3,rep,name=guilds,proto3" json:"guilds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnReadyRequest) Reset() {
+	*x = OnReadyRequest{}
+	mi := &file_discord_v1_hook_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnReadyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnReadyRequest) ProtoMessage() {}
+
+func (x *OnReadyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnReadyRequest.ProtoReflect.Descriptor instead.
+func (*OnReadyRequest) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OnReadyRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
 }
 
-func (x *OnEventRequest) GetEvent() string {
+func (x *OnReadyRequest) GetSessionId() string {
 	if x != nil {
-		return x.Event
+		return x.SessionId
 	}
 	return ""
 }
 
+func (x *OnReadyRequest) GetGuilds() []*Guild {
+	if x != nil {
+		return x.Guilds
+	}
+	return nil
+}
+
+// The previous version of the guild is only set when the runtime had it cached.
+type OnDeleteGuildRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guild         *Guild                 `protobuf:"bytes,1,opt,name=guild,proto3" json:"guild,omitempty"`
+	Before        *Guild                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnDeleteGuildRequest) Reset() {
+	*x = OnDeleteGuildRequest{}
+	mi := &file_discord_v1_hook_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnDeleteGuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnDeleteGuildRequest) ProtoMessage() {}
+
+func (x *OnDeleteGuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnDeleteGuildRequest.ProtoReflect.Descriptor instead.
+func (*OnDeleteGuildRequest) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *OnDeleteGuildRequest) GetGuild() *Guild {
+	if x != nil {
+		return x.Guild
+	}
+	return nil
+}
+
+func (x *OnDeleteGuildRequest) GetBefore() *Guild {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+// The response is sent by the runtime. It is unset when the module responds to the
+// interaction itself (with InteractionRespond), or not at all.
+type OnCreateInteractionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *InteractionResponse   `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnCreateInteractionResponse) Reset() {
+	*x = OnCreateInteractionResponse{}
+	mi := &file_discord_v1_hook_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnCreateInteractionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnCreateInteractionResponse) ProtoMessage() {}
+
+func (x *OnCreateInteractionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discord_v1_hook_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnCreateInteractionResponse.ProtoReflect.Descriptor instead.
+func (*OnCreateInteractionResponse) Descriptor() ([]byte, []int) {
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OnCreateInteractionResponse) GetResponse() *InteractionResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
 type InitRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	HelperServerId uint32                 `protobuf:"varint,1,opt,name=helper_server_id,json=helperServerId,proto3" json:"helper_server_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Set when the runtime reattached to an already running module. The module's
+	// broker can't be reused in that case, so the helper server is dialed directly.
+	HelperServerNetwork string `protobuf:"bytes,2,opt,name=helper_server_network,json=helperServerNetwork,proto3" json:"helper_server_network,omitempty"`
+	HelperServerAddress string `protobuf:"bytes,3,opt,name=helper_server_address,json=helperServerAddress,proto3" json:"helper_server_address,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *InitRequest) Reset() {
 	*x = InitRequest{}
-	mi := &file_discord_v1_hook_proto_msgTypes[1]
+	mi := &file_discord_v1_hook_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -87,7 +1834,7 @@ func (x *InitRequest) String() string {
 func (*InitRequest) ProtoMessage() {}
 
 func (x *InitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_hook_proto_msgTypes[1]
+	mi := &file_discord_v1_hook_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -100,7 +1847,7 @@ func (x *InitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitRequest.ProtoReflect.Descriptor instead.
 func (*InitRequest) Descriptor() ([]byte, []int) {
-	return file_discord_v1_hook_proto_rawDescGZIP(), []int{1}
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *InitRequest) GetHelperServerId() uint32 {
@@ -110,16 +1857,31 @@ func (x *InitRequest) GetHelperServerId() uint32 {
 	return 0
 }
 
+func (x *InitRequest) GetHelperServerNetwork() string {
+	if x != nil {
+		return x.HelperServerNetwork
+	}
+	return ""
+}
+
+func (x *InitRequest) GetHelperServerAddress() string {
+	if x != nil {
+		return x.HelperServerAddress
+	}
+	return ""
+}
+
 type InitResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Interactions  []*ApplicationCommand  `protobuf:"bytes,1,rep,name=interactions,proto3" json:"interactions,omitempty"`
+	Hooks         []string               `protobuf:"bytes,2,rep,name=hooks,proto3" json:"hooks,omitempty"` // Hooks of privileged events implemented by the module
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InitResponse) Reset() {
 	*x = InitResponse{}
-	mi := &file_discord_v1_hook_proto_msgTypes[2]
+	mi := &file_discord_v1_hook_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -131,7 +1893,7 @@ func (x *InitResponse) String() string {
 func (*InitResponse) ProtoMessage() {}
 
 func (x *InitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_discord_v1_hook_proto_msgTypes[2]
+	mi := &file_discord_v1_hook_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -144,7 +1906,7 @@ func (x *InitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitResponse.ProtoReflect.Descriptor instead.
 func (*InitResponse) Descriptor() ([]byte, []int) {
-	return file_discord_v1_hook_proto_rawDescGZIP(), []int{2}
+	return file_discord_v1_hook_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *InitResponse) GetInteractions() []*ApplicationCommand {
@@ -154,23 +1916,169 @@ func (x *InitResponse) GetInteractions() []*ApplicationCommand {
 	return nil
 }
 
+func (x *InitResponse) GetHooks() []string {
+	if x != nil {
+		return x.Hooks
+	}
+	return nil
+}
+
 var File_discord_v1_hook_proto protoreflect.FileDescriptor
 
 const file_discord_v1_hook_proto_rawDesc = "" +
 	"\n" +
 	"\x15discord-v1/hook.proto\x12\n" +
-	"discord_v1\x1a\fcommon.proto\x1a\x1adiscord-v1/discordgo.proto\"&\n" +
-	"\x0eOnEventRequest\x12\x14\n" +
-	"\x05event\x18\x01 \x01(\tR\x05event\"7\n" +
+	"discord_v1\x1a\fcommon.proto\x1a\x1adiscord-v1/discordgo.proto\"\xbd\x15\n" +
+	"\fGatewayEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x19\n" +
+	"\bguild_id\x18\x02 \x01(\tR\aguildId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12<\n" +
+	"\x0echannel_create\x18\n" +
+	" \x01(\v2\x13.discord_v1.ChannelH\x00R\rchannelCreate\x12<\n" +
+	"\x0echannel_update\x18\v \x01(\v2\x13.discord_v1.ChannelH\x00R\rchannelUpdate\x12<\n" +
+	"\x0echannel_delete\x18\f \x01(\v2\x13.discord_v1.ChannelH\x00R\rchannelDelete\x12T\n" +
+	"\x13channel_pins_update\x18\r \x01(\v2\".discord_v1.ChannelPinsUpdateEventH\x00R\x11channelPinsUpdate\x12D\n" +
+	"\rthread_create\x18\x14 \x01(\v2\x1d.discord_v1.ThreadCreateEventH\x00R\fthreadCreate\x12D\n" +
+	"\rthread_update\x18\x15 \x01(\v2\x1d.discord_v1.ThreadUpdateEventH\x00R\fthreadUpdate\x12:\n" +
+	"\rthread_delete\x18\x16 \x01(\v2\x13.discord_v1.ChannelH\x00R\fthreadDelete\x12K\n" +
+	"\x10thread_list_sync\x18\x17 \x01(\v2\x1f.discord_v1.ThreadListSyncEventH\x00R\x0ethreadListSync\x12L\n" +
+	"\x14thread_member_update\x18\x18 \x01(\v2\x18.discord_v1.ThreadMemberH\x00R\x12threadMemberUpdate\x12Z\n" +
+	"\x15thread_members_update\x18\x19 \x01(\v2$.discord_v1.ThreadMembersUpdateEventH\x00R\x13threadMembersUpdate\x12>\n" +
+	"\x11guild_role_create\x18\x1e \x01(\v2\x10.discord_v1.RoleH\x00R\x0fguildRoleCreate\x12>\n" +
+	"\x11guild_role_update\x18\x1f \x01(\v2\x10.discord_v1.RoleH\x00R\x0fguildRoleUpdate\x12,\n" +
+	"\x11guild_role_delete\x18  \x01(\tH\x00R\x0fguildRoleDelete\x12T\n" +
+	"\x13guild_emojis_update\x18! \x01(\v2\".discord_v1.GuildEmojisUpdateEventH\x00R\x11guildEmojisUpdate\x12K\n" +
+	"\x19guild_integrations_update\x18\" \x01(\v2\r.common.EmptyH\x00R\x17guildIntegrationsUpdate\x128\n" +
+	"\x0fwebhooks_update\x18# \x01(\v2\r.common.EmptyH\x00R\x0ewebhooksUpdate\x123\n" +
+	"\vuser_update\x18$ \x01(\v2\x10.discord_v1.UserH\x00R\n" +
+	"userUpdate\x12?\n" +
+	"\x0fpresence_update\x18( \x01(\v2\x14.discord_v1.PresenceH\x00R\x0epresenceUpdate\x12A\n" +
+	"\ftyping_start\x18) \x01(\v2\x1c.discord_v1.TypingStartEventH\x00R\vtypingStart\x12D\n" +
+	"\rinvite_create\x182 \x01(\v2\x1d.discord_v1.InviteCreateEventH\x00R\finviteCreate\x12D\n" +
+	"\rinvite_delete\x183 \x01(\v2\x1d.discord_v1.InviteDeleteEventH\x00R\finviteDelete\x12b\n" +
+	"\x1cguild_scheduled_event_create\x18< \x01(\v2\x1f.discord_v1.GuildScheduledEventH\x00R\x19guildScheduledEventCreate\x12b\n" +
+	"\x1cguild_scheduled_event_update\x18= \x01(\v2\x1f.discord_v1.GuildScheduledEventH\x00R\x19guildScheduledEventUpdate\x12b\n" +
+	"\x1cguild_scheduled_event_delete\x18> \x01(\v2\x1f.discord_v1.GuildScheduledEventH\x00R\x19guildScheduledEventDelete\x12n\n" +
+	"\x1eguild_scheduled_event_user_add\x18? \x01(\v2(.discord_v1.GuildScheduledEventUserEventH\x00R\x1aguildScheduledEventUserAdd\x12t\n" +
+	"!guild_scheduled_event_user_remove\x18@ \x01(\v2(.discord_v1.GuildScheduledEventUserEventH\x00R\x1dguildScheduledEventUserRemove\x12O\n" +
+	"\x15stage_instance_create\x18F \x01(\v2\x19.discord_v1.StageInstanceH\x00R\x13stageInstanceCreate\x12O\n" +
+	"\x15stage_instance_update\x18G \x01(\v2\x19.discord_v1.StageInstanceH\x00R\x13stageInstanceUpdate\x12O\n" +
+	"\x15stage_instance_delete\x18H \x01(\v2\x19.discord_v1.StageInstanceH\x00R\x13stageInstanceDelete\x12_\n" +
+	"\x1bauto_moderation_rule_create\x18P \x01(\v2\x1e.discord_v1.AutoModerationRuleH\x00R\x18autoModerationRuleCreate\x12_\n" +
+	"\x1bauto_moderation_rule_update\x18Q \x01(\v2\x1e.discord_v1.AutoModerationRuleH\x00R\x18autoModerationRuleUpdate\x12_\n" +
+	"\x1bauto_moderation_rule_delete\x18R \x01(\v2\x1e.discord_v1.AutoModerationRuleH\x00R\x18autoModerationRuleDelete\x12y\n" +
+	" auto_moderation_action_execution\x18S \x01(\v2..discord_v1.AutoModerationActionExecutionEventH\x00R\x1dautoModerationActionExecution\x12\x12\n" +
+	"\x03raw\x18d \x01(\fH\x00R\x03rawB\t\n" +
+	"\apayload\"F\n" +
+	"\x16ChannelPinsUpdateEvent\x12,\n" +
+	"\x12last_pin_timestamp\x18\x01 \x01(\tR\x10lastPinTimestamp\"e\n" +
+	"\x11ThreadCreateEvent\x12+\n" +
+	"\x06thread\x18\x01 \x01(\v2\x13.discord_v1.ChannelR\x06thread\x12#\n" +
+	"\rnewly_created\x18\x02 \x01(\bR\fnewlyCreated\"m\n" +
+	"\x11ThreadUpdateEvent\x12+\n" +
+	"\x06thread\x18\x01 \x01(\v2\x13.discord_v1.ChannelR\x06thread\x12+\n" +
+	"\x06before\x18\x02 \x01(\v2\x13.discord_v1.ChannelR\x06before\"\x99\x01\n" +
+	"\x13ThreadListSyncEvent\x12\x1f\n" +
+	"\vchannel_ids\x18\x01 \x03(\tR\n" +
+	"channelIds\x12-\n" +
+	"\athreads\x18\x02 \x03(\v2\x13.discord_v1.ChannelR\athreads\x122\n" +
+	"\amembers\x18\x03 \x03(\v2\x18.discord_v1.ThreadMemberR\amembers\"\xcc\x01\n" +
+	"\x18ThreadMembersUpdateEvent\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\tR\bthreadId\x12!\n" +
+	"\fmember_count\x18\x02 \x01(\x05R\vmemberCount\x12B\n" +
+	"\radded_members\x18\x03 \x03(\v2\x1d.discord_v1.AddedThreadMemberR\faddedMembers\x12,\n" +
+	"\x12removed_member_ids\x18\x04 \x03(\tR\x10removedMemberIds\"C\n" +
+	"\x16GuildEmojisUpdateEvent\x12)\n" +
+	"\x06emojis\x18\x01 \x03(\v2\x11.discord_v1.EmojiR\x06emojis\"I\n" +
+	"\x10TypingStartEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\"?\n" +
+	"\x11InviteCreateEvent\x12*\n" +
+	"\x06invite\x18\x01 \x01(\v2\x12.discord_v1.InviteR\x06invite\"'\n" +
+	"\x11InviteDeleteEvent\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"p\n" +
+	"\x1cGuildScheduledEventUserEvent\x127\n" +
+	"\x18guild_scheduled_event_id\x18\x01 \x01(\tR\x15guildScheduledEventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xfe\x02\n" +
+	"\"AutoModerationActionExecutionEvent\x128\n" +
+	"\x06action\x18\x01 \x01(\v2 .discord_v1.AutoModerationActionR\x06action\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\x12*\n" +
+	"\x11rule_trigger_type\x18\x03 \x01(\x05R\x0fruleTriggerType\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x05 \x01(\tR\tmessageId\x125\n" +
+	"\x17alert_system_message_id\x18\x06 \x01(\tR\x14alertSystemMessageId\x12\x18\n" +
+	"\acontent\x18\a \x01(\tR\acontent\x12'\n" +
+	"\x0fmatched_keyword\x18\b \x01(\tR\x0ematchedKeyword\x12'\n" +
+	"\x0fmatched_content\x18\t \x01(\tR\x0ematchedContent\"t\n" +
+	"\x16OnUpdateMessageRequest\x12-\n" +
+	"\amessage\x18\x01 \x01(\v2\x13.discord_v1.MessageR\amessage\x12+\n" +
+	"\x06before\x18\x02 \x01(\v2\x13.discord_v1.MessageR\x06before\"t\n" +
+	"\x16OnDeleteMessageRequest\x12-\n" +
+	"\amessage\x18\x01 \x01(\v2\x13.discord_v1.MessageR\amessage\x12+\n" +
+	"\x06before\x18\x02 \x01(\v2\x13.discord_v1.MessageR\x06before\"\xa5\x01\n" +
+	"\x1bOnBulkDeleteMessagesRequest\x12\x1f\n" +
+	"\vmessage_ids\x18\x01 \x03(\tR\n" +
+	"messageIds\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x19\n" +
+	"\bguild_id\x18\x03 \x01(\tR\aguildId\x12+\n" +
+	"\x06before\x18\x04 \x03(\v2\x13.discord_v1.MessageR\x06before\"{\n" +
+	"\x14OnAddReactionRequest\x127\n" +
+	"\breaction\x18\x01 \x01(\v2\x1b.discord_v1.MessageReactionR\breaction\x12*\n" +
+	"\x06member\x18\x02 \x01(\v2\x12.discord_v1.MemberR\x06member\"t\n" +
+	"\x1aOnUpdateGuildMemberRequest\x12*\n" +
+	"\x06member\x18\x01 \x01(\v2\x12.discord_v1.MemberR\x06member\x12*\n" +
+	"\x06before\x18\x02 \x01(\v2\x12.discord_v1.MemberR\x06before\"P\n" +
+	"\rGuildBanEvent\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12$\n" +
+	"\x04user\x18\x02 \x01(\v2\x10.discord_v1.UserR\x04user\"\x84\x01\n" +
+	"\x19OnVoiceStateUpdateRequest\x127\n" +
+	"\vvoice_state\x18\x01 \x01(\v2\x16.discord_v1.VoiceStateR\n" +
+	"voiceState\x12.\n" +
+	"\x06before\x18\x02 \x01(\v2\x16.discord_v1.VoiceStateR\x06before\"\x80\x01\n" +
+	"\x0eOnReadyRequest\x12$\n" +
+	"\x04user\x18\x01 \x01(\v2\x10.discord_v1.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12)\n" +
+	"\x06guilds\x18\x03 \x03(\v2\x11.discord_v1.GuildR\x06guilds\"j\n" +
+	"\x14OnDeleteGuildRequest\x12'\n" +
+	"\x05guild\x18\x01 \x01(\v2\x11.discord_v1.GuildR\x05guild\x12)\n" +
+	"\x06before\x18\x02 \x01(\v2\x11.discord_v1.GuildR\x06before\"Z\n" +
+	"\x1bOnCreateInteractionResponse\x12;\n" +
+	"\bresponse\x18\x01 \x01(\v2\x1f.discord_v1.InteractionResponseR\bresponse\"\x9f\x01\n" +
 	"\vInitRequest\x12(\n" +
-	"\x10helper_server_id\x18\x01 \x01(\rR\x0ehelperServerId\"R\n" +
+	"\x10helper_server_id\x18\x01 \x01(\rR\x0ehelperServerId\x122\n" +
+	"\x15helper_server_network\x18\x02 \x01(\tR\x13helperServerNetwork\x122\n" +
+	"\x15helper_server_address\x18\x03 \x01(\tR\x13helperServerAddress\"h\n" +
 	"\fInitResponse\x12B\n" +
-	"\finteractions\x18\x01 \x03(\v2\x1e.discord_v1.ApplicationCommandR\finteractions2\xef\x01\n" +
+	"\finteractions\x18\x01 \x03(\v2\x1e.discord_v1.ApplicationCommandR\finteractions\x12\x14\n" +
+	"\x05hooks\x18\x02 \x03(\tR\x05hooks2\xaa\v\n" +
 	"\x04Hook\x12;\n" +
 	"\x06OnInit\x12\x17.discord_v1.InitRequest\x1a\x18.discord_v1.InitResponse\x125\n" +
-	"\x0fOnCreateMessage\x12\x13.discord_v1.Message\x1a\r.common.Empty\x12=\n" +
-	"\x13OnCreateInteraction\x12\x17.discord_v1.Interaction\x1a\r.common.Empty\x124\n" +
-	"\aOnEvent\x12\x1a.discord_v1.OnEventRequest\x1a\r.common.EmptyB<Z:github.com/thirdscam/chatanium-flexmodule/proto/discord-v1b\x06proto3"
+	"\x0fOnCreateMessage\x12\x13.discord_v1.Message\x1a\r.common.Empty\x12D\n" +
+	"\x0fOnUpdateMessage\x12\".discord_v1.OnUpdateMessageRequest\x1a\r.common.Empty\x12D\n" +
+	"\x0fOnDeleteMessage\x12\".discord_v1.OnDeleteMessageRequest\x1a\r.common.Empty\x12N\n" +
+	"\x14OnBulkDeleteMessages\x12'.discord_v1.OnBulkDeleteMessagesRequest\x1a\r.common.Empty\x12@\n" +
+	"\rOnAddReaction\x12 .discord_v1.OnAddReactionRequest\x1a\r.common.Empty\x12>\n" +
+	"\x10OnRemoveReaction\x12\x1b.discord_v1.MessageReaction\x1a\r.common.Empty\x12B\n" +
+	"\x14OnRemoveAllReactions\x12\x1b.discord_v1.MessageReaction\x1a\r.common.Empty\x12D\n" +
+	"\x16OnRemoveEmojiReactions\x12\x1b.discord_v1.MessageReaction\x1a\r.common.Empty\x125\n" +
+	"\x10OnAddGuildMember\x12\x12.discord_v1.Member\x1a\r.common.Empty\x128\n" +
+	"\x13OnRemoveGuildMember\x12\x12.discord_v1.Member\x1a\r.common.Empty\x12L\n" +
+	"\x13OnUpdateGuildMember\x12&.discord_v1.OnUpdateGuildMemberRequest\x1a\r.common.Empty\x129\n" +
+	"\rOnAddGuildBan\x12\x19.discord_v1.GuildBanEvent\x1a\r.common.Empty\x12<\n" +
+	"\x10OnRemoveGuildBan\x12\x19.discord_v1.GuildBanEvent\x1a\r.common.Empty\x12J\n" +
+	"\x12OnVoiceStateUpdate\x12%.discord_v1.OnVoiceStateUpdateRequest\x1a\r.common.Empty\x124\n" +
+	"\aOnReady\x12\x1a.discord_v1.OnReadyRequest\x1a\r.common.Empty\x12)\n" +
+	"\tOnResumed\x12\r.common.Empty\x1a\r.common.Empty\x12,\n" +
+	"\fOnDisconnect\x12\r.common.Empty\x1a\r.common.Empty\x121\n" +
+	"\rOnCreateGuild\x12\x11.discord_v1.Guild\x1a\r.common.Empty\x121\n" +
+	"\rOnUpdateGuild\x12\x11.discord_v1.Guild\x1a\r.common.Empty\x12@\n" +
+	"\rOnDeleteGuild\x12 .discord_v1.OnDeleteGuildRequest\x1a\r.common.Empty\x12W\n" +
+	"\x13OnCreateInteraction\x12\x17.discord_v1.Interaction\x1a'.discord_v1.OnCreateInteractionResponse\x122\n" +
+	"\aOnEvent\x12\x18.discord_v1.GatewayEvent\x1a\r.common.EmptyB<Z:github.com/thirdscam/chatanium-flexmodule/proto/discord-v1b\x06proto3"
 
 var (
 	file_discord_v1_hook_proto_rawDescOnce sync.Once
@@ -184,31 +2092,165 @@ func file_discord_v1_hook_proto_rawDescGZIP() []byte {
 	return file_discord_v1_hook_proto_rawDescData
 }
 
-var file_discord_v1_hook_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_discord_v1_hook_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_discord_v1_hook_proto_goTypes = []any{
-	(*OnEventRequest)(nil),     // 0: discord_v1.OnEventRequest
-	(*InitRequest)(nil),        // 1: discord_v1.InitRequest
-	(*InitResponse)(nil),       // 2: discord_v1.InitResponse
-	(*ApplicationCommand)(nil), // 3: discord_v1.ApplicationCommand
-	(*Message)(nil),            // 4: discord_v1.Message
-	(*Interaction)(nil),        // 5: discord_v1.Interaction
-	(*proto.Empty)(nil),        // 6: common.Empty
+	(*GatewayEvent)(nil),                       // 0: discord_v1.GatewayEvent
+	(*ChannelPinsUpdateEvent)(nil),             // 1: discord_v1.ChannelPinsUpdateEvent
+	(*ThreadCreateEvent)(nil),                  // 2: discord_v1.ThreadCreateEvent
+	(*ThreadUpdateEvent)(nil),                  // 3: discord_v1.ThreadUpdateEvent
+	(*ThreadListSyncEvent)(nil),                // 4: discord_v1.ThreadListSyncEvent
+	(*ThreadMembersUpdateEvent)(nil),           // 5: discord_v1.ThreadMembersUpdateEvent
+	(*GuildEmojisUpdateEvent)(nil),             // 6: discord_v1.GuildEmojisUpdateEvent
+	(*TypingStartEvent)(nil),                   // 7: discord_v1.TypingStartEvent
+	(*InviteCreateEvent)(nil),                  // 8: discord_v1.InviteCreateEvent
+	(*InviteDeleteEvent)(nil),                  // 9: discord_v1.InviteDeleteEvent
+	(*GuildScheduledEventUserEvent)(nil),       // 10: discord_v1.GuildScheduledEventUserEvent
+	(*AutoModerationActionExecutionEvent)(nil), // 11: discord_v1.AutoModerationActionExecutionEvent
+	(*OnUpdateMessageRequest)(nil),             // 12: discord_v1.OnUpdateMessageRequest
+	(*OnDeleteMessageRequest)(nil),             // 13: discord_v1.OnDeleteMessageRequest
+	(*OnBulkDeleteMessagesRequest)(nil),        // 14: discord_v1.OnBulkDeleteMessagesRequest
+	(*OnAddReactionRequest)(nil),               // 15: discord_v1.OnAddReactionRequest
+	(*OnUpdateGuildMemberRequest)(nil),         // 16: discord_v1.OnUpdateGuildMemberRequest
+	(*GuildBanEvent)(nil),                      // 17: discord_v1.GuildBanEvent
+	(*OnVoiceStateUpdateRequest)(nil),          // 18: discord_v1.OnVoiceStateUpdateRequest
+	(*OnReadyRequest)(nil),                     // 19: discord_v1.OnReadyRequest
+	(*OnDeleteGuildRequest)(nil),               // 20: discord_v1.OnDeleteGuildRequest
+	(*OnCreateInteractionResponse)(nil),        // 21: discord_v1.OnCreateInteractionResponse
+	(*InitRequest)(nil),                        // 22: discord_v1.InitRequest
+	(*InitResponse)(nil),                       // 23: discord_v1.InitResponse
+	(*Channel)(nil),                            // 24: discord_v1.Channel
+	(*ThreadMember)(nil),                       // 25: discord_v1.ThreadMember
+	(*Role)(nil),                               // 26: discord_v1.Role
+	(*proto.Empty)(nil),                        // 27: common.Empty
+	(*User)(nil),                               // 28: discord_v1.User
+	(*Presence)(nil),                           // 29: discord_v1.Presence
+	(*GuildScheduledEvent)(nil),                // 30: discord_v1.GuildScheduledEvent
+	(*StageInstance)(nil),                      // 31: discord_v1.StageInstance
+	(*AutoModerationRule)(nil),                 // 32: discord_v1.AutoModerationRule
+	(*AddedThreadMember)(nil),                  // 33: discord_v1.AddedThreadMember
+	(*Emoji)(nil),                              // 34: discord_v1.Emoji
+	(*Invite)(nil),                             // 35: discord_v1.Invite
+	(*AutoModerationAction)(nil),               // 36: discord_v1.AutoModerationAction
+	(*Message)(nil),                            // 37: discord_v1.Message
+	(*MessageReaction)(nil),                    // 38: discord_v1.MessageReaction
+	(*Member)(nil),                             // 39: discord_v1.Member
+	(*VoiceState)(nil),                         // 40: discord_v1.VoiceState
+	(*Guild)(nil),                              // 41: discord_v1.Guild
+	(*InteractionResponse)(nil),                // 42: discord_v1.InteractionResponse
+	(*ApplicationCommand)(nil),                 // 43: discord_v1.ApplicationCommand
+	(*Interaction)(nil),                        // 44: discord_v1.Interaction
 }
 var file_discord_v1_hook_proto_depIdxs = []int32{
-	3, // 0: discord_v1.InitResponse.interactions:type_name -> discord_v1.ApplicationCommand
-	1, // 1: discord_v1.Hook.OnInit:input_type -> discord_v1.InitRequest
-	4, // 2: discord_v1.Hook.OnCreateMessage:input_type -> discord_v1.Message
-	5, // 3: discord_v1.Hook.OnCreateInteraction:input_type -> discord_v1.Interaction
-	0, // 4: discord_v1.Hook.OnEvent:input_type -> discord_v1.OnEventRequest
-	2, // 5: discord_v1.Hook.OnInit:output_type -> discord_v1.InitResponse
-	6, // 6: discord_v1.Hook.OnCreateMessage:output_type -> common.Empty
-	6, // 7: discord_v1.Hook.OnCreateInteraction:output_type -> common.Empty
-	6, // 8: discord_v1.Hook.OnEvent:output_type -> common.Empty
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	24, // 0: discord_v1.GatewayEvent.channel_create:type_name -> discord_v1.Channel
+	24, // 1: discord_v1.GatewayEvent.channel_update:type_name -> discord_v1.Channel
+	24, // 2: discord_v1.GatewayEvent.channel_delete:type_name -> discord_v1.Channel
+	1,  // 3: discord_v1.GatewayEvent.channel_pins_update:type_name -> discord_v1.ChannelPinsUpdateEvent
+	2,  // 4: discord_v1.GatewayEvent.thread_create:type_name -> discord_v1.ThreadCreateEvent
+	3,  // 5: discord_v1.GatewayEvent.thread_update:type_name -> discord_v1.ThreadUpdateEvent
+	24, // 6: discord_v1.GatewayEvent.thread_delete:type_name -> discord_v1.Channel
+	4,  // 7: discord_v1.GatewayEvent.thread_list_sync:type_name -> discord_v1.ThreadListSyncEvent
+	25, // 8: discord_v1.GatewayEvent.thread_member_update:type_name -> discord_v1.ThreadMember
+	5,  // 9: discord_v1.GatewayEvent.thread_members_update:type_name -> discord_v1.ThreadMembersUpdateEvent
+	26, // 10: discord_v1.GatewayEvent.guild_role_create:type_name -> discord_v1.Role
+	26, // 11: discord_v1.GatewayEvent.guild_role_update:type_name -> discord_v1.Role
+	6,  // 12: discord_v1.GatewayEvent.guild_emojis_update:type_name -> discord_v1.GuildEmojisUpdateEvent
+	27, // 13: discord_v1.GatewayEvent.guild_integrations_update:type_name -> common.Empty
+	27, // 14: discord_v1.GatewayEvent.webhooks_update:type_name -> common.Empty
+	28, // 15: discord_v1.GatewayEvent.user_update:type_name -> discord_v1.User
+	29, // 16: discord_v1.GatewayEvent.presence_update:type_name -> discord_v1.Presence
+	7,  // 17: discord_v1.GatewayEvent.typing_start:type_name -> discord_v1.TypingStartEvent
+	8,  // 18: discord_v1.GatewayEvent.invite_create:type_name -> discord_v1.InviteCreateEvent
+	9,  // 19: discord_v1.GatewayEvent.invite_delete:type_name -> discord_v1.InviteDeleteEvent
+	30, // 20: discord_v1.GatewayEvent.guild_scheduled_event_create:type_name -> discord_v1.GuildScheduledEvent
+	30, // 21: discord_v1.GatewayEvent.guild_scheduled_event_update:type_name -> discord_v1.GuildScheduledEvent
+	30, // 22: discord_v1.GatewayEvent.guild_scheduled_event_delete:type_name -> discord_v1.GuildScheduledEvent
+	10, // 23: discord_v1.GatewayEvent.guild_scheduled_event_user_add:type_name -> discord_v1.GuildScheduledEventUserEvent
+	10, // 24: discord_v1.GatewayEvent.guild_scheduled_event_user_remove:type_name -> discord_v1.GuildScheduledEventUserEvent
+	31, // 25: discord_v1.GatewayEvent.stage_instance_create:type_name -> discord_v1.StageInstance
+	31, // 26: discord_v1.GatewayEvent.stage_instance_update:type_name -> discord_v1.StageInstance
+	31, // 27: discord_v1.GatewayEvent.stage_instance_delete:type_name -> discord_v1.StageInstance
+	32, // 28: discord_v1.GatewayEvent.auto_moderation_rule_create:type_name -> discord_v1.AutoModerationRule
+	32, // 29: discord_v1.GatewayEvent.auto_moderation_rule_update:type_name -> discord_v1.AutoModerationRule
+	32, // 30: discord_v1.GatewayEvent.auto_moderation_rule_delete:type_name -> discord_v1.AutoModerationRule
+	11, // 31: discord_v1.GatewayEvent.auto_moderation_action_execution:type_name -> discord_v1.AutoModerationActionExecutionEvent
+	24, // 32: discord_v1.ThreadCreateEvent.thread:type_name -> discord_v1.Channel
+	24, // 33: discord_v1.ThreadUpdateEvent.thread:type_name -> discord_v1.Channel
+	24, // 34: discord_v1.ThreadUpdateEvent.before:type_name -> discord_v1.Channel
+	24, // 35: discord_v1.ThreadListSyncEvent.threads:type_name -> discord_v1.Channel
+	25, // 36: discord_v1.ThreadListSyncEvent.members:type_name -> discord_v1.ThreadMember
+	33, // 37: discord_v1.ThreadMembersUpdateEvent.added_members:type_name -> discord_v1.AddedThreadMember
+	34, // 38: discord_v1.GuildEmojisUpdateEvent.emojis:type_name -> discord_v1.Emoji
+	35, // 39: discord_v1.InviteCreateEvent.invite:type_name -> discord_v1.Invite
+	36, // 40: discord_v1.AutoModerationActionExecutionEvent.action:type_name -> discord_v1.AutoModerationAction
+	37, // 41: discord_v1.OnUpdateMessageRequest.message:type_name -> discord_v1.Message
+	37, // 42: discord_v1.OnUpdateMessageRequest.before:type_name -> discord_v1.Message
+	37, // 43: discord_v1.OnDeleteMessageRequest.message:type_name -> discord_v1.Message
+	37, // 44: discord_v1.OnDeleteMessageRequest.before:type_name -> discord_v1.Message
+	37, // 45: discord_v1.OnBulkDeleteMessagesRequest.before:type_name -> discord_v1.Message
+	38, // 46: discord_v1.OnAddReactionRequest.reaction:type_name -> discord_v1.MessageReaction
+	39, // 47: discord_v1.OnAddReactionRequest.member:type_name -> discord_v1.Member
+	39, // 48: discord_v1.OnUpdateGuildMemberRequest.member:type_name -> discord_v1.Member
+	39, // 49: discord_v1.OnUpdateGuildMemberRequest.before:type_name -> discord_v1.Member
+	28, // 50: discord_v1.GuildBanEvent.user:type_name -> discord_v1.User
+	40, // 51: discord_v1.OnVoiceStateUpdateRequest.voice_state:type_name -> discord_v1.VoiceState
+	40, // 52: discord_v1.OnVoiceStateUpdateRequest.before:type_name -> discord_v1.VoiceState
+	28, // 53: discord_v1.OnReadyRequest.user:type_name -> discord_v1.User
+	41, // 54: discord_v1.OnReadyRequest.guilds:type_name -> discord_v1.Guild
+	41, // 55: discord_v1.OnDeleteGuildRequest.guild:type_name -> discord_v1.Guild
+	41, // 56: discord_v1.OnDeleteGuildRequest.before:type_name -> discord_v1.Guild
+	42, // 57: discord_v1.OnCreateInteractionResponse.response:type_name -> discord_v1.InteractionResponse
+	43, // 58: discord_v1.InitResponse.interactions:type_name -> discord_v1.ApplicationCommand
+	22, // 59: discord_v1.Hook.OnInit:input_type -> discord_v1.InitRequest
+	37, // 60: discord_v1.Hook.OnCreateMessage:input_type -> discord_v1.Message
+	12, // 61: discord_v1.Hook.OnUpdateMessage:input_type -> discord_v1.OnUpdateMessageRequest
+	13, // 62: discord_v1.Hook.OnDeleteMessage:input_type -> discord_v1.OnDeleteMessageRequest
+	14, // 63: discord_v1.Hook.OnBulkDeleteMessages:input_type -> discord_v1.OnBulkDeleteMessagesRequest
+	15, // 64: discord_v1.Hook.OnAddReaction:input_type -> discord_v1.OnAddReactionRequest
+	38, // 65: discord_v1.Hook.OnRemoveReaction:input_type -> discord_v1.MessageReaction
+	38, // 66: discord_v1.Hook.OnRemoveAllReactions:input_type -> discord_v1.MessageReaction
+	38, // 67: discord_v1.Hook.OnRemoveEmojiReactions:input_type -> discord_v1.MessageReaction
+	39, // 68: discord_v1.Hook.OnAddGuildMember:input_type -> discord_v1.Member
+	39, // 69: discord_v1.Hook.OnRemoveGuildMember:input_type -> discord_v1.Member
+	16, // 70: discord_v1.Hook.OnUpdateGuildMember:input_type -> discord_v1.OnUpdateGuildMemberRequest
+	17, // 71: discord_v1.Hook.OnAddGuildBan:input_type -> discord_v1.GuildBanEvent
+	17, // 72: discord_v1.Hook.OnRemoveGuildBan:input_type -> discord_v1.GuildBanEvent
+	18, // 73: discord_v1.Hook.OnVoiceStateUpdate:input_type -> discord_v1.OnVoiceStateUpdateRequest
+	19, // 74: discord_v1.Hook.OnReady:input_type -> discord_v1.OnReadyRequest
+	27, // 75: discord_v1.Hook.OnResumed:input_type -> common.Empty
+	27, // 76: discord_v1.Hook.OnDisconnect:input_type -> common.Empty
+	41, // 77: discord_v1.Hook.OnCreateGuild:input_type -> discord_v1.Guild
+	41, // 78: discord_v1.Hook.OnUpdateGuild:input_type -> discord_v1.Guild
+	20, // 79: discord_v1.Hook.OnDeleteGuild:input_type -> discord_v1.OnDeleteGuildRequest
+	44, // 80: discord_v1.Hook.OnCreateInteraction:input_type -> discord_v1.Interaction
+	0,  // 81: discord_v1.Hook.OnEvent:input_type -> discord_v1.GatewayEvent
+	23, // 82: discord_v1.Hook.OnInit:output_type -> discord_v1.InitResponse
+	27, // 83: discord_v1.Hook.OnCreateMessage:output_type -> common.Empty
+	27, // 84: discord_v1.Hook.OnUpdateMessage:output_type -> common.Empty
+	27, // 85: discord_v1.Hook.OnDeleteMessage:output_type -> common.Empty
+	27, // 86: discord_v1.Hook.OnBulkDeleteMessages:output_type -> common.Empty
+	27, // 87: discord_v1.Hook.OnAddReaction:output_type -> common.Empty
+	27, // 88: discord_v1.Hook.OnRemoveReaction:output_type -> common.Empty
+	27, // 89: discord_v1.Hook.OnRemoveAllReactions:output_type -> common.Empty
+	27, // 90: discord_v1.Hook.OnRemoveEmojiReactions:output_type -> common.Empty
+	27, // 91: discord_v1.Hook.OnAddGuildMember:output_type -> common.Empty
+	27, // 92: discord_v1.Hook.OnRemoveGuildMember:output_type -> common.Empty
+	27, // 93: discord_v1.Hook.OnUpdateGuildMember:output_type -> common.Empty
+	27, // 94: discord_v1.Hook.OnAddGuildBan:output_type -> common.Empty
+	27, // 95: discord_v1.Hook.OnRemoveGuildBan:output_type -> common.Empty
+	27, // 96: discord_v1.Hook.OnVoiceStateUpdate:output_type -> common.Empty
+	27, // 97: discord_v1.Hook.OnReady:output_type -> common.Empty
+	27, // 98: discord_v1.Hook.OnResumed:output_type -> common.Empty
+	27, // 99: discord_v1.Hook.OnDisconnect:output_type -> common.Empty
+	27, // 100: discord_v1.Hook.OnCreateGuild:output_type -> common.Empty
+	27, // 101: discord_v1.Hook.OnUpdateGuild:output_type -> common.Empty
+	27, // 102: discord_v1.Hook.OnDeleteGuild:output_type -> common.Empty
+	21, // 103: discord_v1.Hook.OnCreateInteraction:output_type -> discord_v1.OnCreateInteractionResponse
+	27, // 104: discord_v1.Hook.OnEvent:output_type -> common.Empty
+	82, // [82:105] is the sub-list for method output_type
+	59, // [59:82] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
 }
 
 func init() { file_discord_v1_hook_proto_init() }
@@ -217,13 +2259,49 @@ func file_discord_v1_hook_proto_init() {
 		return
 	}
 	file_discord_v1_discordgo_proto_init()
+	file_discord_v1_hook_proto_msgTypes[0].OneofWrappers = []any{
+		(*GatewayEvent_ChannelCreate)(nil),
+		(*GatewayEvent_ChannelUpdate)(nil),
+		(*GatewayEvent_ChannelDelete)(nil),
+		(*GatewayEvent_ChannelPinsUpdate)(nil),
+		(*GatewayEvent_ThreadCreate)(nil),
+		(*GatewayEvent_ThreadUpdate)(nil),
+		(*GatewayEvent_ThreadDelete)(nil),
+		(*GatewayEvent_ThreadListSync)(nil),
+		(*GatewayEvent_ThreadMemberUpdate)(nil),
+		(*GatewayEvent_ThreadMembersUpdate)(nil),
+		(*GatewayEvent_GuildRoleCreate)(nil),
+		(*GatewayEvent_GuildRoleUpdate)(nil),
+		(*GatewayEvent_GuildRoleDelete)(nil),
+		(*GatewayEvent_GuildEmojisUpdate)(nil),
+		(*GatewayEvent_GuildIntegrationsUpdate)(nil),
+		(*GatewayEvent_WebhooksUpdate)(nil),
+		(*GatewayEvent_UserUpdate)(nil),
+		(*GatewayEvent_PresenceUpdate)(nil),
+		(*GatewayEvent_TypingStart)(nil),
+		(*GatewayEvent_InviteCreate)(nil),
+		(*GatewayEvent_InviteDelete)(nil),
+		(*GatewayEvent_GuildScheduledEventCreate)(nil),
+		(*GatewayEvent_GuildScheduledEventUpdate)(nil),
+		(*GatewayEvent_GuildScheduledEventDelete)(nil),
+		(*GatewayEvent_GuildScheduledEventUserAdd)(nil),
+		(*GatewayEvent_GuildScheduledEventUserRemove)(nil),
+		(*GatewayEvent_StageInstanceCreate)(nil),
+		(*GatewayEvent_StageInstanceUpdate)(nil),
+		(*GatewayEvent_StageInstanceDelete)(nil),
+		(*GatewayEvent_AutoModerationRuleCreate)(nil),
+		(*GatewayEvent_AutoModerationRuleUpdate)(nil),
+		(*GatewayEvent_AutoModerationRuleDelete)(nil),
+		(*GatewayEvent_AutoModerationActionExecution)(nil),
+		(*GatewayEvent_Raw)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_discord_v1_hook_proto_rawDesc), len(file_discord_v1_hook_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
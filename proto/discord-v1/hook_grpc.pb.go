@@ -20,10 +20,29 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Hook_OnInit_FullMethodName              = "/discord_v1.Hook/OnInit"
-	Hook_OnCreateMessage_FullMethodName     = "/discord_v1.Hook/OnCreateMessage"
-	Hook_OnCreateInteraction_FullMethodName = "/discord_v1.Hook/OnCreateInteraction"
-	Hook_OnEvent_FullMethodName             = "/discord_v1.Hook/OnEvent"
+	Hook_OnInit_FullMethodName                 = "/discord_v1.Hook/OnInit"
+	Hook_OnCreateMessage_FullMethodName        = "/discord_v1.Hook/OnCreateMessage"
+	Hook_OnUpdateMessage_FullMethodName        = "/discord_v1.Hook/OnUpdateMessage"
+	Hook_OnDeleteMessage_FullMethodName        = "/discord_v1.Hook/OnDeleteMessage"
+	Hook_OnBulkDeleteMessages_FullMethodName   = "/discord_v1.Hook/OnBulkDeleteMessages"
+	Hook_OnAddReaction_FullMethodName          = "/discord_v1.Hook/OnAddReaction"
+	Hook_OnRemoveReaction_FullMethodName       = "/discord_v1.Hook/OnRemoveReaction"
+	Hook_OnRemoveAllReactions_FullMethodName   = "/discord_v1.Hook/OnRemoveAllReactions"
+	Hook_OnRemoveEmojiReactions_FullMethodName = "/discord_v1.Hook/OnRemoveEmojiReactions"
+	Hook_OnAddGuildMember_FullMethodName       = "/discord_v1.Hook/OnAddGuildMember"
+	Hook_OnRemoveGuildMember_FullMethodName    = "/discord_v1.Hook/OnRemoveGuildMember"
+	Hook_OnUpdateGuildMember_FullMethodName    = "/discord_v1.Hook/OnUpdateGuildMember"
+	Hook_OnAddGuildBan_FullMethodName          = "/discord_v1.Hook/OnAddGuildBan"
+	Hook_OnRemoveGuildBan_FullMethodName       = "/discord_v1.Hook/OnRemoveGuildBan"
+	Hook_OnVoiceStateUpdate_FullMethodName     = "/discord_v1.Hook/OnVoiceStateUpdate"
+	Hook_OnReady_FullMethodName                = "/discord_v1.Hook/OnReady"
+	Hook_OnResumed_FullMethodName              = "/discord_v1.Hook/OnResumed"
+	Hook_OnDisconnect_FullMethodName           = "/discord_v1.Hook/OnDisconnect"
+	Hook_OnCreateGuild_FullMethodName          = "/discord_v1.Hook/OnCreateGuild"
+	Hook_OnUpdateGuild_FullMethodName          = "/discord_v1.Hook/OnUpdateGuild"
+	Hook_OnDeleteGuild_FullMethodName          = "/discord_v1.Hook/OnDeleteGuild"
+	Hook_OnCreateInteraction_FullMethodName    = "/discord_v1.Hook/OnCreateInteraction"
+	Hook_OnEvent_FullMethodName                = "/discord_v1.Hook/OnEvent"
 )
 
 // HookClient is the client API for Hook service.
@@ -32,8 +51,27 @@ const (
 type HookClient interface {
 	OnInit(ctx context.Context, in *InitRequest, opts ...grpc.CallOption) (*InitResponse, error)
 	OnCreateMessage(ctx context.Context, in *Message, opts ...grpc.CallOption) (*proto.Empty, error)
-	OnCreateInteraction(ctx context.Context, in *Interaction, opts ...grpc.CallOption) (*proto.Empty, error)
-	OnEvent(ctx context.Context, in *OnEventRequest, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnUpdateMessage(ctx context.Context, in *OnUpdateMessageRequest, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnDeleteMessage(ctx context.Context, in *OnDeleteMessageRequest, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnBulkDeleteMessages(ctx context.Context, in *OnBulkDeleteMessagesRequest, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnAddReaction(ctx context.Context, in *OnAddReactionRequest, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnRemoveReaction(ctx context.Context, in *MessageReaction, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnRemoveAllReactions(ctx context.Context, in *MessageReaction, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnRemoveEmojiReactions(ctx context.Context, in *MessageReaction, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnAddGuildMember(ctx context.Context, in *Member, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnRemoveGuildMember(ctx context.Context, in *Member, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnUpdateGuildMember(ctx context.Context, in *OnUpdateGuildMemberRequest, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnAddGuildBan(ctx context.Context, in *GuildBanEvent, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnRemoveGuildBan(ctx context.Context, in *GuildBanEvent, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnVoiceStateUpdate(ctx context.Context, in *OnVoiceStateUpdateRequest, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnReady(ctx context.Context, in *OnReadyRequest, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnResumed(ctx context.Context, in *proto.Empty, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnDisconnect(ctx context.Context, in *proto.Empty, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnCreateGuild(ctx context.Context, in *Guild, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnUpdateGuild(ctx context.Context, in *Guild, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnDeleteGuild(ctx context.Context, in *OnDeleteGuildRequest, opts ...grpc.CallOption) (*proto.Empty, error)
+	OnCreateInteraction(ctx context.Context, in *Interaction, opts ...grpc.CallOption) (*OnCreateInteractionResponse, error)
+	OnEvent(ctx context.Context, in *GatewayEvent, opts ...grpc.CallOption) (*proto.Empty, error)
 }
 
 type hookClient struct {
@@ -62,8 +100,179 @@ func (c *hookClient) OnCreateMessage(ctx context.Context, in *Message, opts ...g
 	return out, nil
 }
 
-func (c *hookClient) OnCreateInteraction(ctx context.Context, in *Interaction, opts ...grpc.CallOption) (*proto.Empty, error) {
+func (c *hookClient) OnUpdateMessage(ctx context.Context, in *OnUpdateMessageRequest, opts ...grpc.CallOption) (*proto.Empty, error) {
 	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnUpdateMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnDeleteMessage(ctx context.Context, in *OnDeleteMessageRequest, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnDeleteMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnBulkDeleteMessages(ctx context.Context, in *OnBulkDeleteMessagesRequest, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnBulkDeleteMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnAddReaction(ctx context.Context, in *OnAddReactionRequest, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnAddReaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnRemoveReaction(ctx context.Context, in *MessageReaction, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnRemoveReaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnRemoveAllReactions(ctx context.Context, in *MessageReaction, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnRemoveAllReactions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnRemoveEmojiReactions(ctx context.Context, in *MessageReaction, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnRemoveEmojiReactions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnAddGuildMember(ctx context.Context, in *Member, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnAddGuildMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnRemoveGuildMember(ctx context.Context, in *Member, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnRemoveGuildMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnUpdateGuildMember(ctx context.Context, in *OnUpdateGuildMemberRequest, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnUpdateGuildMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnAddGuildBan(ctx context.Context, in *GuildBanEvent, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnAddGuildBan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnRemoveGuildBan(ctx context.Context, in *GuildBanEvent, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnRemoveGuildBan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnVoiceStateUpdate(ctx context.Context, in *OnVoiceStateUpdateRequest, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnVoiceStateUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnReady(ctx context.Context, in *OnReadyRequest, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnReady_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnResumed(ctx context.Context, in *proto.Empty, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnResumed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnDisconnect(ctx context.Context, in *proto.Empty, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnDisconnect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnCreateGuild(ctx context.Context, in *Guild, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnCreateGuild_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnUpdateGuild(ctx context.Context, in *Guild, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnUpdateGuild_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnDeleteGuild(ctx context.Context, in *OnDeleteGuildRequest, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, Hook_OnDeleteGuild_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hookClient) OnCreateInteraction(ctx context.Context, in *Interaction, opts ...grpc.CallOption) (*OnCreateInteractionResponse, error) {
+	out := new(OnCreateInteractionResponse)
 	err := c.cc.Invoke(ctx, Hook_OnCreateInteraction_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -71,7 +280,7 @@ func (c *hookClient) OnCreateInteraction(ctx context.Context, in *Interaction, o
 	return out, nil
 }
 
-func (c *hookClient) OnEvent(ctx context.Context, in *OnEventRequest, opts ...grpc.CallOption) (*proto.Empty, error) {
+func (c *hookClient) OnEvent(ctx context.Context, in *GatewayEvent, opts ...grpc.CallOption) (*proto.Empty, error) {
 	out := new(proto.Empty)
 	err := c.cc.Invoke(ctx, Hook_OnEvent_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -86,8 +295,27 @@ func (c *hookClient) OnEvent(ctx context.Context, in *OnEventRequest, opts ...gr
 type HookServer interface {
 	OnInit(context.Context, *InitRequest) (*InitResponse, error)
 	OnCreateMessage(context.Context, *Message) (*proto.Empty, error)
-	OnCreateInteraction(context.Context, *Interaction) (*proto.Empty, error)
-	OnEvent(context.Context, *OnEventRequest) (*proto.Empty, error)
+	OnUpdateMessage(context.Context, *OnUpdateMessageRequest) (*proto.Empty, error)
+	OnDeleteMessage(context.Context, *OnDeleteMessageRequest) (*proto.Empty, error)
+	OnBulkDeleteMessages(context.Context, *OnBulkDeleteMessagesRequest) (*proto.Empty, error)
+	OnAddReaction(context.Context, *OnAddReactionRequest) (*proto.Empty, error)
+	OnRemoveReaction(context.Context, *MessageReaction) (*proto.Empty, error)
+	OnRemoveAllReactions(context.Context, *MessageReaction) (*proto.Empty, error)
+	OnRemoveEmojiReactions(context.Context, *MessageReaction) (*proto.Empty, error)
+	OnAddGuildMember(context.Context, *Member) (*proto.Empty, error)
+	OnRemoveGuildMember(context.Context, *Member) (*proto.Empty, error)
+	OnUpdateGuildMember(context.Context, *OnUpdateGuildMemberRequest) (*proto.Empty, error)
+	OnAddGuildBan(context.Context, *GuildBanEvent) (*proto.Empty, error)
+	OnRemoveGuildBan(context.Context, *GuildBanEvent) (*proto.Empty, error)
+	OnVoiceStateUpdate(context.Context, *OnVoiceStateUpdateRequest) (*proto.Empty, error)
+	OnReady(context.Context, *OnReadyRequest) (*proto.Empty, error)
+	OnResumed(context.Context, *proto.Empty) (*proto.Empty, error)
+	OnDisconnect(context.Context, *proto.Empty) (*proto.Empty, error)
+	OnCreateGuild(context.Context, *Guild) (*proto.Empty, error)
+	OnUpdateGuild(context.Context, *Guild) (*proto.Empty, error)
+	OnDeleteGuild(context.Context, *OnDeleteGuildRequest) (*proto.Empty, error)
+	OnCreateInteraction(context.Context, *Interaction) (*OnCreateInteractionResponse, error)
+	OnEvent(context.Context, *GatewayEvent) (*proto.Empty, error)
 }
 
 // UnimplementedHookServer should be embedded to have forward compatible implementations.
@@ -100,10 +328,67 @@ func (UnimplementedHookServer) OnInit(context.Context, *InitRequest) (*InitRespo
 func (UnimplementedHookServer) OnCreateMessage(context.Context, *Message) (*proto.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OnCreateMessage not implemented")
 }
-func (UnimplementedHookServer) OnCreateInteraction(context.Context, *Interaction) (*proto.Empty, error) {
+func (UnimplementedHookServer) OnUpdateMessage(context.Context, *OnUpdateMessageRequest) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnUpdateMessage not implemented")
+}
+func (UnimplementedHookServer) OnDeleteMessage(context.Context, *OnDeleteMessageRequest) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnDeleteMessage not implemented")
+}
+func (UnimplementedHookServer) OnBulkDeleteMessages(context.Context, *OnBulkDeleteMessagesRequest) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnBulkDeleteMessages not implemented")
+}
+func (UnimplementedHookServer) OnAddReaction(context.Context, *OnAddReactionRequest) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnAddReaction not implemented")
+}
+func (UnimplementedHookServer) OnRemoveReaction(context.Context, *MessageReaction) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnRemoveReaction not implemented")
+}
+func (UnimplementedHookServer) OnRemoveAllReactions(context.Context, *MessageReaction) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnRemoveAllReactions not implemented")
+}
+func (UnimplementedHookServer) OnRemoveEmojiReactions(context.Context, *MessageReaction) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnRemoveEmojiReactions not implemented")
+}
+func (UnimplementedHookServer) OnAddGuildMember(context.Context, *Member) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnAddGuildMember not implemented")
+}
+func (UnimplementedHookServer) OnRemoveGuildMember(context.Context, *Member) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnRemoveGuildMember not implemented")
+}
+func (UnimplementedHookServer) OnUpdateGuildMember(context.Context, *OnUpdateGuildMemberRequest) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnUpdateGuildMember not implemented")
+}
+func (UnimplementedHookServer) OnAddGuildBan(context.Context, *GuildBanEvent) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnAddGuildBan not implemented")
+}
+func (UnimplementedHookServer) OnRemoveGuildBan(context.Context, *GuildBanEvent) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnRemoveGuildBan not implemented")
+}
+func (UnimplementedHookServer) OnVoiceStateUpdate(context.Context, *OnVoiceStateUpdateRequest) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnVoiceStateUpdate not implemented")
+}
+func (UnimplementedHookServer) OnReady(context.Context, *OnReadyRequest) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnReady not implemented")
+}
+func (UnimplementedHookServer) OnResumed(context.Context, *proto.Empty) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnResumed not implemented")
+}
+func (UnimplementedHookServer) OnDisconnect(context.Context, *proto.Empty) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnDisconnect not implemented")
+}
+func (UnimplementedHookServer) OnCreateGuild(context.Context, *Guild) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnCreateGuild not implemented")
+}
+func (UnimplementedHookServer) OnUpdateGuild(context.Context, *Guild) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnUpdateGuild not implemented")
+}
+func (UnimplementedHookServer) OnDeleteGuild(context.Context, *OnDeleteGuildRequest) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnDeleteGuild not implemented")
+}
+func (UnimplementedHookServer) OnCreateInteraction(context.Context, *Interaction) (*OnCreateInteractionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OnCreateInteraction not implemented")
 }
-func (UnimplementedHookServer) OnEvent(context.Context, *OnEventRequest) (*proto.Empty, error) {
+func (UnimplementedHookServer) OnEvent(context.Context, *GatewayEvent) (*proto.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OnEvent not implemented")
 }
 
@@ -154,6 +439,348 @@ func _Hook_OnCreateMessage_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Hook_OnUpdateMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnUpdateMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnUpdateMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnUpdateMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnUpdateMessage(ctx, req.(*OnUpdateMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hook_OnDeleteMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnDeleteMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnDeleteMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnDeleteMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnDeleteMessage(ctx, req.(*OnDeleteMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hook_OnBulkDeleteMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnBulkDeleteMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnBulkDeleteMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnBulkDeleteMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnBulkDeleteMessages(ctx, req.(*OnBulkDeleteMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hook_OnAddReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnAddReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnAddReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnAddReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnAddReaction(ctx, req.(*OnAddReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hook_OnRemoveReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageReaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnRemoveReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnRemoveReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnRemoveReaction(ctx, req.(*MessageReaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hook_OnRemoveAllReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageReaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnRemoveAllReactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnRemoveAllReactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnRemoveAllReactions(ctx, req.(*MessageReaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hook_OnRemoveEmojiReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageReaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnRemoveEmojiReactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnRemoveEmojiReactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnRemoveEmojiReactions(ctx, req.(*MessageReaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hook_OnAddGuildMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Member)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnAddGuildMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnAddGuildMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnAddGuildMember(ctx, req.(*Member))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hook_OnRemoveGuildMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Member)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnRemoveGuildMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnRemoveGuildMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnRemoveGuildMember(ctx, req.(*Member))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hook_OnUpdateGuildMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnUpdateGuildMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnUpdateGuildMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnUpdateGuildMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnUpdateGuildMember(ctx, req.(*OnUpdateGuildMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hook_OnAddGuildBan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuildBanEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnAddGuildBan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnAddGuildBan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnAddGuildBan(ctx, req.(*GuildBanEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hook_OnRemoveGuildBan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuildBanEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnRemoveGuildBan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnRemoveGuildBan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnRemoveGuildBan(ctx, req.(*GuildBanEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hook_OnVoiceStateUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnVoiceStateUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnVoiceStateUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnVoiceStateUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnVoiceStateUpdate(ctx, req.(*OnVoiceStateUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hook_OnReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnReadyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnReady_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnReady(ctx, req.(*OnReadyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hook_OnResumed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnResumed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnResumed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnResumed(ctx, req.(*proto.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hook_OnDisconnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnDisconnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnDisconnect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnDisconnect(ctx, req.(*proto.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hook_OnCreateGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Guild)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnCreateGuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnCreateGuild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnCreateGuild(ctx, req.(*Guild))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hook_OnUpdateGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Guild)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnUpdateGuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnUpdateGuild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnUpdateGuild(ctx, req.(*Guild))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hook_OnDeleteGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnDeleteGuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HookServer).OnDeleteGuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hook_OnDeleteGuild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HookServer).OnDeleteGuild(ctx, req.(*OnDeleteGuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Hook_OnCreateInteraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Interaction)
 	if err := dec(in); err != nil {
@@ -173,7 +800,7 @@ func _Hook_OnCreateInteraction_Handler(srv interface{}, ctx context.Context, dec
 }
 
 func _Hook_OnEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OnEventRequest)
+	in := new(GatewayEvent)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -185,7 +812,7 @@ func _Hook_OnEvent_Handler(srv interface{}, ctx context.Context, dec func(interf
 		FullMethod: Hook_OnEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HookServer).OnEvent(ctx, req.(*OnEventRequest))
+		return srv.(HookServer).OnEvent(ctx, req.(*GatewayEvent))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -205,6 +832,82 @@ var Hook_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OnCreateMessage",
 			Handler:    _Hook_OnCreateMessage_Handler,
 		},
+		{
+			MethodName: "OnUpdateMessage",
+			Handler:    _Hook_OnUpdateMessage_Handler,
+		},
+		{
+			MethodName: "OnDeleteMessage",
+			Handler:    _Hook_OnDeleteMessage_Handler,
+		},
+		{
+			MethodName: "OnBulkDeleteMessages",
+			Handler:    _Hook_OnBulkDeleteMessages_Handler,
+		},
+		{
+			MethodName: "OnAddReaction",
+			Handler:    _Hook_OnAddReaction_Handler,
+		},
+		{
+			MethodName: "OnRemoveReaction",
+			Handler:    _Hook_OnRemoveReaction_Handler,
+		},
+		{
+			MethodName: "OnRemoveAllReactions",
+			Handler:    _Hook_OnRemoveAllReactions_Handler,
+		},
+		{
+			MethodName: "OnRemoveEmojiReactions",
+			Handler:    _Hook_OnRemoveEmojiReactions_Handler,
+		},
+		{
+			MethodName: "OnAddGuildMember",
+			Handler:    _Hook_OnAddGuildMember_Handler,
+		},
+		{
+			MethodName: "OnRemoveGuildMember",
+			Handler:    _Hook_OnRemoveGuildMember_Handler,
+		},
+		{
+			MethodName: "OnUpdateGuildMember",
+			Handler:    _Hook_OnUpdateGuildMember_Handler,
+		},
+		{
+			MethodName: "OnAddGuildBan",
+			Handler:    _Hook_OnAddGuildBan_Handler,
+		},
+		{
+			MethodName: "OnRemoveGuildBan",
+			Handler:    _Hook_OnRemoveGuildBan_Handler,
+		},
+		{
+			MethodName: "OnVoiceStateUpdate",
+			Handler:    _Hook_OnVoiceStateUpdate_Handler,
+		},
+		{
+			MethodName: "OnReady",
+			Handler:    _Hook_OnReady_Handler,
+		},
+		{
+			MethodName: "OnResumed",
+			Handler:    _Hook_OnResumed_Handler,
+		},
+		{
+			MethodName: "OnDisconnect",
+			Handler:    _Hook_OnDisconnect_Handler,
+		},
+		{
+			MethodName: "OnCreateGuild",
+			Handler:    _Hook_OnCreateGuild_Handler,
+		},
+		{
+			MethodName: "OnUpdateGuild",
+			Handler:    _Hook_OnUpdateGuild_Handler,
+		},
+		{
+			MethodName: "OnDeleteGuild",
+			Handler:    _Hook_OnDeleteGuild_Handler,
+		},
 		{
 			MethodName: "OnCreateInteraction",
 			Handler:    _Hook_OnCreateInteraction_Handler,
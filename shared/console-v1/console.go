@@ -0,0 +1,315 @@
+package console
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Console is a local stand-in for the Discord platform, for developing modules without Discord.
+//
+// It turns the lines of a script (or stdin) into discordgo events, and implements discord.Helper
+// by printing every call made by the modules. Modules keep using the discord-v1 plugin unchanged,
+// so they can be exercised end to end offline.
+//
+// Script syntax (one event or directive per line):
+//
+//	hello world              MESSAGE_CREATE with the content "hello world"
+//	/play url=https://... n=3   INTERACTION_CREATE for the application command "play" (values may be "quoted")
+//	!click my_button         INTERACTION_CREATE for a click on the component with the custom_id "my_button"
+//	                         (as printed, e.g. with the namespace of its module in the runtime)
+//	.guild 1234              set the guild of the following events (an empty guild means DMs)
+//	.channel 5678            set the channel of the following events
+//	.user alice              set the author of the following events
+//	.sleep 500ms             wait before handling the next line
+//	# comment                ignored (as are empty lines)
+type Console struct {
+	mu     sync.Mutex
+	out    io.Writer
+	nextID uint64
+
+	GuildID   string          // Guild of the events (empty for DMs)
+	ChannelID string          // Channel of the events
+	Author    *discordgo.User // Author of the events
+	Bot       *discordgo.User // The user the modules act as
+
+	commands []*discordgo.ApplicationCommand
+}
+
+// Handler receives the events produced by Console.Run.
+type Handler struct {
+	OnMessage     func(message *discordgo.Message)
+	OnInteraction func(interaction *discordgo.Interaction)
+}
+
+// New creates a Console that prints to out.
+func New(out io.Writer) *Console {
+	return &Console{
+		out:       out,
+		nextID:    100000000000000000,
+		GuildID:   "100000000000000001",
+		ChannelID: "100000000000000002",
+		Author:    &discordgo.User{ID: "100000000000000003", Username: "console"},
+		Bot:       &discordgo.User{ID: "100000000000000004", Username: "flexmodule", Bot: true},
+	}
+}
+
+// Run reads the script from r line by line, and passes the resulting events to h.
+// It returns when r is exhausted.
+func (c *Console) Run(r io.Reader, h Handler) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		switch line[0] {
+		case '.':
+			if err := c.directive(line[1:]); err != nil {
+				c.printf("!! %s\n", err)
+			}
+		case '/':
+			i, err := c.command(line[1:])
+			if err != nil {
+				c.printf("!! %s\n", err)
+				continue
+			}
+			c.printf("-> INTERACTION_CREATE %s\n", line)
+			if h.OnInteraction != nil {
+				h.OnInteraction(i)
+			}
+		case '!':
+			i, err := c.click(line[1:])
+			if err != nil {
+				c.printf("!! %s\n", err)
+				continue
+			}
+			c.printf("-> INTERACTION_CREATE %s\n", line)
+			if h.OnInteraction != nil {
+				h.OnInteraction(i)
+			}
+		default:
+			c.printf("-> MESSAGE_CREATE %q\n", line)
+			if h.OnMessage != nil {
+				h.OnMessage(c.message(line))
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+// RegisterCommands records the commands registered by a module, so that ApplicationCommands returns them.
+func (c *Console) RegisterCommands(commands []*discordgo.ApplicationCommand) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, cmd := range commands {
+		cmd.ID = c.newID()
+		c.commands = append(c.commands, cmd)
+		fmt.Fprintf(c.out, "== registered /%s: %s\n", cmd.Name, cmd.Description)
+	}
+}
+
+// directive handles a line starting with '.'.
+func (c *Console) directive(line string) error {
+	name, arg, _ := strings.Cut(line, " ")
+	arg = strings.TrimSpace(arg)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch name {
+	case "guild":
+		c.GuildID = arg
+	case "channel":
+		if arg == "" {
+			return fmt.Errorf("usage: .channel <id>")
+		}
+		c.ChannelID = arg
+	case "user":
+		if arg == "" {
+			return fmt.Errorf("usage: .user <name>")
+		}
+		c.Author = &discordgo.User{ID: c.newID(), Username: arg}
+	case "sleep":
+		d, err := time.ParseDuration(arg)
+		if err != nil {
+			return fmt.Errorf("usage: .sleep <duration>: %w", err)
+		}
+		c.mu.Unlock()
+		time.Sleep(d)
+		c.mu.Lock()
+	default:
+		return fmt.Errorf("unknown directive: .%s", name)
+	}
+	return nil
+}
+
+// message creates a message event written by the current user.
+func (c *Console) message(content string) *discordgo.Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	m := &discordgo.Message{
+		ID:        c.newID(),
+		ChannelID: c.ChannelID,
+		GuildID:   c.GuildID,
+		Content:   content,
+		Timestamp: time.Now(),
+		Author:    c.Author,
+	}
+	if c.GuildID != "" {
+		m.Member = &discordgo.Member{GuildID: c.GuildID, User: c.Author}
+	}
+	return m
+}
+
+// command creates an application command interaction from "name key=value ...".
+func (c *Console) command(line string) (*discordgo.Interaction, error) {
+	fields, err := splitFields(line)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("usage: /<command> [option=value ...]")
+	}
+
+	data := discordgo.ApplicationCommandInteractionData{
+		Name:        fields[0],
+		CommandType: discordgo.ChatApplicationCommand,
+	}
+	for _, field := range fields[1:] {
+		name, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return nil, fmt.Errorf("option %q is not in the form name=value", field)
+		}
+		data.Options = append(data.Options, option(name, value))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, cmd := range c.commands {
+		if cmd.Name == data.Name {
+			data.ID = cmd.ID
+		}
+	}
+	return c.interaction(discordgo.InteractionApplicationCommand, data), nil
+}
+
+// click creates a button click interaction from "click <custom_id>".
+func (c *Console) click(line string) (*discordgo.Interaction, error) {
+	verb, customID, _ := strings.Cut(line, " ")
+	customID = strings.TrimSpace(customID)
+	if verb != "click" || customID == "" {
+		return nil, fmt.Errorf("usage: !click <custom_id>")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.interaction(discordgo.InteractionMessageComponent, discordgo.MessageComponentInteractionData{
+		CustomID:      customID,
+		ComponentType: discordgo.ButtonComponent,
+	}), nil
+}
+
+// interaction creates an interaction from the current user. c.mu must be held.
+func (c *Console) interaction(typ discordgo.InteractionType, data discordgo.InteractionData) *discordgo.Interaction {
+	i := &discordgo.Interaction{
+		ID:        c.newID(),
+		AppID:     c.Bot.ID,
+		Type:      typ,
+		Data:      data,
+		GuildID:   c.GuildID,
+		ChannelID: c.ChannelID,
+		Token:     "console-" + c.newID(),
+		Version:   1,
+	}
+	if c.GuildID != "" {
+		i.Member = &discordgo.Member{GuildID: c.GuildID, User: c.Author}
+	} else {
+		i.User = c.Author
+	}
+	return i
+}
+
+// option guesses the option type from the value, like Discord would deliver it.
+func option(name, value string) *discordgo.ApplicationCommandInteractionDataOption {
+	o := &discordgo.ApplicationCommandInteractionDataOption{Name: name}
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		// Discord sends numbers as JSON numbers, which discordgo decodes to float64
+		o.Type, o.Value = discordgo.ApplicationCommandOptionInteger, float64(n)
+	} else if f, err := strconv.ParseFloat(value, 64); err == nil {
+		o.Type, o.Value = discordgo.ApplicationCommandOptionNumber, f
+	} else if b, err := strconv.ParseBool(value); err == nil {
+		o.Type, o.Value = discordgo.ApplicationCommandOptionBoolean, b
+	} else {
+		o.Type, o.Value = discordgo.ApplicationCommandOptionString, value
+	}
+	return o
+}
+
+// splitFields splits s by spaces, keeping "quoted strings" together.
+func splitFields(s string) ([]string, error) {
+	var fields []string
+	var cur strings.Builder
+	quoted, inField := false, false
+	for _, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			inField = true
+		case r == ' ' && !quoted:
+			if inField {
+				fields = append(fields, cur.String())
+				cur.Reset()
+				inField = false
+			}
+		default:
+			cur.WriteRune(r)
+			inField = true
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quote")
+	}
+	if inField {
+		fields = append(fields, cur.String())
+	}
+	return fields, nil
+}
+
+// newID returns a new unique snowflake-like ID. c.mu must be held.
+func (c *Console) newID() string {
+	c.nextID++
+	return strconv.FormatUint(c.nextID, 10)
+}
+
+func (c *Console) printf(format string, args ...any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fmt.Fprintf(c.out, format, args...)
+}
+
+// call prints a Helper call with its arguments.
+func (c *Console) call(name string, args ...any) {
+	parts := make([]string, 0, len(args))
+	for _, arg := range args {
+		b, err := json.Marshal(arg)
+		if err != nil {
+			parts = append(parts, fmt.Sprintf("%+v", arg))
+			continue
+		}
+		parts = append(parts, string(b))
+	}
+	c.printf("<- %s(%s)\n", name, strings.Join(parts, ", "))
+}
@@ -0,0 +1,99 @@
+package console_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	console "github.com/thirdscam/chatanium-flexmodule/shared/console-v1"
+)
+
+// run runs a script, returning the events and the output.
+func run(t *testing.T, script string) ([]*discordgo.Message, []*discordgo.Interaction, string) {
+	t.Helper()
+
+	var out bytes.Buffer
+	c := console.New(&out)
+	c.RegisterCommands([]*discordgo.ApplicationCommand{{Name: "play", Description: "Play a song"}})
+
+	var messages []*discordgo.Message
+	var interactions []*discordgo.Interaction
+	err := c.Run(strings.NewReader(script), console.Handler{
+		OnMessage:     func(m *discordgo.Message) { messages = append(messages, m) },
+		OnInteraction: func(i *discordgo.Interaction) { interactions = append(interactions, i) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return messages, interactions, out.String()
+}
+
+func TestConsoleCommand(t *testing.T) {
+	_, interactions, _ := run(t, `/play url="never gonna" n=3 volume=0.5 loop=true`)
+	if len(interactions) != 1 {
+		t.Fatalf("got %d interactions", len(interactions))
+	}
+
+	i := interactions[0]
+	data := i.ApplicationCommandData()
+	if i.Type != discordgo.InteractionApplicationCommand || data.Name != "play" || data.ID == "" {
+		t.Fatalf("got %#v", i)
+	}
+	if len(data.Options) != 4 {
+		t.Fatalf("options: got %#v", data.Options)
+	}
+	// The values are typed like Discord would deliver them
+	if o := data.Options[0]; o.Name != "url" || o.StringValue() != "never gonna" {
+		t.Errorf("url: got %#v", o)
+	}
+	if o := data.Options[1]; o.Name != "n" || o.IntValue() != 3 {
+		t.Errorf("n: got %#v", o)
+	}
+	if o := data.Options[2]; o.Name != "volume" || o.FloatValue() != 0.5 {
+		t.Errorf("volume: got %#v", o)
+	}
+	if o := data.Options[3]; o.Name != "loop" || !o.BoolValue() {
+		t.Errorf("loop: got %#v", o)
+	}
+}
+
+func TestConsoleScript(t *testing.T) {
+	messages, interactions, out := run(t, strings.Join([]string{
+		"# comment",
+		"",
+		"hello world",
+		".guild",
+		".user alice",
+		"ping",
+		"!click vote:yes",
+		`/play url="unterminated`,
+		"/play n",
+		".unknown",
+	}, "\n"))
+
+	if len(messages) != 2 || messages[0].Content != "hello world" || messages[1].Content != "ping" {
+		t.Fatalf("messages: got %v", messages)
+	}
+	if messages[0].GuildID == "" || messages[0].Member == nil {
+		t.Errorf("first message: expected a guild message, got %#v", messages[0])
+	}
+	// .guild without an ID switches to DMs
+	if dm := messages[1]; dm.GuildID != "" || dm.Member != nil || dm.Author.Username != "alice" {
+		t.Errorf("second message: expected a DM from alice, got %#v", dm)
+	}
+
+	if len(interactions) != 1 {
+		t.Fatalf("interactions: got %d", len(interactions))
+	}
+	click := interactions[0]
+	if click.Type != discordgo.InteractionMessageComponent || click.MessageComponentData().CustomID != "vote:yes" || click.User == nil {
+		t.Errorf("click: got %#v", click)
+	}
+
+	for _, want := range []string{"!! unterminated quote", `!! option "n" is not in the form name=value`, "!! unknown directive: .unknown"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output: missing %q in\n%s", want, out)
+		}
+	}
+}
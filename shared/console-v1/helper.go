@@ -0,0 +1,378 @@
+package console
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	shared "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+)
+
+// The Console implements discord.Helper by printing the calls and returning plausible results,
+// so that modules behave (mostly) as they would on Discord.
+
+// sent returns a message as if it was sent by the bot.
+func (c *Console) sent(channelID, content string, embeds []*discordgo.MessageEmbed) *discordgo.Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return &discordgo.Message{
+		ID:        c.newID(),
+		ChannelID: channelID,
+		GuildID:   c.GuildID,
+		Content:   content,
+		Embeds:    embeds,
+		Timestamp: time.Now(),
+		Author:    c.Bot,
+	}
+}
+
+// channel returns a text channel in the current guild.
+func (c *Console) channel(channelID string) *discordgo.Channel {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return &discordgo.Channel{
+		ID:      channelID,
+		GuildID: c.GuildID,
+		Name:    "console",
+		Type:    discordgo.ChannelTypeGuildText,
+	}
+}
+
+// WithContext returns the console itself, since its calls complete immediately.
+func (c *Console) WithContext(ctx context.Context) shared.Helper {
+	return c
+}
+
+// ================================================
+// Message operations
+// ================================================
+
+func (c *Console) ChannelMessageSend(channelID string, content string) (*discordgo.Message, error) {
+	c.call("ChannelMessageSend", channelID, content)
+	return c.sent(channelID, content, nil), nil
+}
+
+func (c *Console) ChannelMessageSendComplex(channelID string, data *discordgo.MessageSend) (*discordgo.Message, error) {
+	c.call("ChannelMessageSendComplex", channelID, data)
+	return c.sent(channelID, data.Content, data.Embeds), nil
+}
+
+func (c *Console) ChannelMessageSendEmbed(channelID string, embed *discordgo.MessageEmbed) (*discordgo.Message, error) {
+	c.call("ChannelMessageSendEmbed", channelID, embed)
+	return c.sent(channelID, "", []*discordgo.MessageEmbed{embed}), nil
+}
+
+func (c *Console) ChannelMessageSendEmbeds(channelID string, embeds []*discordgo.MessageEmbed) (*discordgo.Message, error) {
+	c.call("ChannelMessageSendEmbeds", channelID, embeds)
+	return c.sent(channelID, "", embeds), nil
+}
+
+func (c *Console) ChannelMessageEdit(channelID, messageID, content string) (*discordgo.Message, error) {
+	c.call("ChannelMessageEdit", channelID, messageID, content)
+	m := c.sent(channelID, content, nil)
+	m.ID = messageID
+	return m, nil
+}
+
+func (c *Console) ChannelMessageEditComplex(data *discordgo.MessageEdit) (*discordgo.Message, error) {
+	c.call("ChannelMessageEditComplex", data)
+	content := ""
+	if data.Content != nil {
+		content = *data.Content
+	}
+	var embeds []*discordgo.MessageEmbed
+	if data.Embeds != nil {
+		embeds = *data.Embeds
+	}
+	m := c.sent(data.Channel, content, embeds)
+	m.ID = data.ID
+	return m, nil
+}
+
+func (c *Console) ChannelMessageDelete(channelID, messageID string) error {
+	c.call("ChannelMessageDelete", channelID, messageID)
+	return nil
+}
+
+func (c *Console) ChannelMessages(channelID string, limit int, beforeID, afterID, aroundID string) ([]*discordgo.Message, error) {
+	c.call("ChannelMessages", channelID, limit, beforeID, afterID, aroundID)
+	return []*discordgo.Message{}, nil
+}
+
+func (c *Console) ChannelMessage(channelID, messageID string) (*discordgo.Message, error) {
+	c.call("ChannelMessage", channelID, messageID)
+	m := c.sent(channelID, "", nil)
+	m.ID = messageID
+	return m, nil
+}
+
+// ================================================
+// Channel operations
+// ================================================
+
+func (c *Console) Channel(channelID string) (*discordgo.Channel, error) {
+	c.call("Channel", channelID)
+	return c.channel(channelID), nil
+}
+
+func (c *Console) ChannelEdit(channelID string, data *discordgo.ChannelEdit) (*discordgo.Channel, error) {
+	c.call("ChannelEdit", channelID, data)
+	ch := c.channel(channelID)
+	if data.Name != "" {
+		ch.Name = data.Name
+	}
+	return ch, nil
+}
+
+func (c *Console) ChannelDelete(channelID string) (*discordgo.Channel, error) {
+	c.call("ChannelDelete", channelID)
+	return c.channel(channelID), nil
+}
+
+func (c *Console) ChannelTyping(channelID string) error {
+	c.call("ChannelTyping", channelID)
+	return nil
+}
+
+// ================================================
+// Guild operations
+// ================================================
+
+func (c *Console) Guild(guildID string) (*discordgo.Guild, error) {
+	c.call("Guild", guildID)
+	return &discordgo.Guild{ID: guildID, Name: "console"}, nil
+}
+
+func (c *Console) GuildChannels(guildID string) ([]*discordgo.Channel, error) {
+	c.call("GuildChannels", guildID)
+	return []*discordgo.Channel{c.channel(c.ChannelID)}, nil
+}
+
+func (c *Console) GuildMembers(guildID string, after string, limit int) ([]*discordgo.Member, error) {
+	c.call("GuildMembers", guildID, after, limit)
+	return []*discordgo.Member{{GuildID: guildID, User: c.Author}}, nil
+}
+
+func (c *Console) GuildMember(guildID, userID string) (*discordgo.Member, error) {
+	c.call("GuildMember", guildID, userID)
+	return &discordgo.Member{GuildID: guildID, User: &discordgo.User{ID: userID}}, nil
+}
+
+func (c *Console) GuildRoles(guildID string) ([]*discordgo.Role, error) {
+	c.call("GuildRoles", guildID)
+	return []*discordgo.Role{}, nil
+}
+
+// ================================================
+// User operations
+// ================================================
+
+func (c *Console) User(userID string) (*discordgo.User, error) {
+	c.call("User", userID)
+	if userID == "@me" {
+		return c.Bot, nil
+	}
+	return &discordgo.User{ID: userID}, nil
+}
+
+func (c *Console) UserChannelCreate(recipientID string) (*discordgo.Channel, error) {
+	c.call("UserChannelCreate", recipientID)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return &discordgo.Channel{
+		ID:         c.newID(),
+		Type:       discordgo.ChannelTypeDM,
+		Recipients: []*discordgo.User{{ID: recipientID}},
+	}, nil
+}
+
+// ================================================
+// Interaction operations
+// ================================================
+
+func (c *Console) InteractionRespond(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse) error {
+	c.call("InteractionRespond", interaction.ID, resp)
+	return nil
+}
+
+func (c *Console) InteractionResponseEdit(interaction *discordgo.Interaction, newresp *discordgo.WebhookEdit) (*discordgo.Message, error) {
+	c.call("InteractionResponseEdit", interaction.ID, newresp)
+	content := ""
+	if newresp.Content != nil {
+		content = *newresp.Content
+	}
+	return c.sent(interaction.ChannelID, content, nil), nil
+}
+
+// ================================================
+// Application Command operations
+// ================================================
+
+func (c *Console) ApplicationCommandCreate(appID string, guildID string, cmd *discordgo.ApplicationCommand) (*discordgo.ApplicationCommand, error) {
+	c.call("ApplicationCommandCreate", appID, guildID, cmd)
+	c.RegisterCommands([]*discordgo.ApplicationCommand{cmd})
+	return cmd, nil
+}
+
+func (c *Console) ApplicationCommandEdit(appID, guildID, cmdID string, cmd *discordgo.ApplicationCommand) (*discordgo.ApplicationCommand, error) {
+	c.call("ApplicationCommandEdit", appID, guildID, cmdID, cmd)
+	cmd.ID = cmdID
+	return cmd, nil
+}
+
+func (c *Console) ApplicationCommandDelete(appID, guildID, cmdID string) error {
+	c.call("ApplicationCommandDelete", appID, guildID, cmdID)
+	return nil
+}
+
+func (c *Console) ApplicationCommands(appID, guildID string) ([]*discordgo.ApplicationCommand, error) {
+	c.call("ApplicationCommands", appID, guildID)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]*discordgo.ApplicationCommand{}, c.commands...), nil
+}
+
+// ================================================
+// Reaction operations
+// ================================================
+
+func (c *Console) MessageReactionAdd(channelID, messageID, emojiID string) error {
+	c.call("MessageReactionAdd", channelID, messageID, emojiID)
+	return nil
+}
+
+func (c *Console) MessageReactionRemove(channelID, messageID, emojiID, userID string) error {
+	c.call("MessageReactionRemove", channelID, messageID, emojiID, userID)
+	return nil
+}
+
+func (c *Console) MessageReactionsRemoveAll(channelID, messageID string) error {
+	c.call("MessageReactionsRemoveAll", channelID, messageID)
+	return nil
+}
+
+// ================================================
+// Thread operations
+// ================================================
+
+func (c *Console) ThreadStart(channelID, name string, typ discordgo.ChannelType, archiveDuration int) (*discordgo.Channel, error) {
+	c.call("ThreadStart", channelID, name, typ, archiveDuration)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return &discordgo.Channel{
+		ID:       c.newID(),
+		GuildID:  c.GuildID,
+		ParentID: channelID,
+		Name:     name,
+		Type:     typ,
+	}, nil
+}
+
+func (c *Console) ThreadJoin(threadID string) error {
+	c.call("ThreadJoin", threadID)
+	return nil
+}
+
+func (c *Console) ThreadLeave(threadID string) error {
+	c.call("ThreadLeave", threadID)
+	return nil
+}
+
+func (c *Console) ThreadMemberAdd(threadID, memberID string) error {
+	c.call("ThreadMemberAdd", threadID, memberID)
+	return nil
+}
+
+func (c *Console) ThreadMemberRemove(threadID, memberID string) error {
+	c.call("ThreadMemberRemove", threadID, memberID)
+	return nil
+}
+
+// ================================================
+// Voice operations
+// ================================================
+
+func (c *Console) VoiceRegions() ([]*discordgo.VoiceRegion, error) {
+	c.call("VoiceRegions")
+	return []*discordgo.VoiceRegion{}, nil
+}
+
+func (c *Console) GuildVoiceStates(guildID string) ([]*discordgo.VoiceState, error) {
+	c.call("GuildVoiceStates", guildID)
+	return []*discordgo.VoiceState{}, nil
+}
+
+// ================================================
+// File operations
+// ================================================
+
+func (c *Console) AttachmentDownload(attachment *discordgo.MessageAttachment) (*shared.Download, error) {
+	c.call("AttachmentDownload", attachment.ID, attachment.URL)
+	return nil, errors.New("attachments can't be downloaded on the console")
+}
+
+// ================================================
+// Webhook operations
+// ================================================
+
+func (c *Console) WebhookCreate(channelID, name, avatar string) (*discordgo.Webhook, error) {
+	c.call("WebhookCreate", channelID, name, avatar)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return &discordgo.Webhook{
+		ID:        c.newID(),
+		Type:      discordgo.WebhookTypeIncoming,
+		GuildID:   c.GuildID,
+		ChannelID: channelID,
+		Name:      name,
+		Avatar:    avatar,
+		Token:     "console-" + c.newID(),
+	}, nil
+}
+
+func (c *Console) WebhookExecute(webhookID, token string, wait bool, data *discordgo.WebhookParams) (*discordgo.Message, error) {
+	c.call("WebhookExecute", webhookID, token, wait, data)
+	if !wait {
+		return nil, nil
+	}
+	return c.sent(c.ChannelID, data.Content, data.Embeds), nil
+}
+
+// ================================================
+// Permission operations
+// ================================================
+
+func (c *Console) UserChannelPermissions(userID, channelID string) (int64, error) {
+	c.call("UserChannelPermissions", userID, channelID)
+	return discordgo.PermissionAll, nil
+}
+
+// ================================================
+// Utility operations
+// ================================================
+
+func (c *Console) Gateway() (string, error) {
+	c.call("Gateway")
+	return "wss://console.invalid", nil
+}
+
+func (c *Console) GatewayBot() (*discordgo.GatewayBotResponse, error) {
+	c.call("GatewayBot")
+	return &discordgo.GatewayBotResponse{URL: "wss://console.invalid", Shards: 1}, nil
+}
+
+var (
+	_ shared.Helper        = &Console{}
+	_ shared.ContextHelper = &Console{}
+)
@@ -18,6 +18,31 @@ type Manifest struct {
 	Author      string
 	Repository  string
 	Permissions Permissions
+
+	// Subscriptions are the events that the runtime forwards to the module.
+	// If empty, the module receives every event (as allowed by its permissions).
+	Subscriptions []Subscription
+}
+
+// Subscription declares events that a module wants to receive.
+//
+// An event is forwarded when it matches any of the module's subscriptions, which means matching
+// all the filters of the subscription.
+// Each filter is optional: an empty filter matches every event.
+type Subscription struct {
+	// Event is the gateway event name (e.g. "MESSAGE_CREATE", "INTERACTION_CREATE"), or "*" (or empty)
+	// for every event.
+	Event string
+
+	Guilds   []string // Only events in one of these guilds
+	Channels []string // Only events in one of these channels
+
+	// MessagePrefix only passes messages whose content starts with this prefix. Discord leaves the
+	// content out of partial MESSAGE_UPDATE events and of MESSAGE_DELETE events, so the content of the
+	// cached message is matched instead; they don't match when the message wasn't cached.
+	MessagePrefix  string
+	Commands       []string // Only interactions of one of these (application) commands
+	CustomIDPrefix string   // Only component and modal interactions whose custom_id starts with this prefix
 }
 
 type Status struct {
@@ -45,3 +70,13 @@ type Status struct {
 // runtime will check if the host has the required permissions
 // before starting the plugin.
 type Permissions []string
+
+// Has reports whether p is one of the permissions.
+func (ps Permissions) Has(p string) bool {
+	for _, v := range ps {
+		if v == p {
+			return true
+		}
+	}
+	return false
+}
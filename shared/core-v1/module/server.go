@@ -24,13 +24,26 @@ func (m *GRPCServer) GetManifest(ctx context.Context, req *proto_common.Empty) (
 		return nil, err
 	}
 
+	subscriptions := make([]*proto.Subscription, 0, len(manifest.Subscriptions))
+	for _, s := range manifest.Subscriptions {
+		subscriptions = append(subscriptions, &proto.Subscription{
+			Event:          s.Event,
+			Guilds:         s.Guilds,
+			Channels:       s.Channels,
+			MessagePrefix:  s.MessagePrefix,
+			Commands:       s.Commands,
+			CustomIdPrefix: s.CustomIDPrefix,
+		})
+	}
+
 	// Serve manifests to the runtime
 	return &proto.GetManifestResponse{
-		Name:        manifest.Name,
-		Version:     manifest.Version,
-		Author:      manifest.Author,
-		Repository:  manifest.Repository,
-		Permissions: []string(manifest.Permissions),
+		Name:          manifest.Name,
+		Version:       manifest.Version,
+		Author:        manifest.Author,
+		Repository:    manifest.Repository,
+		Permissions:   []string(manifest.Permissions),
+		Subscriptions: subscriptions,
 	}, nil
 }
 
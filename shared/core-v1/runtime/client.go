@@ -24,13 +24,26 @@ func (m *GRPCClient) GetManifest() (shared.Manifest, error) {
 		return shared.Manifest{}, err
 	}
 
+	var subscriptions []shared.Subscription
+	for _, s := range resp.Subscriptions {
+		subscriptions = append(subscriptions, shared.Subscription{
+			Event:          s.Event,
+			Guilds:         s.Guilds,
+			Channels:       s.Channels,
+			MessagePrefix:  s.MessagePrefix,
+			Commands:       s.Commands,
+			CustomIDPrefix: s.CustomIdPrefix,
+		})
+	}
+
 	// Pass the results received from the module to the runtime
 	return shared.Manifest{
-		Name:        resp.Name,
-		Version:     resp.Version,
-		Author:      resp.Author,
-		Repository:  resp.Repository,
-		Permissions: shared.Permissions(resp.Permissions),
+		Name:          resp.Name,
+		Version:       resp.Version,
+		Author:        resp.Author,
+		Repository:    resp.Repository,
+		Permissions:   shared.Permissions(resp.Permissions),
+		Subscriptions: subscriptions,
 	}, nil
 }
 
@@ -0,0 +1,51 @@
+package discord
+
+import "github.com/bwmarrin/discordgo"
+
+// MaxAutocompleteChoices is the maximum number of choices of an autocomplete response.
+const MaxAutocompleteChoices = 25
+
+// AutocompleteHook is implemented by hooks that suggest values for the options with autocomplete.
+//
+// When a module's Hook implements it, autocomplete interactions are passed to OnAutocomplete
+// instead of OnCreateInteraction, with the option being typed. The runtime sends the returned
+// choices; if they aren't returned in time, the runtime sends no choices instead, since
+// autocompletes can't be deferred.
+type AutocompleteHook interface {
+	OnAutocomplete(interaction *discordgo.Interaction, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error)
+}
+
+// FocusedOption returns the option being typed in an autocomplete interaction (searching the
+// options of subcommands), or nil.
+func FocusedOption(interaction *discordgo.Interaction) *discordgo.ApplicationCommandInteractionDataOption {
+	data, ok := interaction.Data.(discordgo.ApplicationCommandInteractionData)
+	if !ok {
+		return nil
+	}
+	return focusedOption(data.Options)
+}
+
+func focusedOption(options []*discordgo.ApplicationCommandInteractionDataOption) *discordgo.ApplicationCommandInteractionDataOption {
+	for _, option := range options {
+		if option.Focused {
+			return option
+		}
+		if focused := focusedOption(option.Options); focused != nil {
+			return focused
+		}
+	}
+	return nil
+}
+
+// AutocompleteResponse returns the response of an autocomplete interaction with choices,
+// keeping the first MaxAutocompleteChoices.
+func AutocompleteResponse(choices []*discordgo.ApplicationCommandOptionChoice) *discordgo.InteractionResponse {
+	if len(choices) > MaxAutocompleteChoices {
+		choices = choices[:MaxAutocompleteChoices]
+	}
+
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{Choices: choices},
+	}
+}
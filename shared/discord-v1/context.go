@@ -0,0 +1,64 @@
+package discord
+
+import (
+	"context"
+	"time"
+)
+
+// ContextHook is implemented by hooks that can be bound to a context.
+//
+// When a module's Hook implements it, each hook is called on WithContext(ctx), where ctx is
+// cancelled when the runtime cancels the call (or its deadline expires). Pass ctx to
+// HelperWithContext to cancel the Helper calls made by the hook along with it.
+//
+// OnInit is called on the hook itself, so that the Helper it receives can be stored.
+type ContextHook interface {
+	WithContext(ctx context.Context) Hook
+}
+
+// ContextHelper is implemented by helpers whose calls can be bound to a context. The helpers
+// provided by the runtime implement it, but other implementations of Helper don't have to.
+type ContextHelper interface {
+	// WithContext returns a Helper whose calls are made with ctx: they are cancelled with it
+	// (on both sides of the module boundary), and have its deadline.
+	WithContext(ctx context.Context) Helper
+}
+
+// HookWithContext returns hook bound to ctx if it implements ContextHook, otherwise hook itself.
+func HookWithContext(hook Hook, ctx context.Context) Hook {
+	if h, ok := hook.(ContextHook); ok {
+		return h.WithContext(ctx)
+	}
+	return hook
+}
+
+// HelperWithContext returns helper bound to ctx if it implements ContextHelper, otherwise helper itself.
+func HelperWithContext(helper Helper, ctx context.Context) Helper {
+	if h, ok := helper.(ContextHelper); ok {
+		return h.WithContext(ctx)
+	}
+	return helper
+}
+
+// Deadlines are the default deadlines of calls, by method name (e.g. "OnCreateChatMessage" or
+// "ChannelMessageSend"). Methods without an entry use Default, and a zero deadline means none.
+type Deadlines struct {
+	Default time.Duration
+	Methods map[string]time.Duration
+}
+
+// For returns the deadline of method.
+func (d Deadlines) For(method string) time.Duration {
+	if deadline, ok := d.Methods[method]; ok {
+		return deadline
+	}
+	return d.Default
+}
+
+// Context returns ctx with the deadline of method, if it has one.
+func (d Deadlines) Context(ctx context.Context, method string) (context.Context, context.CancelFunc) {
+	if deadline := d.For(method); deadline > 0 {
+		return context.WithTimeout(ctx, deadline)
+	}
+	return context.WithCancel(ctx)
+}
@@ -0,0 +1,64 @@
+package discord_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	discord "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+)
+
+func TestDeadlines(t *testing.T) {
+	deadlines := discord.Deadlines{
+		Default: time.Minute,
+		Methods: map[string]time.Duration{"OnInit": time.Hour, "OnEvent": 0},
+	}
+	for method, want := range map[string]time.Duration{"OnInit": time.Hour, "OnEvent": 0, "OnReady": time.Minute} {
+		if got := deadlines.For(method); got != want {
+			t.Errorf("%s: got %v, want %v", method, got, want)
+		}
+
+		ctx, cancel := deadlines.Context(context.Background(), method)
+		deadline, ok := ctx.Deadline()
+		if ok != (want > 0) || ok && time.Until(deadline) > want {
+			t.Errorf("%s: got deadline %v (%v)", method, deadline, ok)
+		}
+		cancel()
+		if ctx.Err() == nil {
+			t.Errorf("%s: not cancelled", method)
+		}
+	}
+}
+
+// contextHook records the context it's bound to.
+type contextHook struct {
+	discord.AbstractHooks
+	ctx context.Context
+}
+
+func (h *contextHook) WithContext(ctx context.Context) discord.Hook {
+	return &contextHook{ctx: ctx}
+}
+
+// plainHelper is a Helper without WithContext.
+type plainHelper struct {
+	discord.Helper
+}
+
+func TestWithContext(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, true)
+
+	if hook, ok := discord.HookWithContext(&contextHook{}, ctx).(*contextHook); !ok || hook.ctx != ctx {
+		t.Errorf("got hook %#v", hook)
+	}
+	plain := &discord.AbstractHooks{}
+	if hook := discord.HookWithContext(plain, ctx); hook != plain {
+		t.Errorf("got hook %#v for a hook without WithContext", hook)
+	}
+
+	helper := &plainHelper{}
+	if got := discord.HelperWithContext(helper, ctx); got != helper {
+		t.Errorf("got helper %#v for a helper without WithContext", got)
+	}
+}
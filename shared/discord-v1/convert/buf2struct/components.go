@@ -1 +1,140 @@
 package buf2struct
+
+import (
+	"github.com/bwmarrin/discordgo"
+	proto "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
+)
+
+// MessageComponent converts a component to a pointer, like the components unmarshaled by discordgo.
+func MessageComponent(buf *proto.MessageComponent) discordgo.MessageComponent {
+	// The nil checks keep typed nil pointers out of the interface
+	switch {
+	case buf.GetActionsRow() != nil:
+		return ActionsRow(buf.GetActionsRow())
+	case buf.GetButton() != nil:
+		return Button(buf.GetButton())
+	case buf.GetSelectMenu() != nil:
+		return SelectMenu(buf.GetSelectMenu())
+	case buf.GetTextInput() != nil:
+		return TextInput(buf.GetTextInput())
+	default:
+		return nil
+	}
+}
+
+// MessageComponents converts a slice of components, skipping the empty ones.
+func MessageComponents(buf []*proto.MessageComponent) []discordgo.MessageComponent {
+	if buf == nil {
+		return nil
+	}
+
+	components := make([]discordgo.MessageComponent, 0, len(buf))
+	for _, component := range buf {
+		if c := MessageComponent(component); c != nil {
+			components = append(components, c)
+		}
+	}
+	return components
+}
+
+func ActionsRow(buf *proto.ActionsRow) *discordgo.ActionsRow {
+	if buf == nil {
+		return nil
+	}
+
+	return &discordgo.ActionsRow{
+		Components: MessageComponents(buf.Components),
+	}
+}
+
+func Button(buf *proto.Button) *discordgo.Button {
+	if buf == nil {
+		return nil
+	}
+
+	return &discordgo.Button{
+		Label:    buf.Label,
+		Style:    discordgo.ButtonStyle(buf.Style),
+		Disabled: buf.Disabled,
+		Emoji:    ComponentEmoji(buf.Emoji),
+		URL:      buf.Url,
+		CustomID: buf.CustomId,
+	}
+}
+
+func SelectMenu(buf *proto.SelectMenu) *discordgo.SelectMenu {
+	if buf == nil {
+		return nil
+	}
+
+	var minValues *int
+	if buf.MinValues != nil {
+		v := int(*buf.MinValues)
+		minValues = &v
+	}
+
+	var defaultValues []discordgo.SelectMenuDefaultValue
+	for _, value := range buf.DefaultValues {
+		defaultValues = append(defaultValues, discordgo.SelectMenuDefaultValue{
+			ID:   value.Id,
+			Type: discordgo.SelectMenuDefaultValueType(value.Type),
+		})
+	}
+
+	var options []discordgo.SelectMenuOption
+	for _, option := range buf.Options {
+		options = append(options, discordgo.SelectMenuOption{
+			Label:       option.Label,
+			Value:       option.Value,
+			Description: option.Description,
+			Emoji:       ComponentEmoji(option.Emoji),
+			Default:     option.Default,
+		})
+	}
+
+	var channelTypes []discordgo.ChannelType
+	for _, channelType := range buf.ChannelTypes {
+		channelTypes = append(channelTypes, discordgo.ChannelType(channelType))
+	}
+
+	return &discordgo.SelectMenu{
+		MenuType:      discordgo.SelectMenuType(buf.MenuType),
+		CustomID:      buf.CustomId,
+		Placeholder:   buf.Placeholder,
+		MinValues:     minValues,
+		MaxValues:     int(buf.MaxValues),
+		DefaultValues: defaultValues,
+		Options:       options,
+		Disabled:      buf.Disabled,
+		ChannelTypes:  channelTypes,
+	}
+}
+
+func TextInput(buf *proto.TextInput) *discordgo.TextInput {
+	if buf == nil {
+		return nil
+	}
+
+	return &discordgo.TextInput{
+		CustomID:    buf.CustomId,
+		Label:       buf.Label,
+		Style:       discordgo.TextInputStyle(buf.Style),
+		Placeholder: buf.Placeholder,
+		Value:       buf.Value,
+		Required:    buf.Required,
+		MinLength:   int(buf.MinLength),
+		MaxLength:   int(buf.MaxLength),
+	}
+}
+
+func ComponentEmoji(buf *proto.ComponentEmoji) *discordgo.ComponentEmoji {
+	if buf == nil {
+		return nil
+	}
+
+	return &discordgo.ComponentEmoji{
+		Name:     buf.Name,
+		ID:       buf.Id,
+		Animated: buf.Animated,
+	}
+}
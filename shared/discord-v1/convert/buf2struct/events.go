@@ -0,0 +1,185 @@
+package buf2struct
+
+import (
+	"github.com/bwmarrin/discordgo"
+	proto "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
+)
+
+// GatewayEvent converts proto.GatewayEvent to a discordgo.Event, with the matching typed event
+// (e.g. *discordgo.ChannelCreate) as Struct. Events sent as raw have their JSON payload as RawData only.
+func GatewayEvent(buf *proto.GatewayEvent) *discordgo.Event {
+	if buf == nil {
+		return nil
+	}
+
+	return &discordgo.Event{
+		Type:    buf.Type,
+		Struct:  gatewayEvent(buf),
+		RawData: buf.GetRaw(),
+	}
+}
+
+// gatewayEvent converts the payload of proto.GatewayEvent to the matching discordgo event, or nil.
+func gatewayEvent(buf *proto.GatewayEvent) interface{} {
+	switch p := buf.Payload.(type) {
+	case *proto.GatewayEvent_ChannelCreate:
+		return &discordgo.ChannelCreate{Channel: Channel(p.ChannelCreate)}
+	case *proto.GatewayEvent_ChannelUpdate:
+		return &discordgo.ChannelUpdate{Channel: Channel(p.ChannelUpdate)}
+	case *proto.GatewayEvent_ChannelDelete:
+		return &discordgo.ChannelDelete{Channel: Channel(p.ChannelDelete)}
+	case *proto.GatewayEvent_ChannelPinsUpdate:
+		return &discordgo.ChannelPinsUpdate{
+			LastPinTimestamp: p.ChannelPinsUpdate.GetLastPinTimestamp(),
+			ChannelID:        buf.ChannelId,
+			GuildID:          buf.GuildId,
+		}
+
+	case *proto.GatewayEvent_ThreadCreate:
+		return &discordgo.ThreadCreate{
+			Channel:      Channel(p.ThreadCreate.GetThread()),
+			NewlyCreated: p.ThreadCreate.GetNewlyCreated(),
+		}
+	case *proto.GatewayEvent_ThreadUpdate:
+		return &discordgo.ThreadUpdate{
+			Channel:      Channel(p.ThreadUpdate.GetThread()),
+			BeforeUpdate: Channel(p.ThreadUpdate.GetBefore()),
+		}
+	case *proto.GatewayEvent_ThreadDelete:
+		return &discordgo.ThreadDelete{Channel: Channel(p.ThreadDelete)}
+	case *proto.GatewayEvent_ThreadListSync:
+		threads := make([]*discordgo.Channel, 0, len(p.ThreadListSync.GetThreads()))
+		for _, t := range p.ThreadListSync.GetThreads() {
+			threads = append(threads, Channel(t))
+		}
+		members := make([]*discordgo.ThreadMember, 0, len(p.ThreadListSync.GetMembers()))
+		for _, m := range p.ThreadListSync.GetMembers() {
+			members = append(members, ThreadMember(m))
+		}
+		return &discordgo.ThreadListSync{
+			GuildID:    buf.GuildId,
+			ChannelIDs: p.ThreadListSync.GetChannelIds(),
+			Threads:    threads,
+			Members:    members,
+		}
+	case *proto.GatewayEvent_ThreadMemberUpdate:
+		return &discordgo.ThreadMemberUpdate{
+			ThreadMember: ThreadMember(p.ThreadMemberUpdate),
+			GuildID:      buf.GuildId,
+		}
+	case *proto.GatewayEvent_ThreadMembersUpdate:
+		added := make([]discordgo.AddedThreadMember, 0, len(p.ThreadMembersUpdate.GetAddedMembers()))
+		for _, m := range p.ThreadMembersUpdate.GetAddedMembers() {
+			if member := AddedThreadMember(m); member != nil {
+				added = append(added, *member)
+			}
+		}
+		return &discordgo.ThreadMembersUpdate{
+			ID:             p.ThreadMembersUpdate.GetThreadId(),
+			GuildID:        buf.GuildId,
+			MemberCount:    int(p.ThreadMembersUpdate.GetMemberCount()),
+			AddedMembers:   added,
+			RemovedMembers: p.ThreadMembersUpdate.GetRemovedMemberIds(),
+		}
+
+	case *proto.GatewayEvent_GuildRoleCreate:
+		return &discordgo.GuildRoleCreate{GuildRole: &discordgo.GuildRole{Role: Role(p.GuildRoleCreate), GuildID: buf.GuildId}}
+	case *proto.GatewayEvent_GuildRoleUpdate:
+		return &discordgo.GuildRoleUpdate{GuildRole: &discordgo.GuildRole{Role: Role(p.GuildRoleUpdate), GuildID: buf.GuildId}}
+	case *proto.GatewayEvent_GuildRoleDelete:
+		return &discordgo.GuildRoleDelete{RoleID: p.GuildRoleDelete, GuildID: buf.GuildId}
+	case *proto.GatewayEvent_GuildEmojisUpdate:
+		emojis := make([]*discordgo.Emoji, 0, len(p.GuildEmojisUpdate.GetEmojis()))
+		for _, e := range p.GuildEmojisUpdate.GetEmojis() {
+			emojis = append(emojis, Emoji(e))
+		}
+		return &discordgo.GuildEmojisUpdate{GuildID: buf.GuildId, Emojis: emojis}
+	case *proto.GatewayEvent_GuildIntegrationsUpdate:
+		return &discordgo.GuildIntegrationsUpdate{GuildID: buf.GuildId}
+	case *proto.GatewayEvent_WebhooksUpdate:
+		return &discordgo.WebhooksUpdate{GuildID: buf.GuildId, ChannelID: buf.ChannelId}
+	case *proto.GatewayEvent_UserUpdate:
+		return &discordgo.UserUpdate{User: User(p.UserUpdate)}
+
+	case *proto.GatewayEvent_PresenceUpdate:
+		update := &discordgo.PresenceUpdate{GuildID: buf.GuildId}
+		if presence := Presence(p.PresenceUpdate); presence != nil {
+			update.Presence = *presence
+		}
+		return update
+	case *proto.GatewayEvent_TypingStart:
+		return &discordgo.TypingStart{
+			UserID:    p.TypingStart.GetUserId(),
+			ChannelID: buf.ChannelId,
+			GuildID:   buf.GuildId,
+			Timestamp: int(p.TypingStart.GetTimestamp()),
+		}
+
+	case *proto.GatewayEvent_InviteCreate:
+		return &discordgo.InviteCreate{
+			Invite:    Invite(p.InviteCreate.GetInvite()),
+			ChannelID: buf.ChannelId,
+			GuildID:   buf.GuildId,
+		}
+	case *proto.GatewayEvent_InviteDelete:
+		return &discordgo.InviteDelete{
+			ChannelID: buf.ChannelId,
+			GuildID:   buf.GuildId,
+			Code:      p.InviteDelete.GetCode(),
+		}
+
+	case *proto.GatewayEvent_GuildScheduledEventCreate:
+		return &discordgo.GuildScheduledEventCreate{GuildScheduledEvent: GuildScheduledEvent(p.GuildScheduledEventCreate)}
+	case *proto.GatewayEvent_GuildScheduledEventUpdate:
+		return &discordgo.GuildScheduledEventUpdate{GuildScheduledEvent: GuildScheduledEvent(p.GuildScheduledEventUpdate)}
+	case *proto.GatewayEvent_GuildScheduledEventDelete:
+		return &discordgo.GuildScheduledEventDelete{GuildScheduledEvent: GuildScheduledEvent(p.GuildScheduledEventDelete)}
+	case *proto.GatewayEvent_GuildScheduledEventUserAdd:
+		return &discordgo.GuildScheduledEventUserAdd{
+			GuildScheduledEventID: p.GuildScheduledEventUserAdd.GetGuildScheduledEventId(),
+			UserID:                p.GuildScheduledEventUserAdd.GetUserId(),
+			GuildID:               buf.GuildId,
+		}
+	case *proto.GatewayEvent_GuildScheduledEventUserRemove:
+		return &discordgo.GuildScheduledEventUserRemove{
+			GuildScheduledEventID: p.GuildScheduledEventUserRemove.GetGuildScheduledEventId(),
+			UserID:                p.GuildScheduledEventUserRemove.GetUserId(),
+			GuildID:               buf.GuildId,
+		}
+
+	case *proto.GatewayEvent_StageInstanceCreate:
+		return &discordgo.StageInstanceEventCreate{StageInstance: StageInstance(p.StageInstanceCreate)}
+	case *proto.GatewayEvent_StageInstanceUpdate:
+		return &discordgo.StageInstanceEventUpdate{StageInstance: StageInstance(p.StageInstanceUpdate)}
+	case *proto.GatewayEvent_StageInstanceDelete:
+		return &discordgo.StageInstanceEventDelete{StageInstance: StageInstance(p.StageInstanceDelete)}
+
+	case *proto.GatewayEvent_AutoModerationRuleCreate:
+		return &discordgo.AutoModerationRuleCreate{AutoModerationRule: AutoModerationRule(p.AutoModerationRuleCreate)}
+	case *proto.GatewayEvent_AutoModerationRuleUpdate:
+		return &discordgo.AutoModerationRuleUpdate{AutoModerationRule: AutoModerationRule(p.AutoModerationRuleUpdate)}
+	case *proto.GatewayEvent_AutoModerationRuleDelete:
+		return &discordgo.AutoModerationRuleDelete{AutoModerationRule: AutoModerationRule(p.AutoModerationRuleDelete)}
+	case *proto.GatewayEvent_AutoModerationActionExecution:
+		e := p.AutoModerationActionExecution
+		execution := &discordgo.AutoModerationActionExecution{
+			GuildID:              buf.GuildId,
+			RuleID:               e.GetRuleId(),
+			RuleTriggerType:      discordgo.AutoModerationRuleTriggerType(e.GetRuleTriggerType()),
+			UserID:               e.GetUserId(),
+			ChannelID:            buf.ChannelId,
+			MessageID:            e.GetMessageId(),
+			AlertSystemMessageID: e.GetAlertSystemMessageId(),
+			Content:              e.GetContent(),
+			MatchedKeyword:       e.GetMatchedKeyword(),
+			MatchedContent:       e.GetMatchedContent(),
+		}
+		if action := AutoModerationAction(e.GetAction()); action != nil {
+			execution.Action = *action
+		}
+		return execution
+	}
+
+	// Raw, or a payload added to the proto after this module was built
+	return nil
+}
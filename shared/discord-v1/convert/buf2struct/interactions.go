@@ -41,7 +41,77 @@ func ApplicationCommand(buf *proto.ApplicationCommand) *discordgo.ApplicationCom
 		NSFW:                     buf.Nsfw,
 		Description:              buf.Description,
 		DescriptionLocalizations: &descriptionLocalizations,
-		Options:                  nil, // TODO(discord/bufstruct): implements Options
+		Options:                  ApplicationCommandOptions(buf.Options),
+	}
+}
+
+// ApplicationCommandOptions converts the options of a command (or a subcommand), recursively.
+func ApplicationCommandOptions(buf []*proto.ApplicationCommandOption) []*discordgo.ApplicationCommandOption {
+	if len(buf) == 0 {
+		return nil
+	}
+
+	options := make([]*discordgo.ApplicationCommandOption, 0, len(buf))
+	for _, option := range buf {
+		if option != nil {
+			options = append(options, ApplicationCommandOption(option))
+		}
+	}
+	return options
+}
+
+func ApplicationCommandOption(buf *proto.ApplicationCommandOption) *discordgo.ApplicationCommandOption {
+	if buf == nil {
+		return nil
+	}
+
+	var nameLocalizations map[discordgo.Locale]string
+	if len(buf.NameLocalizations) > 0 {
+		nameLocalizations = make(map[discordgo.Locale]string, len(buf.NameLocalizations))
+		for k, v := range buf.NameLocalizations {
+			nameLocalizations[util.StringToLocale(k)] = v
+		}
+	}
+
+	var descriptionLocalizations map[discordgo.Locale]string
+	if len(buf.DescriptionLocalizations) > 0 {
+		descriptionLocalizations = make(map[discordgo.Locale]string, len(buf.DescriptionLocalizations))
+		for k, v := range buf.DescriptionLocalizations {
+			descriptionLocalizations[util.StringToLocale(k)] = v
+		}
+	}
+
+	var channelTypes []discordgo.ChannelType
+	for _, channelType := range buf.ChannelTypes {
+		channelTypes = append(channelTypes, discordgo.ChannelType(channelType))
+	}
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, choice := range buf.Choices {
+		choices = append(choices, ApplicationCommandOptionChoice(choice))
+	}
+
+	var minLength *int
+	if buf.MinLength != nil {
+		v := int(*buf.MinLength)
+		minLength = &v
+	}
+
+	return &discordgo.ApplicationCommandOption{
+		Type:                     discordgo.ApplicationCommandOptionType(buf.Type),
+		Name:                     buf.Name,
+		NameLocalizations:        nameLocalizations,
+		Description:              buf.Description,
+		DescriptionLocalizations: descriptionLocalizations,
+		ChannelTypes:             channelTypes,
+		Required:                 buf.Required,
+		Options:                  ApplicationCommandOptions(buf.Options),
+		Autocomplete:             buf.Autocomplete,
+		Choices:                  choices,
+		MinValue:                 buf.MinValue,
+		MaxValue:                 buf.GetMaxValue(),
+		MinLength:                minLength,
+		MaxLength:                int(buf.GetMaxLength()),
 	}
 }
 
@@ -79,6 +149,9 @@ func Interaction(buf *proto.Interaction) *discordgo.Interaction {
 	case *proto.Interaction_ApplicationCommandData:
 		appCmdData := buf.Data.(*proto.Interaction_ApplicationCommandData).ApplicationCommandData
 		interaction.Data = *ApplicationCommandInteractionData(appCmdData)
+	case *proto.Interaction_AutocompleteData:
+		autocompleteData := buf.Data.(*proto.Interaction_AutocompleteData).AutocompleteData
+		interaction.Data = *ApplicationCommandInteractionData(autocompleteData)
 	case *proto.Interaction_MessageComponentData:
 		msgCompData := buf.Data.(*proto.Interaction_MessageComponentData).MessageComponentData
 		interaction.Data = *MessageComponentInteractionData(msgCompData)
@@ -97,12 +170,17 @@ func ApplicationCommandInteractionData(buf *proto.ApplicationCommandInteractionD
 		return nil
 	}
 
+	var options []*discordgo.ApplicationCommandInteractionDataOption
+	for _, v := range buf.Options {
+		options = append(options, ApplicationCommandInteractionDataOption(v))
+	}
+
 	return &discordgo.ApplicationCommandInteractionData{
 		ID:          buf.Id,
 		Name:        buf.Name,
 		CommandType: discordgo.ApplicationCommandType(buf.CommandType),
 		Resolved:    ApplicationCommandInteractionDataResolved(buf.Resolved),
-		Options:     nil,
+		Options:     options,
 		TargetID:    buf.TargetId,
 	}
 }
@@ -112,15 +190,38 @@ func ApplicationCommandInteractionDataOption(buf *proto.ApplicationCommandIntera
 		return nil
 	}
 
-	options := make([]*discordgo.ApplicationCommandInteractionDataOption, 0)
+	var options []*discordgo.ApplicationCommandInteractionDataOption
 	for _, v := range buf.Options {
 		options = append(options, ApplicationCommandInteractionDataOption(v))
 	}
 
+	// Values are restored in their JSON form, so that the accessors of discordgo (IntValue, etc.) work
+	var value interface{}
+	switch v := buf.Value.(type) {
+	case *proto.ApplicationCommandInteractionDataOption_StringValue:
+		value = v.StringValue
+	case *proto.ApplicationCommandInteractionDataOption_IntegerValue:
+		value = float64(v.IntegerValue)
+	case *proto.ApplicationCommandInteractionDataOption_NumberValue:
+		value = v.NumberValue
+	case *proto.ApplicationCommandInteractionDataOption_BooleanValue:
+		value = v.BooleanValue
+	case *proto.ApplicationCommandInteractionDataOption_UserValueId:
+		value = v.UserValueId
+	case *proto.ApplicationCommandInteractionDataOption_ChannelValueId:
+		value = v.ChannelValueId
+	case *proto.ApplicationCommandInteractionDataOption_RoleValueId:
+		value = v.RoleValueId
+	case *proto.ApplicationCommandInteractionDataOption_MentionableValueId:
+		value = v.MentionableValueId
+	case *proto.ApplicationCommandInteractionDataOption_AttachmentValueId:
+		value = v.AttachmentValueId
+	}
+
 	return &discordgo.ApplicationCommandInteractionDataOption{
 		Name:    buf.Name,
 		Type:    discordgo.ApplicationCommandOptionType(buf.Type),
-		Value:   buf.Value,
+		Value:   value,
 		Options: options,
 		Focused: buf.Focused,
 	}
@@ -212,7 +313,7 @@ func ModalSubmitInteractionData(buf *proto.ModalSubmitInteractionData) *discordg
 
 	return &discordgo.ModalSubmitInteractionData{
 		CustomID:   buf.CustomId,
-		Components: nil, // TODO(discord/bufstruct): implements Components
+		Components: MessageComponents(buf.Components),
 	}
 }
 
@@ -224,7 +325,86 @@ func InteractionResponse(buf *proto.InteractionResponse) *discordgo.InteractionR
 
 	return &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseType(buf.Type),
-		Data: nil, // TODO: implement InteractionResponseData conversion
+		Data: InteractionResponseData(buf.Data),
+	}
+}
+
+// InteractionResponseData converts proto InteractionResponseData to discordgo InteractionResponseData
+func InteractionResponseData(buf *proto.InteractionResponseData) *discordgo.InteractionResponseData {
+	if buf == nil {
+		return nil
+	}
+
+	var embeds []*discordgo.MessageEmbed
+	for _, embed := range buf.Embeds {
+		embeds = append(embeds, MessageEmbed(embed))
+	}
+
+	var files []*discordgo.File
+	for _, file := range buf.Files {
+		files = append(files, &discordgo.File{
+			Name:        file.Name,
+			ContentType: file.ContentType,
+		})
+	}
+
+	var attachments *[]*discordgo.MessageAttachment
+	if len(buf.Attachments) > 0 {
+		attachmentSlice := make([]*discordgo.MessageAttachment, 0, len(buf.Attachments))
+		for _, attachment := range buf.Attachments {
+			attachmentSlice = append(attachmentSlice, MessageAttachment(attachment))
+		}
+		attachments = &attachmentSlice
+	}
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, choice := range buf.Choices {
+		choices = append(choices, ApplicationCommandOptionChoice(choice))
+	}
+
+	return &discordgo.InteractionResponseData{
+		TTS:             buf.Tts,
+		Content:         buf.Content,
+		Components:      MessageComponents(buf.Components),
+		Embeds:          embeds,
+		AllowedMentions: MessageAllowedMentions(buf.AllowedMentions),
+		Files:           files,
+		Attachments:     attachments,
+		Flags:           discordgo.MessageFlags(buf.Flags),
+		Choices:         choices,
+		CustomID:        buf.CustomId,
+		Title:           buf.Title,
+	}
+}
+
+// ApplicationCommandOptionChoice converts proto ApplicationCommandOptionChoice to discordgo ApplicationCommandOptionChoice
+func ApplicationCommandOptionChoice(buf *proto.ApplicationCommandOptionChoice) *discordgo.ApplicationCommandOptionChoice {
+	if buf == nil {
+		return nil
+	}
+
+	var nameLocalizations map[discordgo.Locale]string
+	if buf.NameLocalizations != nil {
+		nameLocalizations = make(map[discordgo.Locale]string, len(buf.NameLocalizations))
+		for locale, name := range buf.NameLocalizations {
+			nameLocalizations[util.StringToLocale(locale)] = name
+		}
+	}
+
+	var value interface{}
+	switch v := buf.Value.(type) {
+	case *proto.ApplicationCommandOptionChoice_StringValue:
+		value = v.StringValue
+	case *proto.ApplicationCommandOptionChoice_IntegerValue:
+		value = v.IntegerValue
+	case *proto.ApplicationCommandOptionChoice_NumberValue:
+		value = v.NumberValue
+	}
+
+	return &discordgo.ApplicationCommandOptionChoice{
+		Name:              buf.Name,
+		NameLocalizations: nameLocalizations,
+		Value:             value,
 	}
 }
 
@@ -239,5 +419,42 @@ func WebhookEdit(buf *proto.WebhookEdit) *discordgo.WebhookEdit {
 	if buf.Content != nil {
 		edit.Content = buf.Content
 	}
+	if len(buf.Components) > 0 || buf.ClearComponents {
+		components := MessageComponents(buf.Components)
+		if components == nil {
+			components = []discordgo.MessageComponent{}
+		}
+		edit.Components = &components
+	}
 	return edit
 }
+
+// WebhookParams converts protobuf WebhookParams to discordgo WebhookParams
+func WebhookParams(buf *proto.WebhookParams) *discordgo.WebhookParams {
+	if buf == nil {
+		return nil
+	}
+
+	var embeds []*discordgo.MessageEmbed
+	for _, embed := range buf.Embeds {
+		embeds = append(embeds, MessageEmbed(embed))
+	}
+
+	var attachments []*discordgo.MessageAttachment
+	for _, attachment := range buf.Attachments {
+		attachments = append(attachments, MessageAttachment(attachment))
+	}
+
+	// Note: Files are opened by the Helper server (see runtime.UploadStore)
+	return &discordgo.WebhookParams{
+		Content:         buf.GetContent(),
+		Username:        buf.GetUsername(),
+		AvatarURL:       buf.GetAvatarUrl(),
+		TTS:             buf.GetTts(),
+		Components:      MessageComponents(buf.Components),
+		Embeds:          embeds,
+		Attachments:     attachments,
+		AllowedMentions: MessageAllowedMentions(buf.AllowedMentions),
+		ThreadName:      buf.GetThreadName(),
+	}
+}
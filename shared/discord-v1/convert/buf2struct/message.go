@@ -57,7 +57,7 @@ func Message(buf *proto.Message) *discordgo.Message {
 		MentionEveryone: buf.MentionEveryone,
 		Author:          User(buf.Author),
 		Attachments:     attachments,
-		Components:      nil, // TODO(discord/buf2struct): Support components
+		Components:      MessageComponents(buf.Components),
 		Embeds:          embeds,
 		Reactions:       nil,
 		Pinned:          buf.Pinned,
@@ -88,6 +88,24 @@ func MessageAttachment(buf *proto.MessageAttachment) *discordgo.MessageAttachmen
 	}
 }
 
+// embedType converts a proto.EmbedType to a discordgo.EmbedType.
+func embedType(t proto.EmbedType) discordgo.EmbedType {
+	switch t {
+	case proto.EmbedType_EMBED_TYPE_IMAGE:
+		return discordgo.EmbedTypeImage
+	case proto.EmbedType_EMBED_TYPE_VIDEO:
+		return discordgo.EmbedTypeVideo
+	case proto.EmbedType_EMBED_TYPE_GIFV:
+		return discordgo.EmbedTypeGifv
+	case proto.EmbedType_EMBED_TYPE_ARTICLE:
+		return discordgo.EmbedTypeArticle
+	case proto.EmbedType_EMBED_TYPE_LINK:
+		return discordgo.EmbedTypeLink
+	default:
+		return discordgo.EmbedTypeRich
+	}
+}
+
 func MessageEmbed(buf *proto.MessageEmbed) *discordgo.MessageEmbed {
 	if buf == nil {
 		return nil
@@ -100,7 +118,7 @@ func MessageEmbed(buf *proto.MessageEmbed) *discordgo.MessageEmbed {
 
 	return &discordgo.MessageEmbed{
 		URL:         buf.Url,
-		Type:        discordgo.EmbedType(buf.Type),
+		Type:        embedType(buf.Type),
 		Title:       buf.Title,
 		Description: buf.Description,
 		Timestamp:   buf.Timestamp,
@@ -213,6 +231,24 @@ func MessageReactions(buf *proto.MessageReactions) *discordgo.MessageReactions {
 	}
 }
 
+func MessageReaction(buf *proto.MessageReaction) *discordgo.MessageReaction {
+	if buf == nil {
+		return nil
+	}
+
+	reaction := &discordgo.MessageReaction{
+		UserID:    buf.UserId,
+		MessageID: buf.MessageId,
+		ChannelID: buf.ChannelId,
+		GuildID:   buf.GuildId,
+	}
+	if emoji := Emoji(buf.Emoji); emoji != nil {
+		reaction.Emoji = *emoji
+	}
+
+	return reaction
+}
+
 func MessageSend(buf *proto.MessageSend) *discordgo.MessageSend {
 	if buf == nil {
 		return nil
@@ -237,6 +273,7 @@ func MessageSend(buf *proto.MessageSend) *discordgo.MessageSend {
 		Content:    buf.Content,
 		Embeds:     embeds,
 		TTS:        buf.Tts,
+		Components: MessageComponents(buf.Components),
 		Files:      files,
 		StickerIDs: stickerIDs,
 		// Note: AllowedMentions and Reference need conversion functions
@@ -275,6 +312,15 @@ func MessageEdit(buf *proto.MessageEdit) *discordgo.MessageEdit {
 		attachments = &attachmentSlice
 	}
 
+	var components *[]discordgo.MessageComponent
+	if len(buf.Components) > 0 || buf.ClearComponents {
+		componentSlice := MessageComponents(buf.Components)
+		if componentSlice == nil {
+			componentSlice = []discordgo.MessageComponent{}
+		}
+		components = &componentSlice
+	}
+
 	var content *string
 	if buf.Content != "" {
 		content = &buf.Content
@@ -282,6 +328,7 @@ func MessageEdit(buf *proto.MessageEdit) *discordgo.MessageEdit {
 
 	return &discordgo.MessageEdit{
 		Content:     content,
+		Components:  components,
 		Embeds:      embeds,
 		Files:       files,
 		Attachments: attachments,
@@ -290,3 +337,29 @@ func MessageEdit(buf *proto.MessageEdit) *discordgo.MessageEdit {
 		// Note: AllowedMentions and Flags need proper handling
 	}
 }
+
+// MessageAllowedMentions converts protobuf MessageAllowedMentions to discordgo MessageAllowedMentions
+func MessageAllowedMentions(buf *proto.MessageAllowedMentions) *discordgo.MessageAllowedMentions {
+	if buf == nil {
+		return nil
+	}
+
+	var parse []discordgo.AllowedMentionType
+	for _, p := range buf.Parse {
+		switch p {
+		case proto.AllowedMentionType_ALLOWED_MENTION_TYPE_ROLES:
+			parse = append(parse, discordgo.AllowedMentionTypeRoles)
+		case proto.AllowedMentionType_ALLOWED_MENTION_TYPE_USERS:
+			parse = append(parse, discordgo.AllowedMentionTypeUsers)
+		case proto.AllowedMentionType_ALLOWED_MENTION_TYPE_EVERYONE:
+			parse = append(parse, discordgo.AllowedMentionTypeEveryone)
+		}
+	}
+
+	return &discordgo.MessageAllowedMentions{
+		Parse:       parse,
+		Roles:       buf.Roles,
+		Users:       buf.Users,
+		RepliedUser: buf.RepliedUser,
+	}
+}
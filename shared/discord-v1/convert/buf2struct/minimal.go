@@ -29,3 +29,147 @@ func GatewayBotResponse(buf *proto.GatewayBotResponse) *discordgo.GatewayBotResp
 		Shards: int(buf.Shards),
 	}
 }
+
+// GuildScheduledEvent converts proto.GuildScheduledEvent to discordgo.GuildScheduledEvent
+func GuildScheduledEvent(buf *proto.GuildScheduledEvent) *discordgo.GuildScheduledEvent {
+	if buf == nil {
+		return nil
+	}
+
+	event := &discordgo.GuildScheduledEvent{
+		ID:                 buf.Id,
+		GuildID:            buf.GuildId,
+		ChannelID:          buf.ChannelId,
+		CreatorID:          buf.CreatorId,
+		Name:               buf.Name,
+		Description:        buf.Description,
+		ScheduledStartTime: TimestampValue(buf.ScheduledStartTime),
+		ScheduledEndTime:   Timestamp(buf.ScheduledEndTime),
+		PrivacyLevel:       discordgo.GuildScheduledEventPrivacyLevel(buf.PrivacyLevel),
+		Status:             discordgo.GuildScheduledEventStatus(buf.Status),
+		EntityType:         discordgo.GuildScheduledEventEntityType(buf.EntityType),
+		EntityID:           buf.EntityId,
+		Creator:            User(buf.Creator),
+		UserCount:          int(buf.UserCount),
+		Image:              buf.Image,
+	}
+	if buf.EntityMetadata != nil {
+		event.EntityMetadata.Location = buf.EntityMetadata.Location
+	}
+
+	return event
+}
+
+// Invite converts proto.Invite to discordgo.Invite
+// Simplified version without the target application
+func Invite(buf *proto.Invite) *discordgo.Invite {
+	if buf == nil {
+		return nil
+	}
+
+	return &discordgo.Invite{
+		Guild:                    Guild(buf.Guild),
+		Channel:                  Channel(buf.Channel),
+		Inviter:                  User(buf.Inviter),
+		Code:                     buf.Code,
+		CreatedAt:                TimestampValue(buf.CreatedAt),
+		MaxAge:                   int(buf.MaxAge),
+		Uses:                     int(buf.Uses),
+		MaxUses:                  int(buf.MaxUses),
+		Revoked:                  buf.Revoked,
+		Temporary:                buf.Temporary,
+		Unique:                   buf.Unique,
+		TargetUser:               User(buf.TargetUser),
+		TargetType:               discordgo.InviteTargetType(buf.TargetType),
+		ApproximatePresenceCount: int(buf.ApproximatePresenceCount),
+		ApproximateMemberCount:   int(buf.ApproximateMemberCount),
+		ExpiresAt:                Timestamp(buf.ExpiresAt),
+	}
+}
+
+// AddedThreadMember converts proto.AddedThreadMember to discordgo.AddedThreadMember
+func AddedThreadMember(buf *proto.AddedThreadMember) *discordgo.AddedThreadMember {
+	if buf == nil {
+		return nil
+	}
+
+	return &discordgo.AddedThreadMember{
+		ThreadMember: ThreadMember(buf.ThreadMember),
+		Member:       Member(buf.Member),
+		Presence:     Presence(buf.Presence),
+	}
+}
+
+// AutoModerationRule converts proto.AutoModerationRule to discordgo.AutoModerationRule
+func AutoModerationRule(buf *proto.AutoModerationRule) *discordgo.AutoModerationRule {
+	if buf == nil {
+		return nil
+	}
+
+	actions := make([]discordgo.AutoModerationAction, len(buf.Actions))
+	for i, a := range buf.Actions {
+		if action := AutoModerationAction(a); action != nil {
+			actions[i] = *action
+		}
+	}
+
+	enabled := buf.Enabled
+	exemptRoles := buf.ExemptRoles
+	exemptChannels := buf.ExemptChannels
+
+	return &discordgo.AutoModerationRule{
+		ID:              buf.Id,
+		GuildID:         buf.GuildId,
+		Name:            buf.Name,
+		CreatorID:       buf.CreatorId,
+		EventType:       discordgo.AutoModerationRuleEventType(buf.EventType),
+		TriggerType:     discordgo.AutoModerationRuleTriggerType(buf.TriggerType),
+		TriggerMetadata: AutoModerationTriggerMetadata(buf.TriggerMetadata),
+		Actions:         actions,
+		Enabled:         &enabled,
+		ExemptRoles:     &exemptRoles,
+		ExemptChannels:  &exemptChannels,
+	}
+}
+
+// AutoModerationTriggerMetadata converts proto.AutoModerationTriggerMetadata to discordgo.AutoModerationTriggerMetadata
+func AutoModerationTriggerMetadata(buf *proto.AutoModerationTriggerMetadata) *discordgo.AutoModerationTriggerMetadata {
+	if buf == nil {
+		return nil
+	}
+
+	presets := make([]discordgo.AutoModerationKeywordPreset, len(buf.Presets))
+	for i, p := range buf.Presets {
+		presets[i] = discordgo.AutoModerationKeywordPreset(p)
+	}
+
+	metadata := &discordgo.AutoModerationTriggerMetadata{
+		KeywordFilter:     buf.KeywordFilter,
+		RegexPatterns:     buf.RegexPatterns,
+		Presets:           presets,
+		MentionTotalLimit: int(buf.MentionTotalLimit),
+	}
+	if len(buf.AllowList) > 0 {
+		metadata.AllowList = &buf.AllowList
+	}
+	return metadata
+}
+
+// AutoModerationAction converts proto.AutoModerationAction to discordgo.AutoModerationAction
+func AutoModerationAction(buf *proto.AutoModerationAction) *discordgo.AutoModerationAction {
+	if buf == nil {
+		return nil
+	}
+
+	action := &discordgo.AutoModerationAction{
+		Type: discordgo.AutoModerationActionType(buf.Type),
+	}
+	if buf.Metadata != nil {
+		action.Metadata = &discordgo.AutoModerationActionMetadata{
+			ChannelID: buf.Metadata.ChannelId,
+			Duration:  int(buf.Metadata.Duration),
+		}
+	}
+
+	return action
+}
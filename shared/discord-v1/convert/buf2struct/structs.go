@@ -14,6 +14,7 @@ func User(buf *proto.User) *discordgo.User {
 	return &discordgo.User{
 		ID:            buf.Id,
 		Username:      buf.Username,
+		GlobalName:    buf.GlobalName,
 		Discriminator: buf.Discriminator,
 		Avatar:        buf.Avatar,
 		Bot:           buf.Bot,
@@ -37,6 +38,7 @@ func Member(buf *proto.Member) *discordgo.Member {
 		Deaf:                       buf.Deaf,
 		Mute:                       buf.Mute,
 		Avatar:                     buf.Avatar,
+		User:                       User(buf.User),
 		Roles:                      buf.Roles,
 		PremiumSince:               util.PbTimestamp2AsTimePtr(buf.PremiumSince),
 		Flags:                      discordgo.MemberFlags(buf.Flags),
@@ -68,6 +70,11 @@ func Channel(buf *proto.Channel) *discordgo.Channel {
 
 	sortOrder := discordgo.ForumSortOrderType(buf.DefaultSortOrder)
 	defaultSortOrder := &sortOrder
+
+	var defaultReactionEmoji discordgo.ForumDefaultReaction
+	if buf.DefaultReactionEmoji != nil {
+		defaultReactionEmoji = *ForumDefaultReaction(buf.DefaultReactionEmoji)
+	}
 	return &discordgo.Channel{
 		ID:                            buf.Id,
 		GuildID:                       buf.GuildId,
@@ -96,7 +103,7 @@ func Channel(buf *proto.Channel) *discordgo.Channel {
 		Flags:                         discordgo.ChannelFlags(buf.Flags),
 		AvailableTags:                 availableTags,
 		AppliedTags:                   buf.AppliedTags,
-		DefaultReactionEmoji:          *ForumDefaultReaction(buf.DefaultReactionEmoji),
+		DefaultReactionEmoji:          defaultReactionEmoji,
 		DefaultThreadRateLimitPerUser: int(buf.DefaultThreadRateLimitPerUser),
 		DefaultSortOrder:              defaultSortOrder,
 		DefaultForumLayout:            discordgo.ForumLayout(buf.DefaultForumLayout),
@@ -182,7 +189,7 @@ func Emoji(buf *proto.Emoji) *discordgo.Emoji {
 		RequireColons: buf.RequireColons,
 		Managed:       buf.Managed,
 		Animated:      buf.Animated,
-		Available:     buf.Animated,
+		Available:     buf.Available,
 	}
 }
 
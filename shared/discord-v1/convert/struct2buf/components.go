@@ -1,8 +1,166 @@
 package struct2buf
 
-// TODO(discord/bufstruct): implements MessageComponent
-// func MessageComponent(s *discordgo.MessageComponent) *proto.MessageComponent {
-// 	if s == nil {
-// 		return nil
-// 	}
-// }
+import (
+	"github.com/bwmarrin/discordgo"
+	proto "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
+)
+
+// MessageComponent converts a component, built by value or unmarshaled as a pointer. It returns nil
+// for the components that the proto doesn't model.
+func MessageComponent(s discordgo.MessageComponent) *proto.MessageComponent {
+	switch c := s.(type) {
+	case nil:
+		return nil
+	case discordgo.ActionsRow:
+		return ActionsRow(&c)
+	case *discordgo.ActionsRow:
+		return ActionsRow(c)
+	case discordgo.Button:
+		return Button(&c)
+	case *discordgo.Button:
+		return Button(c)
+	case discordgo.SelectMenu:
+		return SelectMenu(&c)
+	case *discordgo.SelectMenu:
+		return SelectMenu(c)
+	case discordgo.TextInput:
+		return TextInput(&c)
+	case *discordgo.TextInput:
+		return TextInput(c)
+	default:
+		return nil
+	}
+}
+
+// MessageComponents converts a slice of components, skipping the nil ones.
+func MessageComponents(s []discordgo.MessageComponent) []*proto.MessageComponent {
+	if s == nil {
+		return nil
+	}
+
+	components := make([]*proto.MessageComponent, 0, len(s))
+	for _, component := range s {
+		if c := MessageComponent(component); c != nil {
+			components = append(components, c)
+		}
+	}
+	return components
+}
+
+func ActionsRow(s *discordgo.ActionsRow) *proto.MessageComponent {
+	if s == nil {
+		return nil
+	}
+
+	return &proto.MessageComponent{
+		Component: &proto.MessageComponent_ActionsRow{
+			ActionsRow: &proto.ActionsRow{
+				Components: MessageComponents(s.Components),
+			},
+		},
+	}
+}
+
+func Button(s *discordgo.Button) *proto.MessageComponent {
+	if s == nil {
+		return nil
+	}
+
+	return &proto.MessageComponent{
+		Component: &proto.MessageComponent_Button{
+			Button: &proto.Button{
+				Label:    s.Label,
+				Style:    proto.ButtonStyle(s.Style),
+				Disabled: s.Disabled,
+				Emoji:    ComponentEmoji(s.Emoji),
+				Url:      s.URL,
+				CustomId: s.CustomID,
+			},
+		},
+	}
+}
+
+func SelectMenu(s *discordgo.SelectMenu) *proto.MessageComponent {
+	if s == nil {
+		return nil
+	}
+
+	var minValues *int32
+	if s.MinValues != nil {
+		v := int32(*s.MinValues)
+		minValues = &v
+	}
+
+	defaultValues := make([]*proto.SelectMenuDefaultValue, 0, len(s.DefaultValues))
+	for _, value := range s.DefaultValues {
+		defaultValues = append(defaultValues, &proto.SelectMenuDefaultValue{
+			Id:   value.ID,
+			Type: string(value.Type),
+		})
+	}
+
+	options := make([]*proto.SelectMenuOption, 0, len(s.Options))
+	for _, option := range s.Options {
+		options = append(options, &proto.SelectMenuOption{
+			Label:       option.Label,
+			Value:       option.Value,
+			Description: option.Description,
+			Emoji:       ComponentEmoji(option.Emoji),
+			Default:     option.Default,
+		})
+	}
+
+	channelTypes := make([]proto.ChannelType, 0, len(s.ChannelTypes))
+	for _, channelType := range s.ChannelTypes {
+		channelTypes = append(channelTypes, proto.ChannelType(channelType))
+	}
+
+	return &proto.MessageComponent{
+		Component: &proto.MessageComponent_SelectMenu{
+			SelectMenu: &proto.SelectMenu{
+				MenuType:      proto.ComponentType(s.Type()), // A zero menu type is a string select menu
+				CustomId:      s.CustomID,
+				Placeholder:   s.Placeholder,
+				MinValues:     minValues,
+				MaxValues:     int32(s.MaxValues),
+				DefaultValues: defaultValues,
+				Options:       options,
+				Disabled:      s.Disabled,
+				ChannelTypes:  channelTypes,
+			},
+		},
+	}
+}
+
+func TextInput(s *discordgo.TextInput) *proto.MessageComponent {
+	if s == nil {
+		return nil
+	}
+
+	return &proto.MessageComponent{
+		Component: &proto.MessageComponent_TextInput{
+			TextInput: &proto.TextInput{
+				CustomId:    s.CustomID,
+				Label:       s.Label,
+				Style:       proto.TextInputStyle(s.Style),
+				Placeholder: s.Placeholder,
+				Value:       s.Value,
+				Required:    s.Required,
+				MinLength:   int32(s.MinLength),
+				MaxLength:   int32(s.MaxLength),
+			},
+		},
+	}
+}
+
+func ComponentEmoji(s *discordgo.ComponentEmoji) *proto.ComponentEmoji {
+	if s == nil {
+		return nil
+	}
+
+	return &proto.ComponentEmoji{
+		Name:     s.Name,
+		Id:       s.ID,
+		Animated: s.Animated,
+	}
+}
@@ -0,0 +1,223 @@
+package struct2buf_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/convert/buf2struct"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/convert/struct2buf"
+	pb "google.golang.org/protobuf/proto"
+
+	proto "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
+)
+
+func intPtr(v int) *int { return &v }
+
+// Components as a module builds them (by value)
+func testComponents() []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "Yes",
+					Style:    discordgo.SuccessButton,
+					CustomID: "vote:yes",
+					Emoji:    &discordgo.ComponentEmoji{Name: "👍"},
+				},
+				discordgo.Button{
+					Label:    "Docs",
+					Style:    discordgo.LinkButton,
+					URL:      "https://example.com",
+					Disabled: true,
+				},
+			},
+		},
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    "color",
+					Placeholder: "Pick a color",
+					MinValues:   intPtr(0),
+					MaxValues:   2,
+					Options: []discordgo.SelectMenuOption{
+						{Label: "Red", Value: "red", Description: "Warm", Default: true},
+						{Label: "Blue", Value: "blue", Emoji: &discordgo.ComponentEmoji{ID: "123", Name: "blue", Animated: true}},
+					},
+				},
+			},
+		},
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					MenuType:     discordgo.ChannelSelectMenu,
+					CustomID:     "channel",
+					ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText, discordgo.ChannelTypeGuildVoice},
+					DefaultValues: []discordgo.SelectMenuDefaultValue{
+						{ID: "456", Type: discordgo.SelectMenuDefaultValueChannel},
+					},
+				},
+			},
+		},
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.TextInput{
+					CustomID:    "feedback",
+					Label:       "Feedback",
+					Style:       discordgo.TextInputParagraph,
+					Placeholder: "Tell us",
+					Value:       "Great",
+					Required:    true,
+					MinLength:   1,
+					MaxLength:   200,
+				},
+			},
+		},
+	}
+}
+
+// The same components as unmarshaled by discordgo (pointers). buf2struct returns them this way.
+func testComponentPointers() []discordgo.MessageComponent {
+	var toPointers func([]discordgo.MessageComponent) []discordgo.MessageComponent
+	toPointers = func(components []discordgo.MessageComponent) []discordgo.MessageComponent {
+		result := make([]discordgo.MessageComponent, 0, len(components))
+		for _, component := range components {
+			switch c := component.(type) {
+			case discordgo.ActionsRow:
+				c.Components = toPointers(c.Components)
+				result = append(result, &c)
+			case discordgo.Button:
+				result = append(result, &c)
+			case discordgo.SelectMenu:
+				if c.MenuType == 0 {
+					c.MenuType = discordgo.StringSelectMenu
+				}
+				result = append(result, &c)
+			case discordgo.TextInput:
+				result = append(result, &c)
+			}
+		}
+		return result
+	}
+	return toPointers(testComponents())
+}
+
+// roundTrip converts components to protobuf, through the wire format, and back.
+func roundTrip(t *testing.T, components []discordgo.MessageComponent) []discordgo.MessageComponent {
+	t.Helper()
+
+	data, err := pb.Marshal(&proto.MessageSend{Components: struct2buf.MessageComponents(components)})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var send proto.MessageSend
+	if err := pb.Unmarshal(data, &send); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	return buf2struct.MessageComponents(send.Components)
+}
+
+func TestMessageComponentsRoundTrip(t *testing.T) {
+	want := testComponentPointers()
+
+	if got := roundTrip(t, testComponents()); !reflect.DeepEqual(got, want) {
+		t.Errorf("components built by value:\ngot  %#v\nwant %#v", got, want)
+	}
+	if got := roundTrip(t, testComponentPointers()); !reflect.DeepEqual(got, want) {
+		t.Errorf("components as pointers:\ngot  %#v\nwant %#v", got, want)
+	}
+}
+
+func TestMessageComponentsInMessages(t *testing.T) {
+	want := testComponentPointers()
+
+	send := buf2struct.MessageSend(struct2buf.MessageSend(&discordgo.MessageSend{Components: testComponents()}))
+	if !reflect.DeepEqual(send.Components, want) {
+		t.Errorf("MessageSend components: got %#v", send.Components)
+	}
+
+	components := testComponents()
+	edit := buf2struct.MessageEdit(struct2buf.MessageEdit(&discordgo.MessageEdit{Components: &components}))
+	if edit.Components == nil || !reflect.DeepEqual(*edit.Components, want) {
+		t.Errorf("MessageEdit components: got %#v", edit.Components)
+	}
+
+	message := buf2struct.Message(struct2buf.Message(&discordgo.Message{Components: testComponentPointers()}))
+	if !reflect.DeepEqual(message.Components, want) {
+		t.Errorf("Message components: got %#v", message.Components)
+	}
+
+	params := buf2struct.WebhookParams(struct2buf.WebhookParams(&discordgo.WebhookParams{Content: "hi", Components: testComponents()}))
+	if params.Content != "hi" || !reflect.DeepEqual(params.Components, want) {
+		t.Errorf("WebhookParams: got %#v", params)
+	}
+
+	webhookEdit := buf2struct.WebhookEdit(struct2buf.WebhookEdit(&discordgo.WebhookEdit{Components: &components}))
+	if webhookEdit.Components == nil || !reflect.DeepEqual(*webhookEdit.Components, want) {
+		t.Errorf("WebhookEdit components: got %#v", webhookEdit.Components)
+	}
+
+	modal := buf2struct.ModalSubmitInteractionData(struct2buf.ModalSubmitInteractionData(&discordgo.ModalSubmitInteractionData{CustomID: "modal", Components: testComponents()}))
+	if !reflect.DeepEqual(modal.Components, want) {
+		t.Errorf("ModalSubmitInteractionData components: got %#v", modal.Components)
+	}
+}
+
+func TestMessageComponentNil(t *testing.T) {
+	if c := struct2buf.MessageComponent(nil); c != nil {
+		t.Errorf("nil component converted to %v", c)
+	}
+	if c := struct2buf.MessageComponent((*discordgo.Button)(nil)); c != nil {
+		t.Errorf("nil button converted to %v", c)
+	}
+	if c := buf2struct.MessageComponent(&proto.MessageComponent{}); c != nil {
+		t.Errorf("empty component converted to %#v", c)
+	}
+
+	// Components that the proto doesn't model are skipped
+	components := []discordgo.MessageComponent{unknownComponent{}, discordgo.Button{CustomID: "ok"}}
+	if got := struct2buf.MessageComponents(components); len(got) != 1 || got[0].GetButton() == nil {
+		t.Errorf("got %v with an unknown component", got)
+	}
+}
+
+// unknownComponent is a component type that the proto doesn't model.
+type unknownComponent struct{}
+
+func (unknownComponent) Type() discordgo.ComponentType { return 99 }
+
+func (unknownComponent) MarshalJSON() ([]byte, error) { return []byte(`{"type":99}`), nil }
+
+// wire sends m through the wire format.
+func wire[T pb.Message](t *testing.T, m T, into T) T {
+	t.Helper()
+
+	data, err := pb.Marshal(m)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if err := pb.Unmarshal(data, into); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	return into
+}
+
+func TestClearComponents(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		components *[]discordgo.MessageComponent
+	}{
+		{name: "unchanged"},
+		{name: "cleared", components: &[]discordgo.MessageComponent{}},
+	} {
+		edit := buf2struct.MessageEdit(wire(t, struct2buf.MessageEdit(&discordgo.MessageEdit{Components: test.components}), &proto.MessageEdit{}))
+		if !reflect.DeepEqual(edit.Components, test.components) {
+			t.Errorf("MessageEdit %s: got components %#v", test.name, edit.Components)
+		}
+
+		webhookEdit := buf2struct.WebhookEdit(wire(t, struct2buf.WebhookEdit(&discordgo.WebhookEdit{Components: test.components}), &proto.WebhookEdit{}))
+		if !reflect.DeepEqual(webhookEdit.Components, test.components) {
+			t.Errorf("WebhookEdit %s: got components %#v", test.name, webhookEdit.Components)
+		}
+	}
+}
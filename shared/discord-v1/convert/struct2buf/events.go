@@ -0,0 +1,321 @@
+package struct2buf
+
+import (
+	"encoding/json"
+
+	"github.com/bwmarrin/discordgo"
+	proto_common "github.com/thirdscam/chatanium-flexmodule/proto"
+	proto "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
+)
+
+// GatewayEvent converts a discordgo event to proto.GatewayEvent, from its typed Struct
+// (e.g. *discordgo.ChannelCreate) when the proto models it, otherwise as raw with its JSON payload.
+func GatewayEvent(e *discordgo.Event) *proto.GatewayEvent {
+	if e == nil {
+		return nil
+	}
+	if buf := gatewayEvent(e.Struct); buf != nil {
+		return buf
+	}
+	return rawGatewayEvent(e)
+}
+
+// gatewayEvent converts a typed discordgo event, or returns nil if the proto doesn't model it.
+func gatewayEvent(event interface{}) *proto.GatewayEvent {
+	switch e := event.(type) {
+	case *discordgo.ChannelCreate:
+		buf := channelEnvelope("CHANNEL_CREATE", e.Channel)
+		buf.Payload = &proto.GatewayEvent_ChannelCreate{ChannelCreate: Channel(e.Channel)}
+		return buf
+	case *discordgo.ChannelUpdate:
+		buf := channelEnvelope("CHANNEL_UPDATE", e.Channel)
+		buf.Payload = &proto.GatewayEvent_ChannelUpdate{ChannelUpdate: Channel(e.Channel)}
+		return buf
+	case *discordgo.ChannelDelete:
+		buf := channelEnvelope("CHANNEL_DELETE", e.Channel)
+		buf.Payload = &proto.GatewayEvent_ChannelDelete{ChannelDelete: Channel(e.Channel)}
+		return buf
+	case *discordgo.ChannelPinsUpdate:
+		return &proto.GatewayEvent{
+			Type:      "CHANNEL_PINS_UPDATE",
+			GuildId:   e.GuildID,
+			ChannelId: e.ChannelID,
+			Payload: &proto.GatewayEvent_ChannelPinsUpdate{ChannelPinsUpdate: &proto.ChannelPinsUpdateEvent{
+				LastPinTimestamp: e.LastPinTimestamp,
+			}},
+		}
+
+	case *discordgo.ThreadCreate:
+		buf := channelEnvelope("THREAD_CREATE", e.Channel)
+		buf.Payload = &proto.GatewayEvent_ThreadCreate{ThreadCreate: &proto.ThreadCreateEvent{
+			Thread:       Channel(e.Channel),
+			NewlyCreated: e.NewlyCreated,
+		}}
+		return buf
+	case *discordgo.ThreadUpdate:
+		buf := channelEnvelope("THREAD_UPDATE", e.Channel)
+		buf.Payload = &proto.GatewayEvent_ThreadUpdate{ThreadUpdate: &proto.ThreadUpdateEvent{
+			Thread: Channel(e.Channel),
+			Before: Channel(e.BeforeUpdate),
+		}}
+		return buf
+	case *discordgo.ThreadDelete:
+		buf := channelEnvelope("THREAD_DELETE", e.Channel)
+		buf.Payload = &proto.GatewayEvent_ThreadDelete{ThreadDelete: Channel(e.Channel)}
+		return buf
+	case *discordgo.ThreadListSync:
+		threads := make([]*proto.Channel, 0, len(e.Threads))
+		for _, t := range e.Threads {
+			threads = append(threads, Channel(t))
+		}
+		members := make([]*proto.ThreadMember, 0, len(e.Members))
+		for _, m := range e.Members {
+			members = append(members, ThreadMember(m))
+		}
+		return &proto.GatewayEvent{
+			Type:    "THREAD_LIST_SYNC",
+			GuildId: e.GuildID,
+			Payload: &proto.GatewayEvent_ThreadListSync{ThreadListSync: &proto.ThreadListSyncEvent{
+				ChannelIds: e.ChannelIDs,
+				Threads:    threads,
+				Members:    members,
+			}},
+		}
+	case *discordgo.ThreadMemberUpdate:
+		buf := &proto.GatewayEvent{
+			Type:    "THREAD_MEMBER_UPDATE",
+			GuildId: e.GuildID,
+			Payload: &proto.GatewayEvent_ThreadMemberUpdate{ThreadMemberUpdate: ThreadMember(e.ThreadMember)},
+		}
+		if e.ThreadMember != nil {
+			buf.ChannelId = e.ThreadMember.ID
+		}
+		return buf
+	case *discordgo.ThreadMembersUpdate:
+		added := make([]*proto.AddedThreadMember, 0, len(e.AddedMembers))
+		for i := range e.AddedMembers {
+			added = append(added, AddedThreadMember(&e.AddedMembers[i]))
+		}
+		return &proto.GatewayEvent{
+			Type:      "THREAD_MEMBERS_UPDATE",
+			GuildId:   e.GuildID,
+			ChannelId: e.ID,
+			Payload: &proto.GatewayEvent_ThreadMembersUpdate{ThreadMembersUpdate: &proto.ThreadMembersUpdateEvent{
+				ThreadId:         e.ID,
+				MemberCount:      int32(e.MemberCount),
+				AddedMembers:     added,
+				RemovedMemberIds: e.RemovedMembers,
+			}},
+		}
+
+	case *discordgo.GuildRoleCreate:
+		if e.GuildRole == nil {
+			return nil
+		}
+		return &proto.GatewayEvent{
+			Type:    "GUILD_ROLE_CREATE",
+			GuildId: e.GuildID,
+			Payload: &proto.GatewayEvent_GuildRoleCreate{GuildRoleCreate: Role(e.Role)},
+		}
+	case *discordgo.GuildRoleUpdate:
+		if e.GuildRole == nil {
+			return nil
+		}
+		return &proto.GatewayEvent{
+			Type:    "GUILD_ROLE_UPDATE",
+			GuildId: e.GuildID,
+			Payload: &proto.GatewayEvent_GuildRoleUpdate{GuildRoleUpdate: Role(e.Role)},
+		}
+	case *discordgo.GuildRoleDelete:
+		return &proto.GatewayEvent{
+			Type:    "GUILD_ROLE_DELETE",
+			GuildId: e.GuildID,
+			Payload: &proto.GatewayEvent_GuildRoleDelete{GuildRoleDelete: e.RoleID},
+		}
+	case *discordgo.GuildEmojisUpdate:
+		emojis := make([]*proto.Emoji, 0, len(e.Emojis))
+		for _, emoji := range e.Emojis {
+			emojis = append(emojis, Emoji(emoji))
+		}
+		return &proto.GatewayEvent{
+			Type:    "GUILD_EMOJIS_UPDATE",
+			GuildId: e.GuildID,
+			Payload: &proto.GatewayEvent_GuildEmojisUpdate{GuildEmojisUpdate: &proto.GuildEmojisUpdateEvent{Emojis: emojis}},
+		}
+	case *discordgo.GuildIntegrationsUpdate:
+		return &proto.GatewayEvent{
+			Type:    "GUILD_INTEGRATIONS_UPDATE",
+			GuildId: e.GuildID,
+			Payload: &proto.GatewayEvent_GuildIntegrationsUpdate{GuildIntegrationsUpdate: &proto_common.Empty{}},
+		}
+	case *discordgo.WebhooksUpdate:
+		return &proto.GatewayEvent{
+			Type:      "WEBHOOKS_UPDATE",
+			GuildId:   e.GuildID,
+			ChannelId: e.ChannelID,
+			Payload:   &proto.GatewayEvent_WebhooksUpdate{WebhooksUpdate: &proto_common.Empty{}},
+		}
+	case *discordgo.UserUpdate:
+		return &proto.GatewayEvent{
+			Type:    "USER_UPDATE",
+			Payload: &proto.GatewayEvent_UserUpdate{UserUpdate: User(e.User)},
+		}
+
+	case *discordgo.PresenceUpdate:
+		return &proto.GatewayEvent{
+			Type:    "PRESENCE_UPDATE",
+			GuildId: e.GuildID,
+			Payload: &proto.GatewayEvent_PresenceUpdate{PresenceUpdate: Presence(&e.Presence)},
+		}
+	case *discordgo.TypingStart:
+		return &proto.GatewayEvent{
+			Type:      "TYPING_START",
+			GuildId:   e.GuildID,
+			ChannelId: e.ChannelID,
+			Payload: &proto.GatewayEvent_TypingStart{TypingStart: &proto.TypingStartEvent{
+				UserId:    e.UserID,
+				Timestamp: int64(e.Timestamp),
+			}},
+		}
+
+	case *discordgo.InviteCreate:
+		return &proto.GatewayEvent{
+			Type:      "INVITE_CREATE",
+			GuildId:   e.GuildID,
+			ChannelId: e.ChannelID,
+			Payload:   &proto.GatewayEvent_InviteCreate{InviteCreate: &proto.InviteCreateEvent{Invite: Invite(e.Invite)}},
+		}
+	case *discordgo.InviteDelete:
+		return &proto.GatewayEvent{
+			Type:      "INVITE_DELETE",
+			GuildId:   e.GuildID,
+			ChannelId: e.ChannelID,
+			Payload:   &proto.GatewayEvent_InviteDelete{InviteDelete: &proto.InviteDeleteEvent{Code: e.Code}},
+		}
+
+	case *discordgo.GuildScheduledEventCreate:
+		buf := scheduledEventEnvelope("GUILD_SCHEDULED_EVENT_CREATE", e.GuildScheduledEvent)
+		buf.Payload = &proto.GatewayEvent_GuildScheduledEventCreate{GuildScheduledEventCreate: GuildScheduledEvent(e.GuildScheduledEvent)}
+		return buf
+	case *discordgo.GuildScheduledEventUpdate:
+		buf := scheduledEventEnvelope("GUILD_SCHEDULED_EVENT_UPDATE", e.GuildScheduledEvent)
+		buf.Payload = &proto.GatewayEvent_GuildScheduledEventUpdate{GuildScheduledEventUpdate: GuildScheduledEvent(e.GuildScheduledEvent)}
+		return buf
+	case *discordgo.GuildScheduledEventDelete:
+		buf := scheduledEventEnvelope("GUILD_SCHEDULED_EVENT_DELETE", e.GuildScheduledEvent)
+		buf.Payload = &proto.GatewayEvent_GuildScheduledEventDelete{GuildScheduledEventDelete: GuildScheduledEvent(e.GuildScheduledEvent)}
+		return buf
+	case *discordgo.GuildScheduledEventUserAdd:
+		return &proto.GatewayEvent{
+			Type:    "GUILD_SCHEDULED_EVENT_USER_ADD",
+			GuildId: e.GuildID,
+			Payload: &proto.GatewayEvent_GuildScheduledEventUserAdd{GuildScheduledEventUserAdd: &proto.GuildScheduledEventUserEvent{
+				GuildScheduledEventId: e.GuildScheduledEventID,
+				UserId:                e.UserID,
+			}},
+		}
+	case *discordgo.GuildScheduledEventUserRemove:
+		return &proto.GatewayEvent{
+			Type:    "GUILD_SCHEDULED_EVENT_USER_REMOVE",
+			GuildId: e.GuildID,
+			Payload: &proto.GatewayEvent_GuildScheduledEventUserRemove{GuildScheduledEventUserRemove: &proto.GuildScheduledEventUserEvent{
+				GuildScheduledEventId: e.GuildScheduledEventID,
+				UserId:                e.UserID,
+			}},
+		}
+
+	case *discordgo.StageInstanceEventCreate:
+		buf := stageInstanceEnvelope("STAGE_INSTANCE_CREATE", e.StageInstance)
+		buf.Payload = &proto.GatewayEvent_StageInstanceCreate{StageInstanceCreate: StageInstance(e.StageInstance)}
+		return buf
+	case *discordgo.StageInstanceEventUpdate:
+		buf := stageInstanceEnvelope("STAGE_INSTANCE_UPDATE", e.StageInstance)
+		buf.Payload = &proto.GatewayEvent_StageInstanceUpdate{StageInstanceUpdate: StageInstance(e.StageInstance)}
+		return buf
+	case *discordgo.StageInstanceEventDelete:
+		buf := stageInstanceEnvelope("STAGE_INSTANCE_DELETE", e.StageInstance)
+		buf.Payload = &proto.GatewayEvent_StageInstanceDelete{StageInstanceDelete: StageInstance(e.StageInstance)}
+		return buf
+
+	case *discordgo.AutoModerationRuleCreate:
+		buf := autoModerationRuleEnvelope("AUTO_MODERATION_RULE_CREATE", e.AutoModerationRule)
+		buf.Payload = &proto.GatewayEvent_AutoModerationRuleCreate{AutoModerationRuleCreate: AutoModerationRule(e.AutoModerationRule)}
+		return buf
+	case *discordgo.AutoModerationRuleUpdate:
+		buf := autoModerationRuleEnvelope("AUTO_MODERATION_RULE_UPDATE", e.AutoModerationRule)
+		buf.Payload = &proto.GatewayEvent_AutoModerationRuleUpdate{AutoModerationRuleUpdate: AutoModerationRule(e.AutoModerationRule)}
+		return buf
+	case *discordgo.AutoModerationRuleDelete:
+		buf := autoModerationRuleEnvelope("AUTO_MODERATION_RULE_DELETE", e.AutoModerationRule)
+		buf.Payload = &proto.GatewayEvent_AutoModerationRuleDelete{AutoModerationRuleDelete: AutoModerationRule(e.AutoModerationRule)}
+		return buf
+	case *discordgo.AutoModerationActionExecution:
+		return &proto.GatewayEvent{
+			Type:      "AUTO_MODERATION_ACTION_EXECUTION",
+			GuildId:   e.GuildID,
+			ChannelId: e.ChannelID,
+			Payload: &proto.GatewayEvent_AutoModerationActionExecution{AutoModerationActionExecution: &proto.AutoModerationActionExecutionEvent{
+				Action:               AutoModerationAction(&e.Action),
+				RuleId:               e.RuleID,
+				RuleTriggerType:      int32(e.RuleTriggerType),
+				UserId:               e.UserID,
+				MessageId:            e.MessageID,
+				AlertSystemMessageId: e.AlertSystemMessageID,
+				Content:              e.Content,
+				MatchedKeyword:       e.MatchedKeyword,
+				MatchedContent:       e.MatchedContent,
+			}},
+		}
+	}
+
+	return nil
+}
+
+// The envelope helpers below read the guild and channel of an event whose payload may be nil.
+
+func channelEnvelope(eventType string, c *discordgo.Channel) *proto.GatewayEvent {
+	buf := &proto.GatewayEvent{Type: eventType}
+	if c != nil {
+		buf.GuildId = c.GuildID
+		buf.ChannelId = c.ID
+	}
+	return buf
+}
+
+func scheduledEventEnvelope(eventType string, s *discordgo.GuildScheduledEvent) *proto.GatewayEvent {
+	if s == nil {
+		return &proto.GatewayEvent{Type: eventType}
+	}
+	return &proto.GatewayEvent{Type: eventType, GuildId: s.GuildID, ChannelId: s.ChannelID}
+}
+
+func stageInstanceEnvelope(eventType string, s *discordgo.StageInstance) *proto.GatewayEvent {
+	if s == nil {
+		return &proto.GatewayEvent{Type: eventType}
+	}
+	return &proto.GatewayEvent{Type: eventType, GuildId: s.GuildID, ChannelId: s.ChannelID}
+}
+
+func autoModerationRuleEnvelope(eventType string, r *discordgo.AutoModerationRule) *proto.GatewayEvent {
+	if r == nil {
+		return &proto.GatewayEvent{Type: eventType}
+	}
+	return &proto.GatewayEvent{Type: eventType, GuildId: r.GuildID}
+}
+
+// rawGatewayEvent sends an event that isn't modeled as JSON.
+// The guild and channel are read from the payload when it has them.
+func rawGatewayEvent(e *discordgo.Event) *proto.GatewayEvent {
+	var ids struct {
+		GuildID   string `json:"guild_id"`
+		ChannelID string `json:"channel_id"`
+	}
+	json.Unmarshal(e.RawData, &ids)
+
+	return &proto.GatewayEvent{
+		Type:      e.Type,
+		GuildId:   ids.GuildID,
+		ChannelId: ids.ChannelID,
+		Payload:   &proto.GatewayEvent_Raw{Raw: e.RawData},
+	}
+}
@@ -0,0 +1,311 @@
+package struct2buf_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/convert/buf2struct"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/convert/struct2buf"
+	pb "google.golang.org/protobuf/proto"
+
+	proto "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
+)
+
+// The proto doesn't tell an unset sort order apart, so the channels have one.
+var sortOrder = discordgo.ForumSortOrderCreationDate
+
+func testChannel(id string) *discordgo.Channel {
+	return &discordgo.Channel{
+		ID:               id,
+		GuildID:          "1",
+		Name:             "general",
+		Topic:            "Talk here",
+		Type:             discordgo.ChannelTypeGuildText,
+		Position:         2,
+		ParentID:         "3",
+		NSFW:             true,
+		DefaultSortOrder: &sortOrder,
+		PermissionOverwrites: []*discordgo.PermissionOverwrite{
+			{ID: "30", Type: discordgo.PermissionOverwriteTypeRole, Deny: discordgo.PermissionSendMessages},
+		},
+	}
+}
+
+func testThread(id string) *discordgo.Channel {
+	return &discordgo.Channel{
+		ID:               id,
+		GuildID:          "1",
+		Name:             "thread",
+		Type:             discordgo.ChannelTypeGuildPublicThread,
+		ParentID:         "2",
+		OwnerID:          "4",
+		DefaultSortOrder: &sortOrder,
+	}
+}
+
+func testThreadMember() *discordgo.ThreadMember {
+	return &discordgo.ThreadMember{
+		ID:            "20",
+		UserID:        "4",
+		JoinTimestamp: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Flags:         1,
+	}
+}
+
+func testRole() *discordgo.Role {
+	return &discordgo.Role{
+		ID:          "30",
+		Name:        "Moderator",
+		Color:       0xff0000,
+		Hoist:       true,
+		Position:    3,
+		Permissions: discordgo.PermissionManageMessages,
+		Mentionable: true,
+	}
+}
+
+func testScheduledEvent() *discordgo.GuildScheduledEvent {
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	return &discordgo.GuildScheduledEvent{
+		ID:                 "60",
+		GuildID:            "1",
+		ChannelID:          "2",
+		CreatorID:          "4",
+		Name:               "Movie night",
+		Description:        "Popcorn",
+		ScheduledStartTime: start,
+		PrivacyLevel:       discordgo.GuildScheduledEventPrivacyLevelGuildOnly,
+		Status:             discordgo.GuildScheduledEventStatusScheduled,
+		EntityType:         discordgo.GuildScheduledEventEntityTypeVoice,
+	}
+}
+
+func testStageInstance() *discordgo.StageInstance {
+	return &discordgo.StageInstance{
+		ID:                    "70",
+		GuildID:               "1",
+		ChannelID:             "2",
+		Topic:                 "Town hall",
+		PrivacyLevel:          discordgo.StageInstancePrivacyLevelGuildOnly,
+		GuildScheduledEventID: "60",
+	}
+}
+
+func testAutoModerationRule() *discordgo.AutoModerationRule {
+	enabled := true
+	return &discordgo.AutoModerationRule{
+		ID:          "80",
+		GuildID:     "1",
+		Name:        "No spam",
+		CreatorID:   "4",
+		EventType:   discordgo.AutoModerationEventMessageSend,
+		TriggerType: discordgo.AutoModerationEventTriggerKeyword,
+		TriggerMetadata: &discordgo.AutoModerationTriggerMetadata{
+			KeywordFilter: []string{"spam"},
+		},
+		Actions: []discordgo.AutoModerationAction{
+			{Type: discordgo.AutoModerationRuleActionBlockMessage},
+		},
+		Enabled:        &enabled,
+		ExemptRoles:    &[]string{"30"},
+		ExemptChannels: &[]string{"2"},
+	}
+}
+
+// testEvents are the typed events that the proto models, by gateway event type.
+func testEvents() map[string]interface{} {
+	return map[string]interface{}{
+		"CHANNEL_CREATE":      &discordgo.ChannelCreate{Channel: testChannel("2")},
+		"CHANNEL_UPDATE":      &discordgo.ChannelUpdate{Channel: testChannel("2")},
+		"CHANNEL_DELETE":      &discordgo.ChannelDelete{Channel: testChannel("2")},
+		"CHANNEL_PINS_UPDATE": &discordgo.ChannelPinsUpdate{LastPinTimestamp: "2024-01-02T03:04:05Z", ChannelID: "2", GuildID: "1"},
+
+		"THREAD_CREATE": &discordgo.ThreadCreate{Channel: testThread("20"), NewlyCreated: true},
+		"THREAD_UPDATE": &discordgo.ThreadUpdate{Channel: testThread("20"), BeforeUpdate: testThread("20")},
+		"THREAD_DELETE": &discordgo.ThreadDelete{Channel: testThread("20")},
+		"THREAD_LIST_SYNC": &discordgo.ThreadListSync{
+			GuildID:    "1",
+			ChannelIDs: []string{"2"},
+			Threads:    []*discordgo.Channel{testThread("20"), testThread("21")},
+			Members:    []*discordgo.ThreadMember{testThreadMember()},
+		},
+		"THREAD_MEMBER_UPDATE": &discordgo.ThreadMemberUpdate{ThreadMember: testThreadMember(), GuildID: "1"},
+		"THREAD_MEMBERS_UPDATE": &discordgo.ThreadMembersUpdate{
+			ID:             "20",
+			GuildID:        "1",
+			MemberCount:    3,
+			AddedMembers:   []discordgo.AddedThreadMember{{ThreadMember: testThreadMember()}},
+			RemovedMembers: []string{"5"},
+		},
+
+		"GUILD_ROLE_CREATE":         &discordgo.GuildRoleCreate{GuildRole: &discordgo.GuildRole{Role: testRole(), GuildID: "1"}},
+		"GUILD_ROLE_UPDATE":         &discordgo.GuildRoleUpdate{GuildRole: &discordgo.GuildRole{Role: testRole(), GuildID: "1"}},
+		"GUILD_ROLE_DELETE":         &discordgo.GuildRoleDelete{RoleID: "30", GuildID: "1"},
+		"GUILD_EMOJIS_UPDATE":       &discordgo.GuildEmojisUpdate{GuildID: "1", Emojis: []*discordgo.Emoji{{ID: "40", Name: "blob", Animated: true, Available: true}}},
+		"GUILD_INTEGRATIONS_UPDATE": &discordgo.GuildIntegrationsUpdate{GuildID: "1"},
+		"WEBHOOKS_UPDATE":           &discordgo.WebhooksUpdate{GuildID: "1", ChannelID: "2"},
+		"USER_UPDATE":               &discordgo.UserUpdate{User: &discordgo.User{ID: "4", Username: "alice", GlobalName: "Alice", Bot: true}},
+
+		"PRESENCE_UPDATE": &discordgo.PresenceUpdate{
+			GuildID: "1",
+			Presence: discordgo.Presence{
+				User:   &discordgo.User{ID: "4"},
+				Status: discordgo.StatusOnline,
+				Activities: []*discordgo.Activity{
+					{Name: "Go", Type: discordgo.ActivityTypeGame},
+				},
+				ClientStatus: discordgo.ClientStatus{Desktop: discordgo.StatusOnline},
+			},
+		},
+		"TYPING_START": &discordgo.TypingStart{UserID: "4", ChannelID: "2", GuildID: "1", Timestamp: 1704164645},
+
+		"INVITE_CREATE": &discordgo.InviteCreate{
+			Invite:    &discordgo.Invite{Code: "abc", MaxAge: 3600, MaxUses: 5, Temporary: true, Inviter: &discordgo.User{ID: "4"}},
+			ChannelID: "2",
+			GuildID:   "1",
+		},
+		"INVITE_DELETE": &discordgo.InviteDelete{ChannelID: "2", GuildID: "1", Code: "abc"},
+
+		"GUILD_SCHEDULED_EVENT_CREATE":      &discordgo.GuildScheduledEventCreate{GuildScheduledEvent: testScheduledEvent()},
+		"GUILD_SCHEDULED_EVENT_UPDATE":      &discordgo.GuildScheduledEventUpdate{GuildScheduledEvent: testScheduledEvent()},
+		"GUILD_SCHEDULED_EVENT_DELETE":      &discordgo.GuildScheduledEventDelete{GuildScheduledEvent: testScheduledEvent()},
+		"GUILD_SCHEDULED_EVENT_USER_ADD":    &discordgo.GuildScheduledEventUserAdd{GuildScheduledEventID: "60", UserID: "4", GuildID: "1"},
+		"GUILD_SCHEDULED_EVENT_USER_REMOVE": &discordgo.GuildScheduledEventUserRemove{GuildScheduledEventID: "60", UserID: "4", GuildID: "1"},
+
+		"STAGE_INSTANCE_CREATE": &discordgo.StageInstanceEventCreate{StageInstance: testStageInstance()},
+		"STAGE_INSTANCE_UPDATE": &discordgo.StageInstanceEventUpdate{StageInstance: testStageInstance()},
+		"STAGE_INSTANCE_DELETE": &discordgo.StageInstanceEventDelete{StageInstance: testStageInstance()},
+
+		"AUTO_MODERATION_RULE_CREATE": &discordgo.AutoModerationRuleCreate{AutoModerationRule: testAutoModerationRule()},
+		"AUTO_MODERATION_RULE_UPDATE": &discordgo.AutoModerationRuleUpdate{AutoModerationRule: testAutoModerationRule()},
+		"AUTO_MODERATION_RULE_DELETE": &discordgo.AutoModerationRuleDelete{AutoModerationRule: testAutoModerationRule()},
+		"AUTO_MODERATION_ACTION_EXECUTION": &discordgo.AutoModerationActionExecution{
+			GuildID: "1",
+			Action: discordgo.AutoModerationAction{
+				Type:     discordgo.AutoModerationRuleActionTimeout,
+				Metadata: &discordgo.AutoModerationActionMetadata{Duration: 60},
+			},
+			RuleID:               "80",
+			RuleTriggerType:      discordgo.AutoModerationEventTriggerKeyword,
+			UserID:               "4",
+			ChannelID:            "2",
+			MessageID:            "90",
+			AlertSystemMessageID: "91",
+			Content:              "spam",
+			MatchedKeyword:       "spam",
+			MatchedContent:       "spam",
+		},
+	}
+}
+
+// compactJSON returns the JSON of v without nulls and empty arrays, since the conversions don't
+// tell nil and empty slices apart. Events are compared as JSON, which shows the differences.
+func compactJSON(t *testing.T, v interface{}) string {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		t.Fatal(err)
+	}
+
+	var compact func(v interface{}) interface{}
+	compact = func(v interface{}) interface{} {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for key, value := range v {
+				if value = compact(value); value == nil {
+					delete(v, key)
+				} else {
+					v[key] = value
+				}
+			}
+		case []interface{}:
+			if len(v) == 0 {
+				return nil
+			}
+			for i, value := range v {
+				v[i] = compact(value)
+			}
+		}
+		return v
+	}
+
+	data, err = json.Marshal(compact(value))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+// roundTripEvent converts an event to protobuf, through the wire format, and back.
+func roundTripEvent(t *testing.T, event *discordgo.Event) (*proto.GatewayEvent, *discordgo.Event) {
+	t.Helper()
+
+	buf := struct2buf.GatewayEvent(event)
+	data, err := pb.Marshal(buf)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var received proto.GatewayEvent
+	if err := pb.Unmarshal(data, &received); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	return buf, buf2struct.GatewayEvent(&received)
+}
+
+func TestGatewayEventsRoundTrip(t *testing.T) {
+	for eventType, event := range testEvents() {
+		buf, got := roundTripEvent(t, &discordgo.Event{Type: eventType, Struct: event})
+		if buf.GetRaw() != nil {
+			t.Errorf("%s: sent as raw", eventType)
+			continue
+		}
+		if buf.Type != eventType || got.Type != eventType {
+			t.Errorf("%s: got type %q, then %q", eventType, buf.Type, got.Type)
+		}
+
+		if want, have := compactJSON(t, event), compactJSON(t, got.Struct); have != want {
+			t.Errorf("%s:\ngot  %s\nwant %s", eventType, have, want)
+		}
+	}
+}
+
+func TestGatewayEventEnvelope(t *testing.T) {
+	buf, _ := roundTripEvent(t, &discordgo.Event{Type: "TYPING_START", Struct: testEvents()["TYPING_START"]})
+	if buf.GuildId != "1" || buf.ChannelId != "2" {
+		t.Errorf("typing: got guild %q, channel %q", buf.GuildId, buf.ChannelId)
+	}
+
+	// Events without a payload are still routed by their guild
+	buf, got := roundTripEvent(t, &discordgo.Event{Type: "CHANNEL_CREATE", Struct: &discordgo.ChannelCreate{}})
+	if buf.GetRaw() != nil || got.Struct == nil {
+		t.Errorf("empty channel: got %#v", got)
+	}
+}
+
+func TestGatewayEventRaw(t *testing.T) {
+	raw := json.RawMessage(`{"guild_id":"1","channel_id":"2","soundboard_sound_id":"3"}`)
+	buf, got := roundTripEvent(t, &discordgo.Event{Type: "VOICE_CHANNEL_EFFECT_SEND", RawData: raw})
+	if buf.GuildId != "1" || buf.ChannelId != "2" {
+		t.Errorf("raw: got guild %q, channel %q", buf.GuildId, buf.ChannelId)
+	}
+	if got.Type != "VOICE_CHANNEL_EFFECT_SEND" || got.Struct != nil || string(got.RawData) != string(raw) {
+		t.Errorf("raw: got %#v", got)
+	}
+
+	// Unmodeled typed events fall back to their payload as well
+	_, got = roundTripEvent(t, &discordgo.Event{Type: "GUILD_MEMBER_ADD", Struct: &discordgo.GuildMemberAdd{}, RawData: raw})
+	if got.Struct != nil || string(got.RawData) != string(raw) {
+		t.Errorf("unmodeled: got %#v", got)
+	}
+
+	if buf := struct2buf.GatewayEvent(nil); buf != nil {
+		t.Errorf("nil event converted to %v", buf)
+	}
+}
@@ -7,12 +7,6 @@ import (
 	proto "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
 )
 
-// The channel parameters of the interaction, etc. require a discordgo.Session.
-//
-// Therefore, need to assign it for use in some conversion functions,
-// and some object conversion functions may not be available if a session is not provided.
-var DiscordSession *discordgo.Session
-
 func ApplicationCommand(s *discordgo.ApplicationCommand) *proto.ApplicationCommand {
 	if s == nil {
 		return nil
@@ -63,7 +57,91 @@ func ApplicationCommand(s *discordgo.ApplicationCommand) *proto.ApplicationComma
 		Nsfw:                     s.NSFW,
 		Description:              s.Description,
 		DescriptionLocalizations: descriptionLocalizations,
-		Options:                  nil, // TODO(discord/bufstruct): implements Options
+		Options:                  ApplicationCommandOptions(s.Options),
+	}
+}
+
+// ApplicationCommandOptions converts the options of a command (or a subcommand), recursively.
+func ApplicationCommandOptions(s []*discordgo.ApplicationCommandOption) []*proto.ApplicationCommandOption {
+	if s == nil {
+		return nil
+	}
+
+	options := make([]*proto.ApplicationCommandOption, 0, len(s))
+	for _, option := range s {
+		if option != nil {
+			options = append(options, ApplicationCommandOption(option))
+		}
+	}
+	return options
+}
+
+func ApplicationCommandOption(s *discordgo.ApplicationCommandOption) *proto.ApplicationCommandOption {
+	if s == nil {
+		return nil
+	}
+
+	var nameLocalizations map[string]string
+	if s.NameLocalizations != nil {
+		nameLocalizations = make(map[string]string, len(s.NameLocalizations))
+		for k, v := range s.NameLocalizations {
+			nameLocalizations[string(k)] = v
+		}
+	}
+
+	var descriptionLocalizations map[string]string
+	if s.DescriptionLocalizations != nil {
+		descriptionLocalizations = make(map[string]string, len(s.DescriptionLocalizations))
+		for k, v := range s.DescriptionLocalizations {
+			descriptionLocalizations[string(k)] = v
+		}
+	}
+
+	channelTypes := make([]proto.ChannelType, 0, len(s.ChannelTypes))
+	for _, channelType := range s.ChannelTypes {
+		channelTypes = append(channelTypes, proto.ChannelType(channelType))
+	}
+
+	choices := make([]*proto.ApplicationCommandOptionChoice, 0, len(s.Choices))
+	for _, choice := range s.Choices {
+		if choice != nil {
+			choices = append(choices, ApplicationCommandOptionChoice(choice))
+		}
+	}
+
+	// Zero is a valid minimum, but a zero maximum is unset for discordgo
+	var maxValue *float64
+	if s.MaxValue != 0 {
+		maxValue = &s.MaxValue
+	}
+
+	var minLength *int32
+	if s.MinLength != nil {
+		v := int32(*s.MinLength)
+		minLength = &v
+	}
+
+	var maxLength *int32
+	if s.MaxLength != 0 {
+		v := int32(s.MaxLength)
+		maxLength = &v
+	}
+
+	return &proto.ApplicationCommandOption{
+		Type:                     proto.ApplicationCommandOptionType(s.Type),
+		Name:                     s.Name,
+		NameLocalizations:        nameLocalizations,
+		Description:              s.Description,
+		DescriptionLocalizations: descriptionLocalizations,
+		ChannelTypes:             channelTypes,
+		Required:                 s.Required,
+		Options:                  ApplicationCommandOptions(s.Options),
+		Autocomplete:             s.Autocomplete,
+		Choices:                  choices,
+		MinValue:                 s.MinValue,
+		MaxValue:                 maxValue,
+		MinLength:                minLength,
+		MaxLength:                maxLength,
 	}
 }
 
@@ -100,9 +178,16 @@ func Interaction(s *discordgo.Interaction) *proto.Interaction {
 	if s.Data != nil {
 		switch s.Data.Type() {
 		case discordgo.InteractionApplicationCommand, discordgo.InteractionApplicationCommandAutocomplete:
+			// The data of autocompletes reports the application command type, so check the interaction's
 			data := s.Data.(discordgo.ApplicationCommandInteractionData)
-			result.Data = &proto.Interaction_ApplicationCommandData{
-				ApplicationCommandData: ApplicationCommandInteractionData(&data),
+			if s.Type == discordgo.InteractionApplicationCommandAutocomplete {
+				result.Data = &proto.Interaction_AutocompleteData{
+					AutocompleteData: ApplicationCommandInteractionData(&data),
+				}
+			} else {
+				result.Data = &proto.Interaction_ApplicationCommandData{
+					ApplicationCommandData: ApplicationCommandInteractionData(&data),
+				}
 			}
 		case discordgo.InteractionMessageComponent:
 			data := s.Data.(discordgo.MessageComponentInteractionData)
@@ -137,7 +222,7 @@ func ApplicationCommandInteractionData(s *discordgo.ApplicationCommandInteractio
 		Name:        s.Name,
 		CommandType: proto.ApplicationCommandType(s.CommandType),
 		Resolved:    ApplicationCommandInteractionDataResolved(s.Resolved),
-		Options:     options, // TODO(discord/bufstruct): implements Options
+		Options:     options,
 		TargetId:    s.TargetID,
 	}
 }
@@ -192,11 +277,6 @@ func ApplicationCommandInteractionDataOption(s *discordgo.ApplicationCommandInte
 		return nil
 	}
 
-	if DiscordSession == nil {
-		fmt.Println("ApplicationCommandInteractionDataOption > DiscordSession is nil")
-		return nil
-	}
-
 	options := make([]*proto.ApplicationCommandInteractionDataOption, 0)
 	for _, v := range s.Options {
 		options = append(options, ApplicationCommandInteractionDataOption(v))
@@ -205,40 +285,36 @@ func ApplicationCommandInteractionDataOption(s *discordgo.ApplicationCommandInte
 	result := &proto.ApplicationCommandInteractionDataOption{
 		Name:    s.Name,
 		Type:    proto.ApplicationCommandOptionType(s.Type),
-		Options: options, // TODO(struct2buf): Consider same-type recursion issues
+		Options: options,
 		Focused: s.Focused,
 	}
 
-	if s.Value != nil {
+	// Values are converted from their JSON form: numbers are float64, and the users, channels,
+	// etc. are IDs (their objects are in the resolved data).
+	switch v := s.Value.(type) {
+	case float64:
+		if s.Type == discordgo.ApplicationCommandOptionInteger {
+			result.Value = &proto.ApplicationCommandInteractionDataOption_IntegerValue{IntegerValue: int64(v)}
+		} else {
+			result.Value = &proto.ApplicationCommandInteractionDataOption_NumberValue{NumberValue: v}
+		}
+	case bool:
+		result.Value = &proto.ApplicationCommandInteractionDataOption_BooleanValue{BooleanValue: v}
+	case string:
 		switch s.Type {
-		case discordgo.ApplicationCommandOptionInteger:
-			result.Value = &proto.ApplicationCommandInteractionDataOption_IntegerValue{
-				IntegerValue: s.IntValue(),
-			}
-		case discordgo.ApplicationCommandOptionNumber:
-			result.Value = &proto.ApplicationCommandInteractionDataOption_NumberValue{
-				NumberValue: s.FloatValue(),
-			}
-		case discordgo.ApplicationCommandOptionString:
-			result.Value = &proto.ApplicationCommandInteractionDataOption_StringValue{
-				StringValue: s.StringValue(),
-			}
-		case discordgo.ApplicationCommandOptionBoolean:
-			result.Value = &proto.ApplicationCommandInteractionDataOption_BooleanValue{
-				BooleanValue: s.BoolValue(),
-			}
+		case discordgo.ApplicationCommandOptionUser:
+			result.Value = &proto.ApplicationCommandInteractionDataOption_UserValueId{UserValueId: v}
 		case discordgo.ApplicationCommandOptionChannel:
-			channel := s.ChannelValue(DiscordSession)
-			if channel == nil {
-				fmt.Println("ApplicationCommandInteractionDataOption > discordgo.Channel is nil")
-				result.Value = &proto.ApplicationCommandInteractionDataOption_ChannelValueId{
-					ChannelValueId: "",
-				}
-			} else {
-				result.Value = &proto.ApplicationCommandInteractionDataOption_ChannelValueId{
-					ChannelValueId: channel.ID,
-				}
-			}
+			result.Value = &proto.ApplicationCommandInteractionDataOption_ChannelValueId{ChannelValueId: v}
+		case discordgo.ApplicationCommandOptionRole:
+			result.Value = &proto.ApplicationCommandInteractionDataOption_RoleValueId{RoleValueId: v}
+		case discordgo.ApplicationCommandOptionMentionable:
+			result.Value = &proto.ApplicationCommandInteractionDataOption_MentionableValueId{MentionableValueId: v}
+		case discordgo.ApplicationCommandOptionAttachment:
+			result.Value = &proto.ApplicationCommandInteractionDataOption_AttachmentValueId{AttachmentValueId: v}
+		default:
+			// Also the partial input of a focused integer or number option, while autocompleting
+			result.Value = &proto.ApplicationCommandInteractionDataOption_StringValue{StringValue: v}
 		}
 	}
 
@@ -298,7 +374,7 @@ func ModalSubmitInteractionData(s *discordgo.ModalSubmitInteractionData) *proto.
 
 	return &proto.ModalSubmitInteractionData{
 		CustomId:   s.CustomID,
-		Components: nil, // TODO(discord/bufstruct): implements Components
+		Components: MessageComponents(s.Components),
 	}
 }
 
@@ -310,10 +386,98 @@ func InteractionResponse(s *discordgo.InteractionResponse) *proto.InteractionRes
 
 	return &proto.InteractionResponse{
 		Type: proto.InteractionResponseType(s.Type),
-		Data: nil, // TODO: implement InteractionResponseData conversion
+		Data: InteractionResponseData(s.Data),
+	}
+}
+
+// InteractionResponseData converts discordgo InteractionResponseData to proto InteractionResponseData
+func InteractionResponseData(s *discordgo.InteractionResponseData) *proto.InteractionResponseData {
+	if s == nil {
+		return nil
+	}
+
+	embeds := make([]*proto.MessageEmbed, 0, len(s.Embeds))
+	for _, embed := range s.Embeds {
+		embeds = append(embeds, MessageEmbed(embed))
+	}
+
+	files := make([]*proto.File, 0, len(s.Files))
+	for _, file := range s.Files {
+		files = append(files, &proto.File{
+			Name:        file.Name,
+			ContentType: file.ContentType,
+		})
+	}
+
+	var attachments []*proto.MessageAttachment
+	if s.Attachments != nil {
+		attachments = make([]*proto.MessageAttachment, 0, len(*s.Attachments))
+		for _, attachment := range *s.Attachments {
+			attachments = append(attachments, MessageAttachment(attachment))
+		}
+	}
+
+	choices := make([]*proto.ApplicationCommandOptionChoice, 0, len(s.Choices))
+	for _, choice := range s.Choices {
+		choices = append(choices, ApplicationCommandOptionChoice(choice))
+	}
+
+	return &proto.InteractionResponseData{
+		Tts:             s.TTS,
+		Content:         s.Content,
+		Components:      MessageComponents(s.Components),
+		Embeds:          embeds,
+		AllowedMentions: MessageAllowedMentions(s.AllowedMentions),
+		Files:           files,
+		Attachments:     attachments,
+		Flags:           int64(s.Flags),
+		Choices:         choices,
+		CustomId:        s.CustomID,
+		Title:           s.Title,
 	}
 }
 
+// ApplicationCommandOptionChoice converts discordgo ApplicationCommandOptionChoice to proto ApplicationCommandOptionChoice
+func ApplicationCommandOptionChoice(s *discordgo.ApplicationCommandOptionChoice) *proto.ApplicationCommandOptionChoice {
+	if s == nil {
+		return nil
+	}
+
+	var nameLocalizations map[string]string
+	if s.NameLocalizations != nil {
+		nameLocalizations = make(map[string]string, len(s.NameLocalizations))
+		for locale, name := range s.NameLocalizations {
+			nameLocalizations[string(locale)] = name
+		}
+	}
+
+	choice := &proto.ApplicationCommandOptionChoice{
+		Name:              s.Name,
+		NameLocalizations: nameLocalizations,
+	}
+
+	switch v := s.Value.(type) {
+	case string:
+		choice.Value = &proto.ApplicationCommandOptionChoice_StringValue{StringValue: v}
+	case int:
+		choice.Value = &proto.ApplicationCommandOptionChoice_IntegerValue{IntegerValue: int64(v)}
+	case int32:
+		choice.Value = &proto.ApplicationCommandOptionChoice_IntegerValue{IntegerValue: int64(v)}
+	case int64:
+		choice.Value = &proto.ApplicationCommandOptionChoice_IntegerValue{IntegerValue: v}
+	case float32:
+		choice.Value = &proto.ApplicationCommandOptionChoice_NumberValue{NumberValue: float64(v)}
+	case float64:
+		// Numbers unmarshaled from JSON are always float64
+		choice.Value = &proto.ApplicationCommandOptionChoice_NumberValue{NumberValue: v}
+	case nil:
+	default:
+		panic(fmt.Sprintf("unknown choice value type (type: %T)", s.Value))
+	}
+
+	return choice
+}
+
 // WebhookEdit converts discordgo WebhookEdit to proto WebhookEdit
 func WebhookEdit(s *discordgo.WebhookEdit) *proto.WebhookEdit {
 	if s == nil {
@@ -324,7 +488,11 @@ func WebhookEdit(s *discordgo.WebhookEdit) *proto.WebhookEdit {
 	if s.Content != nil {
 		edit.Content = s.Content
 	}
-	// Add more fields as needed for embeds, components, etc.
+	if s.Components != nil {
+		edit.Components = MessageComponents(*s.Components)
+		edit.ClearComponents = len(edit.Components) == 0
+	}
+	// Add more fields as needed for embeds, etc.
 
 	return edit
 }
@@ -372,8 +540,17 @@ func WebhookParams(s *discordgo.WebhookParams) *proto.WebhookParams {
 		params.AllowedMentions = MessageAllowedMentions(s.AllowedMentions)
 	}
 
-	// Note: Files and Components are complex and would need special handling
-	// For now, we'll leave them empty as they're not commonly used in simple webhook calls
+	params.Components = MessageComponents(s.Components)
+
+	// Note: The content of the files is sent by the Helper calls (see module.HelperClientImpl)
+	files := make([]*proto.File, 0, len(s.Files))
+	for _, file := range s.Files {
+		files = append(files, &proto.File{
+			Name:        file.Name,
+			ContentType: file.ContentType,
+		})
+	}
+	params.Files = files
 
 	return params
 }
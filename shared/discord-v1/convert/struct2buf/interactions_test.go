@@ -0,0 +1,230 @@
+package struct2buf_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	discord "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/convert/buf2struct"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/convert/struct2buf"
+	pb "google.golang.org/protobuf/proto"
+
+	proto "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
+)
+
+// responseRoundTrip converts a response to protobuf, through the wire format, and back.
+func responseRoundTrip(t *testing.T, resp *discordgo.InteractionResponse) *discordgo.InteractionResponse {
+	t.Helper()
+
+	data, err := pb.Marshal(struct2buf.InteractionResponse(resp))
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var buf proto.InteractionResponse
+	if err := pb.Unmarshal(data, &buf); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	return buf2struct.InteractionResponse(&buf)
+}
+
+func TestInteractionResponseMessage(t *testing.T) {
+	got := responseRoundTrip(t, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			TTS:     true,
+			Content: "Only you can see this",
+			Embeds:  []*discordgo.MessageEmbed{{Title: "Embed", Type: discordgo.EmbedTypeRich}},
+			AllowedMentions: &discordgo.MessageAllowedMentions{
+				Parse: []discordgo.AllowedMentionType{discordgo.AllowedMentionTypeUsers},
+			},
+			Flags:      discordgo.MessageFlagsEphemeral | discordgo.MessageFlagsSuppressEmbeds,
+			Components: testComponents(),
+		},
+	})
+
+	if got.Type != discordgo.InteractionResponseChannelMessageWithSource || got.Data == nil {
+		t.Fatalf("got %#v", got)
+	}
+	if got.Data.Flags != discordgo.MessageFlagsEphemeral|discordgo.MessageFlagsSuppressEmbeds {
+		t.Errorf("flags: got %d", got.Data.Flags)
+	}
+	if !got.Data.TTS || got.Data.Content != "Only you can see this" || len(got.Data.Embeds) != 1 || got.Data.Embeds[0].Title != "Embed" {
+		t.Errorf("message: got %#v", got.Data)
+	}
+	if got.Data.AllowedMentions == nil || !reflect.DeepEqual(got.Data.AllowedMentions.Parse, []discordgo.AllowedMentionType{discordgo.AllowedMentionTypeUsers}) {
+		t.Errorf("allowed mentions: got %#v", got.Data.AllowedMentions)
+	}
+	if !reflect.DeepEqual(got.Data.Components, testComponentPointers()) {
+		t.Errorf("components: got %#v", got.Data.Components)
+	}
+}
+
+func TestInteractionResponseAutocomplete(t *testing.T) {
+	got := responseRoundTrip(t, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{
+			Choices: []*discordgo.ApplicationCommandOptionChoice{
+				{Name: "Seoul", Value: "seoul", NameLocalizations: map[discordgo.Locale]string{discordgo.Korean: "서울"}},
+				{Name: "Ten", Value: 10},
+				{Name: "Pi", Value: 3.14},
+			},
+		},
+	})
+
+	want := []*discordgo.ApplicationCommandOptionChoice{
+		{Name: "Seoul", Value: "seoul", NameLocalizations: map[discordgo.Locale]string{discordgo.Korean: "서울"}},
+		{Name: "Ten", Value: int64(10)},
+		{Name: "Pi", Value: 3.14},
+	}
+	if got.Data == nil || !reflect.DeepEqual(got.Data.Choices, want) {
+		t.Errorf("choices: got %#v", got.Data)
+	}
+}
+
+func TestInteractionResponseModal(t *testing.T) {
+	got := responseRoundTrip(t, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID:   "feedback",
+			Title:      "Send feedback",
+			Components: testComponents()[3:],
+		},
+	})
+
+	if got.Type != discordgo.InteractionResponseModal || got.Data == nil {
+		t.Fatalf("got %#v", got)
+	}
+	if got.Data.CustomID != "feedback" || got.Data.Title != "Send feedback" {
+		t.Errorf("modal: got %#v", got.Data)
+	}
+	if !reflect.DeepEqual(got.Data.Components, testComponentPointers()[3:]) {
+		t.Errorf("components: got %#v", got.Data.Components)
+	}
+}
+
+func TestInteractionResponseWithoutData(t *testing.T) {
+	got := responseRoundTrip(t, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseDeferredChannelMessageWithSource})
+	if got.Type != discordgo.InteractionResponseDeferredChannelMessageWithSource || got.Data != nil {
+		t.Errorf("got %#v", got)
+	}
+}
+
+func TestApplicationCommandOptions(t *testing.T) {
+	minValue, minLength := 0.0, 2
+	command := &discordgo.ApplicationCommand{
+		Name:        "music",
+		Description: "Music commands",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+				Name:        "queue",
+				Description: "Manage the queue",
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:                     discordgo.ApplicationCommandOptionSubCommand,
+						Name:                     "add",
+						Description:              "Add a song",
+						NameLocalizations:        map[discordgo.Locale]string{discordgo.Korean: "추가"},
+						DescriptionLocalizations: map[discordgo.Locale]string{discordgo.Korean: "노래 추가"},
+						Options: []*discordgo.ApplicationCommandOption{
+							{Type: discordgo.ApplicationCommandOptionString, Name: "song", Description: "Song", Required: true, Autocomplete: true, MinLength: &minLength, MaxLength: 100},
+							{Type: discordgo.ApplicationCommandOptionInteger, Name: "position", Description: "Position", MinValue: &minValue, MaxValue: 50},
+							{Type: discordgo.ApplicationCommandOptionChannel, Name: "channel", Description: "Channel", ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildVoice}},
+							{Type: discordgo.ApplicationCommandOptionNumber, Name: "volume", Description: "Volume", Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "Quiet", Value: 0.25},
+								{Name: "Loud", Value: 1.0},
+							}},
+							{Type: discordgo.ApplicationCommandOptionString, Name: "source", Description: "Source", Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "YouTube", Value: "youtube"},
+							}},
+							{Type: discordgo.ApplicationCommandOptionInteger, Name: "repeat", Description: "Repeat", Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "Once", Value: int64(1)},
+							}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	data, err := pb.Marshal(struct2buf.ApplicationCommand(command))
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var buf proto.ApplicationCommand
+	if err := pb.Unmarshal(data, &buf); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	got := buf2struct.ApplicationCommand(&buf)
+
+	if !reflect.DeepEqual(got.Options, command.Options) {
+		t.Errorf("options:\ngot  %#v\nwant %#v", got.Options, command.Options)
+	}
+}
+
+func TestApplicationCommandInteractionDataOptionValues(t *testing.T) {
+	// As unmarshaled by discordgo from an interaction
+	option := &discordgo.ApplicationCommandInteractionDataOption{
+		Name: "add",
+		Type: discordgo.ApplicationCommandOptionSubCommand,
+		Options: []*discordgo.ApplicationCommandInteractionDataOption{
+			{Name: "song", Type: discordgo.ApplicationCommandOptionString, Value: "never gonna"},
+			{Name: "position", Type: discordgo.ApplicationCommandOptionInteger, Value: float64(3)},
+			{Name: "volume", Type: discordgo.ApplicationCommandOptionNumber, Value: 0.5},
+			{Name: "loop", Type: discordgo.ApplicationCommandOptionBoolean, Value: true},
+			{Name: "user", Type: discordgo.ApplicationCommandOptionUser, Value: "111"},
+			{Name: "channel", Type: discordgo.ApplicationCommandOptionChannel, Value: "222"},
+			{Name: "role", Type: discordgo.ApplicationCommandOptionRole, Value: "333"},
+		},
+	}
+
+	got := buf2struct.ApplicationCommandInteractionDataOption(struct2buf.ApplicationCommandInteractionDataOption(option))
+	if !reflect.DeepEqual(got, option) {
+		t.Fatalf("got %#v", got)
+	}
+
+	options := got.Options
+	if options[0].StringValue() != "never gonna" || options[1].IntValue() != 3 || options[2].FloatValue() != 0.5 || !options[3].BoolValue() {
+		t.Errorf("values: got %v, %v, %v, %v", options[0].Value, options[1].Value, options[2].Value, options[3].Value)
+	}
+	if options[4].UserValue(nil).ID != "111" || options[5].ChannelValue(nil).ID != "222" || options[6].RoleValue(nil, "").ID != "333" {
+		t.Errorf("IDs: got %v, %v, %v", options[4].Value, options[5].Value, options[6].Value)
+	}
+}
+
+func TestAutocompleteInteraction(t *testing.T) {
+	interaction := &discordgo.Interaction{
+		ID:   "1",
+		Type: discordgo.InteractionApplicationCommandAutocomplete,
+		Data: discordgo.ApplicationCommandInteractionData{
+			Name: "music",
+			Options: []*discordgo.ApplicationCommandInteractionDataOption{
+				{Name: "add", Type: discordgo.ApplicationCommandOptionSubCommand, Options: []*discordgo.ApplicationCommandInteractionDataOption{
+					{Name: "position", Type: discordgo.ApplicationCommandOptionInteger, Value: float64(1)},
+					// Integers are typed as strings while autocompleting
+					{Name: "song", Type: discordgo.ApplicationCommandOptionString, Value: "never", Focused: true},
+				}},
+			},
+		},
+	}
+
+	buf := struct2buf.Interaction(interaction)
+	if buf.GetAutocompleteData() == nil {
+		t.Fatalf("autocomplete data not set: %v", buf)
+	}
+
+	got := buf2struct.Interaction(buf)
+	if got.Type != discordgo.InteractionApplicationCommandAutocomplete || got.ApplicationCommandData().Name != "music" {
+		t.Fatalf("got %#v", got)
+	}
+	focused := discord.FocusedOption(got)
+	if focused == nil || focused.Name != "song" || focused.StringValue() != "never" {
+		t.Errorf("focused option: got %#v", focused)
+	}
+
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 30)
+	if resp := discord.AutocompleteResponse(choices); len(resp.Data.Choices) != discord.MaxAutocompleteChoices {
+		t.Errorf("got %d choices", len(resp.Data.Choices))
+	}
+}
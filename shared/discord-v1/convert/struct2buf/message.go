@@ -61,6 +61,7 @@ func Message(s *discordgo.Message) *proto.Message {
 		Author:          User(s.Author),
 		Attachments:     attachments,
 		Embeds:          embeds,
+		Components:      MessageComponents(s.Components),
 		Pinned:          s.Pinned,
 		Type:            proto.MessageType(s.Type),
 		WebhookId:       s.WebhookID,
@@ -101,7 +102,8 @@ func MessageEmbed(s *discordgo.MessageEmbed) *proto.MessageEmbed {
 
 	var embedType proto.EmbedType
 	switch s.Type {
-	case discordgo.EmbedTypeRich:
+	case discordgo.EmbedTypeRich, "":
+		// Embeds sent by bots are rich embeds, even if the type is left empty
 		embedType = 0
 	case discordgo.EmbedTypeImage:
 		embedType = 1
@@ -231,6 +233,20 @@ func MessageReactions(s *discordgo.MessageReactions) *proto.MessageReactions {
 	}
 }
 
+func MessageReaction(s *discordgo.MessageReaction) *proto.MessageReaction {
+	if s == nil {
+		return nil
+	}
+
+	return &proto.MessageReaction{
+		UserId:    s.UserID,
+		MessageId: s.MessageID,
+		Emoji:     Emoji(&s.Emoji),
+		ChannelId: s.ChannelID,
+		GuildId:   s.GuildID,
+	}
+}
+
 func MessageSend(s *discordgo.MessageSend) *proto.MessageSend {
 	if s == nil {
 		return nil
@@ -255,6 +271,7 @@ func MessageSend(s *discordgo.MessageSend) *proto.MessageSend {
 		Content:    s.Content,
 		Embeds:     embeds,
 		Tts:        s.TTS,
+		Components: MessageComponents(s.Components),
 		Files:      files,
 		StickerIds: stickerIDs,
 		// Note: AllowedMentions and Reference need conversion functions
@@ -291,13 +308,21 @@ func MessageEdit(s *discordgo.MessageEdit) *proto.MessageEdit {
 		}
 	}
 
+	var components []*proto.MessageComponent
+	if s.Components != nil {
+		components = MessageComponents(*s.Components)
+	}
+
 	return &proto.MessageEdit{
 		Content:     util.StringPtrToString(s.Content),
+		Components:  components,
 		Embeds:      embeds,
 		Files:       files,
 		Attachments: attachments,
 		Id:          s.ID,
 		Channel:     s.Channel,
+
+		ClearComponents: s.Components != nil && len(components) == 0,
 		// Note: AllowedMentions and Flags need proper handling
 		// Flags:      proto.MessageFlags(s.Flags),
 	}
@@ -0,0 +1,50 @@
+package struct2buf_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/convert/buf2struct"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/convert/struct2buf"
+
+	proto "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
+)
+
+func TestMessageReactionRoundTrip(t *testing.T) {
+	for name, reaction := range map[string]*discordgo.MessageReaction{
+		"unicode emoji": {
+			UserID:    "1",
+			MessageID: "2",
+			ChannelID: "3",
+			GuildID:   "4",
+			Emoji:     discordgo.Emoji{Name: "👍"},
+		},
+		"custom emoji": {
+			UserID:    "1",
+			MessageID: "2",
+			ChannelID: "3",
+			GuildID:   "4",
+			Emoji:     discordgo.Emoji{ID: "5", Name: "party", Animated: true},
+		},
+		// Removing all the reactions of a message has no user nor emoji
+		"all reactions": {MessageID: "2", ChannelID: "3", GuildID: "4"},
+	} {
+		got := buf2struct.MessageReaction(wire(t, struct2buf.MessageReaction(reaction), &proto.MessageReaction{}))
+		if !reflect.DeepEqual(got, reaction) {
+			t.Errorf("%s:\ngot  %#v\nwant %#v", name, got, reaction)
+		}
+	}
+
+	if struct2buf.MessageReaction(nil) != nil || buf2struct.MessageReaction(nil) != nil {
+		t.Error("converted a nil reaction")
+	}
+}
+
+func TestMessageReactionsRoundTrip(t *testing.T) {
+	reactions := &discordgo.MessageReactions{Count: 3, Me: true, Emoji: &discordgo.Emoji{ID: "5", Name: "party"}}
+	got := buf2struct.MessageReactions(wire(t, struct2buf.MessageReactions(reactions), &proto.MessageReactions{}))
+	if !reflect.DeepEqual(got, reactions) {
+		t.Errorf("got %#v, want %#v", got, reactions)
+	}
+}
@@ -29,3 +29,148 @@ func GatewayBotResponse(s *discordgo.GatewayBotResponse) *proto.GatewayBotRespon
 		Shards: int32(s.Shards),
 	}
 }
+
+// GuildScheduledEvent converts discordgo.GuildScheduledEvent to proto.GuildScheduledEvent
+func GuildScheduledEvent(s *discordgo.GuildScheduledEvent) *proto.GuildScheduledEvent {
+	if s == nil {
+		return nil
+	}
+
+	return &proto.GuildScheduledEvent{
+		Id:                 s.ID,
+		GuildId:            s.GuildID,
+		ChannelId:          s.ChannelID,
+		CreatorId:          s.CreatorID,
+		Name:               s.Name,
+		Description:        s.Description,
+		ScheduledStartTime: Timestamp(s.ScheduledStartTime),
+		ScheduledEndTime:   TimestampPtr(s.ScheduledEndTime),
+		PrivacyLevel:       int32(s.PrivacyLevel),
+		Status:             int32(s.Status),
+		EntityType:         int32(s.EntityType),
+		EntityId:           s.EntityID,
+		EntityMetadata: &proto.GuildScheduledEventEntityMetadata{
+			Location: s.EntityMetadata.Location,
+		},
+		Creator:   User(s.Creator),
+		UserCount: int32(s.UserCount),
+		Image:     s.Image,
+	}
+}
+
+// Invite converts discordgo.Invite to proto.Invite
+// Simplified version without the target application
+func Invite(s *discordgo.Invite) *proto.Invite {
+	if s == nil {
+		return nil
+	}
+
+	return &proto.Invite{
+		Guild:                    Guild(s.Guild),
+		Channel:                  Channel(s.Channel),
+		Inviter:                  User(s.Inviter),
+		Code:                     s.Code,
+		CreatedAt:                Timestamp(s.CreatedAt),
+		MaxAge:                   int32(s.MaxAge),
+		Uses:                     int32(s.Uses),
+		MaxUses:                  int32(s.MaxUses),
+		Revoked:                  s.Revoked,
+		Temporary:                s.Temporary,
+		Unique:                   s.Unique,
+		TargetUser:               User(s.TargetUser),
+		TargetType:               uint32(s.TargetType),
+		ApproximatePresenceCount: int32(s.ApproximatePresenceCount),
+		ApproximateMemberCount:   int32(s.ApproximateMemberCount),
+		ExpiresAt:                TimestampPtr(s.ExpiresAt),
+	}
+}
+
+// AddedThreadMember converts discordgo.AddedThreadMember to proto.AddedThreadMember
+func AddedThreadMember(s *discordgo.AddedThreadMember) *proto.AddedThreadMember {
+	if s == nil {
+		return nil
+	}
+
+	return &proto.AddedThreadMember{
+		ThreadMember: ThreadMember(s.ThreadMember),
+		Member:       Member(s.Member),
+		Presence:     Presence(s.Presence),
+	}
+}
+
+// AutoModerationRule converts discordgo.AutoModerationRule to proto.AutoModerationRule
+func AutoModerationRule(s *discordgo.AutoModerationRule) *proto.AutoModerationRule {
+	if s == nil {
+		return nil
+	}
+
+	actions := make([]*proto.AutoModerationAction, len(s.Actions))
+	for i := range s.Actions {
+		actions[i] = AutoModerationAction(&s.Actions[i])
+	}
+
+	rule := &proto.AutoModerationRule{
+		Id:              s.ID,
+		GuildId:         s.GuildID,
+		Name:            s.Name,
+		CreatorId:       s.CreatorID,
+		EventType:       int32(s.EventType),
+		TriggerType:     int32(s.TriggerType),
+		TriggerMetadata: AutoModerationTriggerMetadata(s.TriggerMetadata),
+		Actions:         actions,
+	}
+	if s.Enabled != nil {
+		rule.Enabled = *s.Enabled
+	}
+	if s.ExemptRoles != nil {
+		rule.ExemptRoles = *s.ExemptRoles
+	}
+	if s.ExemptChannels != nil {
+		rule.ExemptChannels = *s.ExemptChannels
+	}
+
+	return rule
+}
+
+// AutoModerationTriggerMetadata converts discordgo.AutoModerationTriggerMetadata to proto.AutoModerationTriggerMetadata
+func AutoModerationTriggerMetadata(s *discordgo.AutoModerationTriggerMetadata) *proto.AutoModerationTriggerMetadata {
+	if s == nil {
+		return nil
+	}
+
+	presets := make([]uint32, len(s.Presets))
+	for i, p := range s.Presets {
+		presets[i] = uint32(p)
+	}
+
+	metadata := &proto.AutoModerationTriggerMetadata{
+		KeywordFilter:     s.KeywordFilter,
+		RegexPatterns:     s.RegexPatterns,
+		Presets:           presets,
+		MentionTotalLimit: int32(s.MentionTotalLimit),
+	}
+	if s.AllowList != nil {
+		metadata.AllowList = *s.AllowList
+	}
+
+	return metadata
+}
+
+// AutoModerationAction converts discordgo.AutoModerationAction to proto.AutoModerationAction
+func AutoModerationAction(s *discordgo.AutoModerationAction) *proto.AutoModerationAction {
+	if s == nil {
+		return nil
+	}
+
+	action := &proto.AutoModerationAction{
+		Type: int32(s.Type),
+	}
+	if s.Metadata != nil {
+		action.Metadata = &proto.AutoModerationActionMetadata{
+			ChannelId: s.Metadata.ChannelID,
+			Duration:  int32(s.Metadata.Duration),
+		}
+	}
+
+	return action
+}
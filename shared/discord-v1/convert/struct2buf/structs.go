@@ -15,6 +15,7 @@ func User(s *discordgo.User) *proto.User {
 	return &proto.User{
 		Id:               s.ID,
 		Username:         s.Username,
+		GlobalName:       s.GlobalName,
 		Discriminator:    s.Discriminator,
 		Avatar:           s.Avatar,
 		Bot:              s.Bot,
@@ -38,6 +39,7 @@ func Member(s *discordgo.Member) *proto.Member {
 		Deaf:                       s.Deaf,
 		Mute:                       s.Mute,
 		Avatar:                     s.Avatar,
+		User:                       User(s.User),
 		Roles:                      s.Roles,
 		PremiumSince:               util.AsTimePtrToPbTimestamp(s.PremiumSince),
 		Flags:                      int32(s.Flags),
@@ -0,0 +1,72 @@
+package struct2buf_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/convert/buf2struct"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/convert/struct2buf"
+
+	proto "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
+)
+
+func testGuild() *discordgo.Guild {
+	return &discordgo.Guild{
+		ID:                       "1",
+		Name:                     "Guild",
+		Icon:                     "icon",
+		OwnerID:                  "4",
+		AfkChannelID:             "5",
+		AfkTimeout:               300,
+		VerificationLevel:        discordgo.VerificationLevelMedium,
+		Features:                 []discordgo.GuildFeature{discordgo.GuildFeatureCommunity},
+		JoinedAt:                 time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		MemberCount:              42,
+		Large:                    true,
+		Roles:                    []*discordgo.Role{testRole()},
+		Emojis:                   []*discordgo.Emoji{{ID: "6", Name: "party", Animated: true}},
+		Members:                  []*discordgo.Member{{GuildID: "1", Nick: "ally", Roles: []string{"30"}, User: &discordgo.User{ID: "4", Username: "alice"}}},
+		Channels:                 []*discordgo.Channel{testChannel("2")},
+		Threads:                  []*discordgo.Channel{testThread("7")},
+		VoiceStates:              []*discordgo.VoiceState{{GuildID: "1", ChannelID: "5", UserID: "4", SessionID: "s", SelfMute: true}},
+		SystemChannelID:          "2",
+		PreferredLocale:          "en-US",
+		PremiumTier:              discordgo.PremiumTier2,
+		PremiumSubscriptionCount: 7,
+	}
+}
+
+func TestGuildRoundTrip(t *testing.T) {
+	for name, guild := range map[string]*discordgo.Guild{
+		"guild": testGuild(),
+		// Guilds in outages only have their ID
+		"unavailable": {ID: "1", Unavailable: true},
+	} {
+		got := buf2struct.Guild(wire(t, struct2buf.Guild(guild), &proto.Guild{}))
+		if want, have := compactJSON(t, guild), compactJSON(t, got); have != want {
+			t.Errorf("%s:\ngot  %s\nwant %s", name, have, want)
+		}
+	}
+}
+
+func TestOnDeleteGuildRequest(t *testing.T) {
+	guild := &discordgo.Guild{ID: "1", Unavailable: true}
+	for name, before := range map[string]*discordgo.Guild{
+		"cached":   testGuild(),
+		"uncached": nil,
+	} {
+		received := wire(t, &proto.OnDeleteGuildRequest{Guild: struct2buf.Guild(guild), Before: struct2buf.Guild(before)}, &proto.OnDeleteGuildRequest{})
+		if got := buf2struct.Guild(received.Guild); compactJSON(t, got) != compactJSON(t, guild) {
+			t.Errorf("%s: got guild %s", name, compactJSON(t, got))
+		}
+		got := buf2struct.Guild(received.Before)
+		if before == nil {
+			if got != nil {
+				t.Errorf("%s: got before %s", name, compactJSON(t, got))
+			}
+		} else if want, have := compactJSON(t, before), compactJSON(t, got); have != want {
+			t.Errorf("%s:\ngot  %s\nwant %s", name, have, want)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package discord
+
+import "io"
+
+// Download is the content of a file fetched by the runtime (see Helper.AttachmentDownload).
+// It must be closed once read.
+type Download struct {
+	io.ReadCloser
+	ContentType string // MIME type of the content (e.g. "audio/mpeg")
+	Size        int64  // Size of the content in bytes, or -1 if unknown
+}
@@ -0,0 +1,57 @@
+package discord
+
+import (
+	"reflect"
+	"runtime"
+	"sort"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// PrivilegedHooks are the hooks of the events that Discord only sends with a privileged intent,
+// with that intent. The runtime warns when a module implements them without subscribing to their
+// events, since it doesn't request the intent then. Presences come through OnEvent, so they
+// aren't tracked here.
+var PrivilegedHooks = map[string]discordgo.Intent{
+	"OnAddGuildMember":    discordgo.IntentGuildMembers,
+	"OnUpdateGuildMember": discordgo.IntentGuildMembers,
+	"OnRemoveGuildMember": discordgo.IntentGuildMembers,
+}
+
+// ImplementedHooks returns the PrivilegedHooks that hook implements itself, rather than through
+// an embedded AbstractHooks.
+func ImplementedHooks(hook Hook) []string {
+	var implemented []string
+	for name := range PrivilegedHooks {
+		if implements(hook, name) {
+			implemented = append(implemented, name)
+		}
+	}
+	sort.Strings(implemented)
+	return implemented
+}
+
+// implements reports whether the method of hook is declared by its type. The compiler generates
+// the methods promoted from embedded types (and the pointer ones of value methods), so a method
+// implemented by the type is one that isn't generated, either on the pointer or on the value.
+func implements(hook Hook, name string) bool {
+	abstract, _ := reflect.TypeOf(&AbstractHooks{}).MethodByName(name)
+
+	t := reflect.TypeOf(hook)
+	types := []reflect.Type{t}
+	if t.Kind() == reflect.Pointer {
+		types = append(types, t.Elem())
+	}
+
+	for _, t := range types {
+		method, ok := t.MethodByName(name)
+		if !ok {
+			continue
+		}
+		pc := method.Func.Pointer()
+		if file, _ := runtime.FuncForPC(pc).FileLine(pc); file != "<autogenerated>" && pc != abstract.Func.Pointer() {
+			return true
+		}
+	}
+	return false
+}
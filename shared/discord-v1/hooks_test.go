@@ -0,0 +1,39 @@
+package discord_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	discord "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+)
+
+type memberHooks struct {
+	discord.AbstractHooks
+}
+
+func (h *memberHooks) OnAddGuildMember(member *discordgo.Member) error { return nil }
+
+type valueHooks struct {
+	*discord.AbstractHooks
+}
+
+func (h valueHooks) OnRemoveGuildMember(member *discordgo.Member) error { return nil }
+
+func TestImplementedHooks(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		hook discord.Hook
+		want []string
+	}{
+		{name: "abstract", hook: &discord.AbstractHooks{}},
+		{name: "embedded", hook: &struct{ discord.AbstractHooks }{}},
+		{name: "pointer method", hook: &memberHooks{}, want: []string{"OnAddGuildMember"}},
+		{name: "value method", hook: valueHooks{&discord.AbstractHooks{}}, want: []string{"OnRemoveGuildMember"}},
+		{name: "value method by pointer", hook: &valueHooks{&discord.AbstractHooks{}}, want: []string{"OnRemoveGuildMember"}},
+	} {
+		if got := discord.ImplementedHooks(test.hook); !slices.Equal(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
@@ -5,16 +5,123 @@ import (
 	pb "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
 )
 
+// Permissions that a module must request in its manifest to receive the corresponding events.
+const (
+	PermissionOnAddReaction    = "DISCORD_V1_ON_ADD_REACTION"
+	PermissionOnRemoveReaction = "DISCORD_V1_ON_REMOVE_REACTION" // Also covers removing all reactions, or all of an emoji
+	PermissionVoiceState       = "DISCORD_V1_REQ_VOICE_STATE"
+
+	// Messages are sent regardless, but their content is only available with this permission
+	// (the runtime requests the privileged message content intent for it).
+	PermissionOnCreateMessage = "DISCORD_V1_ON_CREATE_MESSAGE"
+)
+
+// EventPermissions are the permissions needed to receive each gateway event, for the events that need one.
+var EventPermissions = map[string]string{
+	"VOICE_STATE_UPDATE":            PermissionVoiceState,
+	"MESSAGE_REACTION_ADD":          PermissionOnAddReaction,
+	"MESSAGE_REACTION_REMOVE":       PermissionOnRemoveReaction,
+	"MESSAGE_REACTION_REMOVE_ALL":   PermissionOnRemoveReaction,
+	"MESSAGE_REACTION_REMOVE_EMOJI": PermissionOnRemoveReaction,
+}
+
 type InitResponse struct {
 	// if bot needs to register interactions, write them here
 	Interactions []*discordgo.ApplicationCommand
+
+	// Hooks are the PrivilegedHooks implemented by the module, set by the module SDK.
+	Hooks []string
 }
 
 type Hook interface {
 	OnInit(h Helper) InitResponse
 	OnCreateChatMessage(message *discordgo.Message) error
-	OnCreateInteraction(interaction *discordgo.Interaction) error
-	OnEvent(event string) error
+
+	// OnUpdateChatMessage is called when a message is edited.
+	// before is the previous version of the message, or nil if it wasn't cached.
+	OnUpdateChatMessage(message *discordgo.Message, before *discordgo.Message) error
+
+	// OnDeleteChatMessage is called when a message is deleted. Only the IDs of message are set,
+	// so before is the deleted message, or nil if it wasn't cached.
+	OnDeleteChatMessage(message *discordgo.Message, before *discordgo.Message) error
+
+	// OnBulkDeleteChatMessages is called when several messages of a channel are deleted at once.
+	// before contains the deleted messages that were cached, oldest first.
+	OnBulkDeleteChatMessages(bulk *discordgo.MessageDeleteBulk, before []*discordgo.Message) error
+
+	// OnAddReaction is called when a user reacts to a message (requires PermissionOnAddReaction).
+	OnAddReaction(reaction *discordgo.MessageReactionAdd) error
+
+	// OnRemoveReaction is called when a user removes a reaction (requires PermissionOnRemoveReaction).
+	OnRemoveReaction(reaction *discordgo.MessageReactionRemove) error
+
+	// OnRemoveAllReactions is called when all reactions are removed from a message (requires PermissionOnRemoveReaction).
+	OnRemoveAllReactions(reaction *discordgo.MessageReactionRemoveAll) error
+
+	// OnRemoveEmojiReactions is called when all reactions of an emoji are removed from a message
+	// (requires PermissionOnRemoveReaction). The UserID of reaction is empty.
+	OnRemoveEmojiReactions(reaction *discordgo.MessageReaction) error
+
+	// OnAddGuildMember is called when a user joins a guild.
+	OnAddGuildMember(member *discordgo.Member) error
+
+	// OnRemoveGuildMember is called when a user leaves (or is kicked from) a guild.
+	OnRemoveGuildMember(member *discordgo.Member) error
+
+	// OnUpdateGuildMember is called when a member is updated (e.g. roles or nickname).
+	// before is the previous version of the member (with the old roles and nickname), or nil if it wasn't cached.
+	OnUpdateGuildMember(member *discordgo.Member, before *discordgo.Member) error
+
+	// OnAddGuildBan is called when a user is banned from a guild.
+	OnAddGuildBan(ban *discordgo.GuildBanAdd) error
+
+	// OnRemoveGuildBan is called when a user is unbanned from a guild.
+	OnRemoveGuildBan(ban *discordgo.GuildBanRemove) error
+
+	// OnVoiceStateUpdate is called when a user joins, leaves, moves between or mutes in voice channels
+	// (requires PermissionVoiceState). state.ChannelID is the channel after the update (empty if the user left),
+	// and before is the previous voice state, or nil if it wasn't cached.
+	OnVoiceStateUpdate(state *discordgo.VoiceState, before *discordgo.VoiceState) error
+
+	// OnReady is called when the bot (re)connected to the gateway with a new session.
+	// Only the User, SessionID and Guilds (where the module is enabled, with their ID only) of ready are set.
+	OnReady(ready *discordgo.Ready) error
+
+	// OnResumed is called when the bot resumed its session after reconnecting to the gateway.
+	// Events that happened while disconnected are replayed after it.
+	OnResumed() error
+
+	// OnDisconnect is called when the bot lost its connection to the gateway.
+	// No events are received until OnReady or OnResumed.
+	OnDisconnect() error
+
+	// OnCreateGuild is called when a guild becomes available: when the bot joins a guild,
+	// on startup for each guild (after OnReady), and when a guild recovers from an outage.
+	OnCreateGuild(guild *discordgo.Guild) error
+
+	// OnUpdateGuild is called when a guild is updated (e.g. renamed).
+	OnUpdateGuild(guild *discordgo.Guild) error
+
+	// OnDeleteGuild is called when the bot leaves (or is removed from) a guild, or when the guild
+	// becomes unavailable because of an outage (guild.Unavailable is true in that case).
+	// before is the guild as it was cached, or nil.
+	OnDeleteGuild(guild *discordgo.Guild, before *discordgo.Guild) error
+
+	// OnCreateInteraction is called when a user uses a command or a component, or submits a modal.
+	//
+	// The returned response (if not nil) is sent by the runtime, which saves a round trip compared to
+	// Helper.InteractionRespond. If the hook takes longer than the runtime's budget, the runtime
+	// defers the interaction first, and the response then edits the deferred one.
+	//
+	// Autocompletes are also passed here, unless the hook implements AutocompleteHook.
+	OnCreateInteraction(interaction *discordgo.Interaction) (*discordgo.InteractionResponse, error)
+
+	// OnEvent is called for gateway events that have no dedicated hook (channels, threads, roles,
+	// presences, typing, invites, scheduled events, auto moderation, ...).
+	//
+	// event.Struct is the typed discordgo event (e.g. *discordgo.ChannelCreate) when the proto
+	// models its type, otherwise it's nil and event.RawData has the JSON payload.
+	OnEvent(event *discordgo.Event) error
 }
 
 // RuntimeClients represents the client interfaces for runtime
@@ -77,6 +184,10 @@ type Helper interface {
 
 	// Voice operations
 	VoiceRegions() ([]*discordgo.VoiceRegion, error)
+	GuildVoiceStates(guildID string) ([]*discordgo.VoiceState, error) // Users currently in the voice channels of a guild
+
+	// File operations
+	AttachmentDownload(attachment *discordgo.MessageAttachment) (*Download, error) // Content of an attachment, fetched by the runtime (by its ID if cached, else its URL)
 
 	// Webhook operations
 	WebhookCreate(channelID, name, avatar string) (*discordgo.Webhook, error)
@@ -104,10 +215,86 @@ func (u *AbstractHooks) OnCreateChatMessage(m *discordgo.Message) error {
 	return nil
 }
 
-func (u *AbstractHooks) OnCreateInteraction(i *discordgo.Interaction) error {
+func (u *AbstractHooks) OnUpdateChatMessage(m *discordgo.Message, before *discordgo.Message) error {
+	return nil
+}
+
+func (u *AbstractHooks) OnDeleteChatMessage(m *discordgo.Message, before *discordgo.Message) error {
+	return nil
+}
+
+func (u *AbstractHooks) OnBulkDeleteChatMessages(b *discordgo.MessageDeleteBulk, before []*discordgo.Message) error {
+	return nil
+}
+
+func (u *AbstractHooks) OnAddReaction(r *discordgo.MessageReactionAdd) error {
+	return nil
+}
+
+func (u *AbstractHooks) OnRemoveReaction(r *discordgo.MessageReactionRemove) error {
+	return nil
+}
+
+func (u *AbstractHooks) OnRemoveAllReactions(r *discordgo.MessageReactionRemoveAll) error {
+	return nil
+}
+
+func (u *AbstractHooks) OnRemoveEmojiReactions(r *discordgo.MessageReaction) error {
+	return nil
+}
+
+func (u *AbstractHooks) OnAddGuildMember(m *discordgo.Member) error {
+	return nil
+}
+
+func (u *AbstractHooks) OnRemoveGuildMember(m *discordgo.Member) error {
+	return nil
+}
+
+func (u *AbstractHooks) OnUpdateGuildMember(m *discordgo.Member, before *discordgo.Member) error {
+	return nil
+}
+
+func (u *AbstractHooks) OnAddGuildBan(b *discordgo.GuildBanAdd) error {
+	return nil
+}
+
+func (u *AbstractHooks) OnRemoveGuildBan(b *discordgo.GuildBanRemove) error {
+	return nil
+}
+
+func (u *AbstractHooks) OnVoiceStateUpdate(s *discordgo.VoiceState, before *discordgo.VoiceState) error {
+	return nil
+}
+
+func (u *AbstractHooks) OnReady(r *discordgo.Ready) error {
 	return nil
 }
 
-func (u *AbstractHooks) OnEvent(e string) error {
+func (u *AbstractHooks) OnResumed() error {
+	return nil
+}
+
+func (u *AbstractHooks) OnDisconnect() error {
+	return nil
+}
+
+func (u *AbstractHooks) OnCreateGuild(g *discordgo.Guild) error {
+	return nil
+}
+
+func (u *AbstractHooks) OnUpdateGuild(g *discordgo.Guild) error {
+	return nil
+}
+
+func (u *AbstractHooks) OnDeleteGuild(g *discordgo.Guild, before *discordgo.Guild) error {
+	return nil
+}
+
+func (u *AbstractHooks) OnCreateInteraction(i *discordgo.Interaction) (*discordgo.InteractionResponse, error) {
+	return nil, nil
+}
+
+func (u *AbstractHooks) OnEvent(e *discordgo.Event) error {
 	return nil
 }
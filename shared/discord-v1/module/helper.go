@@ -1,7 +1,10 @@
 package module
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
 
 	"github.com/bwmarrin/discordgo"
 	plugin "github.com/hashicorp/go-plugin"
@@ -16,6 +19,99 @@ import (
 type HelperClientImpl struct {
 	broker *plugin.GRPCBroker
 	client proto.HelperClient
+	ctx    context.Context // Context of the calls (see WithContext), Background if nil
+}
+
+// WithContext returns a copy of the client whose calls are made with ctx.
+// Cancelling ctx cancels the calls in progress on the runtime as well.
+func (h *HelperClientImpl) WithContext(ctx context.Context) shared.Helper {
+	c := *h
+	c.ctx = ctx
+	return &c
+}
+
+func (h *HelperClientImpl) callContext() context.Context {
+	if h.ctx == nil {
+		return context.Background()
+	}
+	return h.ctx
+}
+
+// inlineFileLimit is the total size of the files sent inline with a call. The files beyond it are
+// uploaded with UploadFile first, keeping the call under gRPC's message size limit.
+const inlineFileLimit = 1 << 20
+
+// uploadChunkSize is the size of the chunks of the uploaded files.
+const uploadChunkSize = 64 << 10
+
+// files converts the files of a call with their content, reading them.
+func (h *HelperClientImpl) files(files []*discordgo.File) ([]*proto.File, error) {
+	result := make([]*proto.File, 0, len(files))
+	inlined := 0
+	for _, file := range files {
+		buf := &proto.File{
+			Name:        file.Name,
+			ContentType: file.ContentType,
+		}
+		result = append(result, buf)
+		if file.Reader == nil {
+			continue
+		}
+
+		// Read one more byte than the remaining inline size to detect larger files
+		data, err := io.ReadAll(io.LimitReader(file.Reader, int64(inlineFileLimit-inlined)+1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file %s: %w", file.Name, err)
+		}
+		if inlined+len(data) <= inlineFileLimit {
+			buf.Data = data
+			inlined += len(data)
+			continue
+		}
+
+		buf.UploadId, err = h.upload(io.MultiReader(bytes.NewReader(data), file.Reader))
+		if err != nil {
+			return nil, fmt.Errorf("failed to upload file %s: %w", file.Name, err)
+		}
+	}
+	return result, nil
+}
+
+// upload streams a file to the runtime, and returns its upload ID.
+func (h *HelperClientImpl) upload(r io.Reader) (string, error) {
+	// Cancelling the stream drops the upload if the file can't be read
+	ctx, cancel := context.WithCancel(h.callContext())
+	defer cancel()
+
+	stream, err := h.client.UploadFile(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	chunk := make([]byte, uploadChunkSize)
+	for {
+		n, err := r.Read(chunk)
+		if n > 0 {
+			// On io.EOF, the runtime closed the stream and CloseAndRecv returns its error
+			if err := stream.Send(&proto.UploadFileChunk{Data: chunk[:n]}); err == io.EOF {
+				break
+			} else if err != nil {
+				return "", err
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return "", err
+	}
+	return resp.UploadId, nil
 }
 
 // ================================================
@@ -24,7 +120,7 @@ type HelperClientImpl struct {
 
 // ChannelMessageSend sends a simple text message to a channel.
 func (h *HelperClientImpl) ChannelMessageSend(channelID string, content string) (*discordgo.Message, error) {
-	resp, err := h.client.ChannelMessageSend(context.Background(), &proto.ChannelMessageSendRequest{
+	resp, err := h.client.ChannelMessageSend(h.callContext(), &proto.ChannelMessageSendRequest{
 		ChannelId: channelID,
 		Content:   content,
 	})
@@ -36,9 +132,18 @@ func (h *HelperClientImpl) ChannelMessageSend(channelID string, content string)
 
 // ChannelMessageSendComplex sends a complex message with attachments, embeds, etc.
 func (h *HelperClientImpl) ChannelMessageSendComplex(channelID string, data *discordgo.MessageSend) (*discordgo.Message, error) {
-	resp, err := h.client.ChannelMessageSendComplex(context.Background(), &proto.ChannelMessageSendComplexRequest{
+	buf := struct2buf.MessageSend(data)
+	if data != nil {
+		files, err := h.files(data.Files)
+		if err != nil {
+			return nil, err
+		}
+		buf.Files = files
+	}
+
+	resp, err := h.client.ChannelMessageSendComplex(h.callContext(), &proto.ChannelMessageSendComplexRequest{
 		ChannelId: channelID,
-		Data:      struct2buf.MessageSend(data),
+		Data:      buf,
 	})
 	if err != nil {
 		return nil, err
@@ -48,7 +153,7 @@ func (h *HelperClientImpl) ChannelMessageSendComplex(channelID string, data *dis
 
 // ChannelMessageSendEmbed sends a message with a single embed.
 func (h *HelperClientImpl) ChannelMessageSendEmbed(channelID string, embed *discordgo.MessageEmbed) (*discordgo.Message, error) {
-	resp, err := h.client.ChannelMessageSendEmbed(context.Background(), &proto.ChannelMessageSendEmbedRequest{
+	resp, err := h.client.ChannelMessageSendEmbed(h.callContext(), &proto.ChannelMessageSendEmbedRequest{
 		ChannelId: channelID,
 		Embed:     struct2buf.MessageEmbed(embed),
 	})
@@ -65,7 +170,7 @@ func (h *HelperClientImpl) ChannelMessageSendEmbeds(channelID string, embeds []*
 		protoEmbeds = append(protoEmbeds, struct2buf.MessageEmbed(embed))
 	}
 
-	resp, err := h.client.ChannelMessageSendEmbeds(context.Background(), &proto.ChannelMessageSendEmbedsRequest{
+	resp, err := h.client.ChannelMessageSendEmbeds(h.callContext(), &proto.ChannelMessageSendEmbedsRequest{
 		ChannelId: channelID,
 		Embeds:    protoEmbeds,
 	})
@@ -77,7 +182,7 @@ func (h *HelperClientImpl) ChannelMessageSendEmbeds(channelID string, embeds []*
 
 // ChannelMessageEdit edits a message with simple text content.
 func (h *HelperClientImpl) ChannelMessageEdit(channelID, messageID, content string) (*discordgo.Message, error) {
-	resp, err := h.client.ChannelMessageEdit(context.Background(), &proto.ChannelMessageEditRequest{
+	resp, err := h.client.ChannelMessageEdit(h.callContext(), &proto.ChannelMessageEditRequest{
 		ChannelId: channelID,
 		MessageId: messageID,
 		Content:   content,
@@ -90,8 +195,17 @@ func (h *HelperClientImpl) ChannelMessageEdit(channelID, messageID, content stri
 
 // ChannelMessageEditComplex edits a message with complex data.
 func (h *HelperClientImpl) ChannelMessageEditComplex(m *discordgo.MessageEdit) (*discordgo.Message, error) {
-	resp, err := h.client.ChannelMessageEditComplex(context.Background(), &proto.ChannelMessageEditComplexRequest{
-		MessageEdit: struct2buf.MessageEdit(m),
+	buf := struct2buf.MessageEdit(m)
+	if m != nil {
+		files, err := h.files(m.Files)
+		if err != nil {
+			return nil, err
+		}
+		buf.Files = files
+	}
+
+	resp, err := h.client.ChannelMessageEditComplex(h.callContext(), &proto.ChannelMessageEditComplexRequest{
+		MessageEdit: buf,
 	})
 	if err != nil {
 		return nil, err
@@ -101,7 +215,7 @@ func (h *HelperClientImpl) ChannelMessageEditComplex(m *discordgo.MessageEdit) (
 
 // ChannelMessageDelete deletes a message from a channel.
 func (h *HelperClientImpl) ChannelMessageDelete(channelID, messageID string) error {
-	_, err := h.client.ChannelMessageDelete(context.Background(), &proto.ChannelMessageDeleteRequest{
+	_, err := h.client.ChannelMessageDelete(h.callContext(), &proto.ChannelMessageDeleteRequest{
 		ChannelId: channelID,
 		MessageId: messageID,
 	})
@@ -110,7 +224,7 @@ func (h *HelperClientImpl) ChannelMessageDelete(channelID, messageID string) err
 
 // ChannelMessages retrieves multiple messages from a channel.
 func (h *HelperClientImpl) ChannelMessages(channelID string, limit int, beforeID, afterID, aroundID string) ([]*discordgo.Message, error) {
-	resp, err := h.client.ChannelMessages(context.Background(), &proto.ChannelMessagesRequest{
+	resp, err := h.client.ChannelMessages(h.callContext(), &proto.ChannelMessagesRequest{
 		ChannelId: channelID,
 		Limit:     int32(limit),
 		BeforeId:  beforeID,
@@ -130,7 +244,7 @@ func (h *HelperClientImpl) ChannelMessages(channelID string, limit int, beforeID
 
 // ChannelMessage retrieves a single message from a channel.
 func (h *HelperClientImpl) ChannelMessage(channelID, messageID string) (*discordgo.Message, error) {
-	resp, err := h.client.ChannelMessage(context.Background(), &proto.ChannelMessageRequest{
+	resp, err := h.client.ChannelMessage(h.callContext(), &proto.ChannelMessageRequest{
 		ChannelId: channelID,
 		MessageId: messageID,
 	})
@@ -146,7 +260,7 @@ func (h *HelperClientImpl) ChannelMessage(channelID, messageID string) (*discord
 
 // Channel retrieves information about a channel.
 func (h *HelperClientImpl) Channel(channelID string) (*discordgo.Channel, error) {
-	resp, err := h.client.Channel(context.Background(), &proto.ChannelRequest{
+	resp, err := h.client.Channel(h.callContext(), &proto.ChannelRequest{
 		ChannelId: channelID,
 	})
 	if err != nil {
@@ -158,7 +272,7 @@ func (h *HelperClientImpl) Channel(channelID string) (*discordgo.Channel, error)
 
 // ChannelEdit modifies a channel's properties.
 func (h *HelperClientImpl) ChannelEdit(channelID string, data *discordgo.ChannelEdit) (*discordgo.Channel, error) {
-	resp, err := h.client.ChannelEdit(context.Background(), &proto.ChannelEditRequest{
+	resp, err := h.client.ChannelEdit(h.callContext(), &proto.ChannelEditRequest{
 		ChannelId: channelID,
 		Data:      struct2buf.ChannelEdit(data),
 	})
@@ -171,7 +285,7 @@ func (h *HelperClientImpl) ChannelEdit(channelID string, data *discordgo.Channel
 
 // ChannelDelete deletes a channel.
 func (h *HelperClientImpl) ChannelDelete(channelID string) (*discordgo.Channel, error) {
-	resp, err := h.client.ChannelDelete(context.Background(), &proto.ChannelDeleteRequest{
+	resp, err := h.client.ChannelDelete(h.callContext(), &proto.ChannelDeleteRequest{
 		ChannelId: channelID,
 	})
 	if err != nil {
@@ -183,7 +297,7 @@ func (h *HelperClientImpl) ChannelDelete(channelID string) (*discordgo.Channel,
 
 // ChannelTyping triggers typing indicator in a channel.
 func (h *HelperClientImpl) ChannelTyping(channelID string) error {
-	_, err := h.client.ChannelTyping(context.Background(), &proto.ChannelTypingRequest{
+	_, err := h.client.ChannelTyping(h.callContext(), &proto.ChannelTypingRequest{
 		ChannelId: channelID,
 	})
 	return err
@@ -191,7 +305,7 @@ func (h *HelperClientImpl) ChannelTyping(channelID string) error {
 
 // Guild retrieves information about a guild.
 func (h *HelperClientImpl) Guild(guildID string) (*discordgo.Guild, error) {
-	resp, err := h.client.Guild(context.Background(), &proto.GuildRequest{
+	resp, err := h.client.Guild(h.callContext(), &proto.GuildRequest{
 		GuildId: guildID,
 	})
 	if err != nil {
@@ -203,7 +317,7 @@ func (h *HelperClientImpl) Guild(guildID string) (*discordgo.Guild, error) {
 
 // GuildChannels retrieves all channels in a guild.
 func (h *HelperClientImpl) GuildChannels(guildID string) ([]*discordgo.Channel, error) {
-	resp, err := h.client.GuildChannels(context.Background(), &proto.GuildChannelsRequest{
+	resp, err := h.client.GuildChannels(h.callContext(), &proto.GuildChannelsRequest{
 		GuildId: guildID,
 	})
 	if err != nil {
@@ -220,7 +334,7 @@ func (h *HelperClientImpl) GuildChannels(guildID string) ([]*discordgo.Channel,
 
 // GuildMembers retrieves guild members.
 func (h *HelperClientImpl) GuildMembers(guildID string, after string, limit int) ([]*discordgo.Member, error) {
-	resp, err := h.client.GuildMembers(context.Background(), &proto.GuildMembersRequest{
+	resp, err := h.client.GuildMembers(h.callContext(), &proto.GuildMembersRequest{
 		GuildId: guildID,
 		After:   after,
 		Limit:   int32(limit),
@@ -239,7 +353,7 @@ func (h *HelperClientImpl) GuildMembers(guildID string, after string, limit int)
 
 // GuildMember retrieves a specific guild member.
 func (h *HelperClientImpl) GuildMember(guildID, userID string) (*discordgo.Member, error) {
-	resp, err := h.client.GuildMember(context.Background(), &proto.GuildMemberRequest{
+	resp, err := h.client.GuildMember(h.callContext(), &proto.GuildMemberRequest{
 		GuildId: guildID,
 		UserId:  userID,
 	})
@@ -252,7 +366,7 @@ func (h *HelperClientImpl) GuildMember(guildID, userID string) (*discordgo.Membe
 
 // GuildRoles retrieves all roles in a guild.
 func (h *HelperClientImpl) GuildRoles(guildID string) ([]*discordgo.Role, error) {
-	resp, err := h.client.GuildRoles(context.Background(), &proto.GuildRolesRequest{
+	resp, err := h.client.GuildRoles(h.callContext(), &proto.GuildRolesRequest{
 		GuildId: guildID,
 	})
 	if err != nil {
@@ -269,7 +383,7 @@ func (h *HelperClientImpl) GuildRoles(guildID string) ([]*discordgo.Role, error)
 
 // User retrieves information about a user.
 func (h *HelperClientImpl) User(userID string) (*discordgo.User, error) {
-	resp, err := h.client.User(context.Background(), &proto.UserRequest{
+	resp, err := h.client.User(h.callContext(), &proto.UserRequest{
 		UserId: userID,
 	})
 	if err != nil {
@@ -281,7 +395,7 @@ func (h *HelperClientImpl) User(userID string) (*discordgo.User, error) {
 
 // UserChannelCreate creates a DM channel with a user.
 func (h *HelperClientImpl) UserChannelCreate(recipientID string) (*discordgo.Channel, error) {
-	resp, err := h.client.UserChannelCreate(context.Background(), &proto.UserChannelCreateRequest{
+	resp, err := h.client.UserChannelCreate(h.callContext(), &proto.UserChannelCreateRequest{
 		RecipientId: recipientID,
 	})
 	if err != nil {
@@ -293,18 +407,45 @@ func (h *HelperClientImpl) UserChannelCreate(recipientID string) (*discordgo.Cha
 
 // InteractionRespond responds to an interaction.
 func (h *HelperClientImpl) InteractionRespond(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse) error {
-	_, err := h.client.InteractionRespond(context.Background(), &proto.InteractionRespondRequest{
+	buf, err := h.interactionResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.client.InteractionRespond(h.callContext(), &proto.InteractionRespondRequest{
 		Interaction: struct2buf.Interaction(interaction),
-		Response:    struct2buf.InteractionResponse(resp),
+		Response:    buf,
 	})
 	return err
 }
 
+// interactionResponse converts an interaction response with the content of its files.
+func (h *HelperClientImpl) interactionResponse(resp *discordgo.InteractionResponse) (*proto.InteractionResponse, error) {
+	buf := struct2buf.InteractionResponse(resp)
+	if resp != nil && resp.Data != nil {
+		files, err := h.files(resp.Data.Files)
+		if err != nil {
+			return nil, err
+		}
+		buf.Data.Files = files
+	}
+	return buf, nil
+}
+
 // InteractionResponseEdit edits an interaction response.
 func (h *HelperClientImpl) InteractionResponseEdit(interaction *discordgo.Interaction, newresp *discordgo.WebhookEdit) (*discordgo.Message, error) {
-	resp, err := h.client.InteractionResponseEdit(context.Background(), &proto.InteractionResponseEditRequest{
+	edit := struct2buf.WebhookEdit(newresp)
+	if newresp != nil {
+		files, err := h.files(newresp.Files)
+		if err != nil {
+			return nil, err
+		}
+		edit.Files = files
+	}
+
+	resp, err := h.client.InteractionResponseEdit(h.callContext(), &proto.InteractionResponseEditRequest{
 		Interaction: struct2buf.Interaction(interaction),
-		WebhookEdit: struct2buf.WebhookEdit(newresp),
+		WebhookEdit: edit,
 	})
 	if err != nil {
 		return nil, err
@@ -315,7 +456,7 @@ func (h *HelperClientImpl) InteractionResponseEdit(interaction *discordgo.Intera
 
 // ApplicationCommandCreate creates a new application command.
 func (h *HelperClientImpl) ApplicationCommandCreate(appID string, guildID string, cmd *discordgo.ApplicationCommand) (*discordgo.ApplicationCommand, error) {
-	resp, err := h.client.ApplicationCommandCreate(context.Background(), &proto.ApplicationCommandCreateRequest{
+	resp, err := h.client.ApplicationCommandCreate(h.callContext(), &proto.ApplicationCommandCreateRequest{
 		AppId:   appID,
 		GuildId: guildID,
 		Command: struct2buf.ApplicationCommand(cmd),
@@ -329,7 +470,7 @@ func (h *HelperClientImpl) ApplicationCommandCreate(appID string, guildID string
 
 // ApplicationCommandEdit edits an existing application command.
 func (h *HelperClientImpl) ApplicationCommandEdit(appID, guildID, cmdID string, cmd *discordgo.ApplicationCommand) (*discordgo.ApplicationCommand, error) {
-	resp, err := h.client.ApplicationCommandEdit(context.Background(), &proto.ApplicationCommandEditRequest{
+	resp, err := h.client.ApplicationCommandEdit(h.callContext(), &proto.ApplicationCommandEditRequest{
 		AppId:   appID,
 		GuildId: guildID,
 		CmdId:   cmdID,
@@ -344,7 +485,7 @@ func (h *HelperClientImpl) ApplicationCommandEdit(appID, guildID, cmdID string,
 
 // ApplicationCommandDelete deletes an application command.
 func (h *HelperClientImpl) ApplicationCommandDelete(appID, guildID, cmdID string) error {
-	_, err := h.client.ApplicationCommandDelete(context.Background(), &proto.ApplicationCommandDeleteRequest{
+	_, err := h.client.ApplicationCommandDelete(h.callContext(), &proto.ApplicationCommandDeleteRequest{
 		AppId:   appID,
 		GuildId: guildID,
 		CmdId:   cmdID,
@@ -354,7 +495,7 @@ func (h *HelperClientImpl) ApplicationCommandDelete(appID, guildID, cmdID string
 
 // ApplicationCommands retrieves all application commands.
 func (h *HelperClientImpl) ApplicationCommands(appID, guildID string) ([]*discordgo.ApplicationCommand, error) {
-	resp, err := h.client.ApplicationCommands(context.Background(), &proto.ApplicationCommandsRequest{
+	resp, err := h.client.ApplicationCommands(h.callContext(), &proto.ApplicationCommandsRequest{
 		AppId:   appID,
 		GuildId: guildID,
 	})
@@ -372,7 +513,7 @@ func (h *HelperClientImpl) ApplicationCommands(appID, guildID string) ([]*discor
 
 // MessageReactionAdd adds a reaction to a message.
 func (h *HelperClientImpl) MessageReactionAdd(channelID, messageID, emojiID string) error {
-	_, err := h.client.MessageReactionAdd(context.Background(), &proto.MessageReactionAddRequest{
+	_, err := h.client.MessageReactionAdd(h.callContext(), &proto.MessageReactionAddRequest{
 		ChannelId: channelID,
 		MessageId: messageID,
 		EmojiId:   emojiID,
@@ -382,7 +523,7 @@ func (h *HelperClientImpl) MessageReactionAdd(channelID, messageID, emojiID stri
 
 // MessageReactionRemove removes a reaction from a message.
 func (h *HelperClientImpl) MessageReactionRemove(channelID, messageID, emojiID, userID string) error {
-	_, err := h.client.MessageReactionRemove(context.Background(), &proto.MessageReactionRemoveRequest{
+	_, err := h.client.MessageReactionRemove(h.callContext(), &proto.MessageReactionRemoveRequest{
 		ChannelId: channelID,
 		MessageId: messageID,
 		EmojiId:   emojiID,
@@ -393,7 +534,7 @@ func (h *HelperClientImpl) MessageReactionRemove(channelID, messageID, emojiID,
 
 // MessageReactionsRemoveAll removes all reactions from a message.
 func (h *HelperClientImpl) MessageReactionsRemoveAll(channelID, messageID string) error {
-	_, err := h.client.MessageReactionsRemoveAll(context.Background(), &proto.MessageReactionsRemoveAllRequest{
+	_, err := h.client.MessageReactionsRemoveAll(h.callContext(), &proto.MessageReactionsRemoveAllRequest{
 		ChannelId: channelID,
 		MessageId: messageID,
 	})
@@ -402,7 +543,7 @@ func (h *HelperClientImpl) MessageReactionsRemoveAll(channelID, messageID string
 
 // ThreadStart creates a new thread.
 func (h *HelperClientImpl) ThreadStart(channelID, name string, typ discordgo.ChannelType, archiveDuration int) (*discordgo.Channel, error) {
-	resp, err := h.client.ThreadStart(context.Background(), &proto.ThreadStartRequest{
+	resp, err := h.client.ThreadStart(h.callContext(), &proto.ThreadStartRequest{
 		ChannelId:       channelID,
 		Name:            name,
 		Type:            int32(typ),
@@ -417,7 +558,7 @@ func (h *HelperClientImpl) ThreadStart(channelID, name string, typ discordgo.Cha
 
 // ThreadJoin joins a thread.
 func (h *HelperClientImpl) ThreadJoin(threadID string) error {
-	_, err := h.client.ThreadJoin(context.Background(), &proto.ThreadJoinRequest{
+	_, err := h.client.ThreadJoin(h.callContext(), &proto.ThreadJoinRequest{
 		ThreadId: threadID,
 	})
 	return err
@@ -425,7 +566,7 @@ func (h *HelperClientImpl) ThreadJoin(threadID string) error {
 
 // ThreadLeave leaves a thread.
 func (h *HelperClientImpl) ThreadLeave(threadID string) error {
-	_, err := h.client.ThreadLeave(context.Background(), &proto.ThreadLeaveRequest{
+	_, err := h.client.ThreadLeave(h.callContext(), &proto.ThreadLeaveRequest{
 		ThreadId: threadID,
 	})
 	return err
@@ -433,7 +574,7 @@ func (h *HelperClientImpl) ThreadLeave(threadID string) error {
 
 // ThreadMemberAdd adds a member to a thread.
 func (h *HelperClientImpl) ThreadMemberAdd(threadID, memberID string) error {
-	_, err := h.client.ThreadMemberAdd(context.Background(), &proto.ThreadMemberAddRequest{
+	_, err := h.client.ThreadMemberAdd(h.callContext(), &proto.ThreadMemberAddRequest{
 		ThreadId: threadID,
 		MemberId: memberID,
 	})
@@ -442,7 +583,7 @@ func (h *HelperClientImpl) ThreadMemberAdd(threadID, memberID string) error {
 
 // ThreadMemberRemove removes a member from a thread.
 func (h *HelperClientImpl) ThreadMemberRemove(threadID, memberID string) error {
-	_, err := h.client.ThreadMemberRemove(context.Background(), &proto.ThreadMemberRemoveRequest{
+	_, err := h.client.ThreadMemberRemove(h.callContext(), &proto.ThreadMemberRemoveRequest{
 		ThreadId: threadID,
 		MemberId: memberID,
 	})
@@ -451,7 +592,7 @@ func (h *HelperClientImpl) ThreadMemberRemove(threadID, memberID string) error {
 
 // VoiceRegions retrieves available voice regions.
 func (h *HelperClientImpl) VoiceRegions() ([]*discordgo.VoiceRegion, error) {
-	resp, err := h.client.VoiceRegions(context.Background(), &proto.VoiceRegionsRequest{})
+	resp, err := h.client.VoiceRegions(h.callContext(), &proto.VoiceRegionsRequest{})
 	if err != nil {
 		return nil, err
 	}
@@ -464,9 +605,79 @@ func (h *HelperClientImpl) VoiceRegions() ([]*discordgo.VoiceRegion, error) {
 	return regions, nil
 }
 
+// GuildVoiceStates retrieves the voice states of the users in the voice channels of a guild.
+func (h *HelperClientImpl) GuildVoiceStates(guildID string) ([]*discordgo.VoiceState, error) {
+	resp, err := h.client.GuildVoiceStates(h.callContext(), &proto.GuildVoiceStatesRequest{
+		GuildId: guildID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var states []*discordgo.VoiceState
+	for _, protoState := range resp.VoiceStates {
+		states = append(states, buf2struct.VoiceState(protoState))
+	}
+
+	return states, nil
+}
+
+// AttachmentDownload downloads an attachment through the runtime. The content is streamed as it
+// is read, and the download must be closed.
+func (h *HelperClientImpl) AttachmentDownload(attachment *discordgo.MessageAttachment) (*shared.Download, error) {
+	// Closing the download cancels the stream
+	ctx, cancel := context.WithCancel(h.callContext())
+	stream, err := h.client.AttachmentDownload(ctx, &proto.AttachmentDownloadRequest{
+		Attachment: struct2buf.MessageAttachment(attachment),
+	})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The first chunk has the metadata, or the runtime's error
+	first, err := stream.Recv()
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	return &shared.Download{
+		ReadCloser:  &downloadReader{recv: stream.Recv, cancel: cancel},
+		ContentType: first.ContentType,
+		Size:        first.Size,
+	}, nil
+}
+
+// downloadReader reads the data of the chunks of a download stream.
+type downloadReader struct {
+	recv   func() (*proto.AttachmentDownloadChunk, error)
+	cancel context.CancelFunc
+	data   []byte
+}
+
+func (r *downloadReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		chunk, err := r.recv()
+		if err != nil {
+			return 0, err // io.EOF once the runtime sent the whole content
+		}
+		r.data = chunk.Data
+	}
+
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func (r *downloadReader) Close() error {
+	r.cancel()
+	return nil
+}
+
 // WebhookCreate creates a new webhook.
 func (h *HelperClientImpl) WebhookCreate(channelID, name, avatar string) (*discordgo.Webhook, error) {
-	resp, err := h.client.WebhookCreate(context.Background(), &proto.WebhookCreateRequest{
+	resp, err := h.client.WebhookCreate(h.callContext(), &proto.WebhookCreateRequest{
 		ChannelId: channelID,
 		Name:      name,
 		Avatar:    avatar,
@@ -480,11 +691,20 @@ func (h *HelperClientImpl) WebhookCreate(channelID, name, avatar string) (*disco
 
 // WebhookExecute executes a webhook.
 func (h *HelperClientImpl) WebhookExecute(webhookID, token string, wait bool, data *discordgo.WebhookParams) (*discordgo.Message, error) {
-	resp, err := h.client.WebhookExecute(context.Background(), &proto.WebhookExecuteRequest{
+	params := struct2buf.WebhookParams(data)
+	if data != nil {
+		files, err := h.files(data.Files)
+		if err != nil {
+			return nil, err
+		}
+		params.Files = files
+	}
+
+	resp, err := h.client.WebhookExecute(h.callContext(), &proto.WebhookExecuteRequest{
 		WebhookId: webhookID,
 		Token:     token,
 		Wait:      wait,
-		Data:      struct2buf.WebhookParams(data),
+		Data:      params,
 	})
 	if err != nil {
 		return nil, err
@@ -495,7 +715,7 @@ func (h *HelperClientImpl) WebhookExecute(webhookID, token string, wait bool, da
 
 // UserChannelPermissions retrieves user permissions for a channel.
 func (h *HelperClientImpl) UserChannelPermissions(userID, channelID string) (int64, error) {
-	resp, err := h.client.UserChannelPermissions(context.Background(), &proto.UserChannelPermissionsRequest{
+	resp, err := h.client.UserChannelPermissions(h.callContext(), &proto.UserChannelPermissionsRequest{
 		UserId:    userID,
 		ChannelId: channelID,
 	})
@@ -508,7 +728,7 @@ func (h *HelperClientImpl) UserChannelPermissions(userID, channelID string) (int
 
 // Gateway retrieves gateway URL.
 func (h *HelperClientImpl) Gateway() (string, error) {
-	resp, err := h.client.Gateway(context.Background(), &proto.GatewayRequest{})
+	resp, err := h.client.Gateway(h.callContext(), &proto.GatewayRequest{})
 	if err != nil {
 		return "", err
 	}
@@ -518,7 +738,7 @@ func (h *HelperClientImpl) Gateway() (string, error) {
 
 // GatewayBot retrieves gateway bot information.
 func (h *HelperClientImpl) GatewayBot() (*discordgo.GatewayBotResponse, error) {
-	resp, err := h.client.GatewayBot(context.Background(), &proto.GatewayBotRequest{})
+	resp, err := h.client.GatewayBot(h.callContext(), &proto.GatewayBotRequest{})
 	if err != nil {
 		return nil, err
 	}
@@ -527,4 +747,7 @@ func (h *HelperClientImpl) GatewayBot() (*discordgo.GatewayBotResponse, error) {
 }
 
 // Ensure HelperClientImpl implements the Helper interface
-var _ shared.Helper = &HelperClientImpl{}
+var (
+	_ shared.Helper        = &HelperClientImpl{}
+	_ shared.ContextHelper = &HelperClientImpl{}
+)
@@ -3,6 +3,7 @@ package module
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/bwmarrin/discordgo"
 	plugin "github.com/hashicorp/go-plugin"
@@ -11,6 +12,8 @@ import (
 	shared "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
 	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/convert/buf2struct"
 	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/convert/struct2buf"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 // `module/server.go` implements the gRPC server for receiving from the runtime.
@@ -20,15 +23,48 @@ type GRPCServer struct {
 	proto.UnimplementedHookServer
 	Impl   shared.Hook // Hook functions to be called from runtime (module developers must implement this!)
 	broker *plugin.GRPCBroker
-	helper shared.Helper // Helper service provided by runtime
+
+	// Helper service provided by runtime, and its connection. Both are replaced by OnInit when a
+	// new runtime reattaches, while the hooks of the previous one may still be running.
+	mu     sync.Mutex
+	helper *HelperClientImpl
+	conn   *grpc.ClientConn
+}
+
+// currentHelper returns the helper of the last OnInit, or nil.
+func (m *GRPCServer) currentHelper() *HelperClientImpl {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.helper
+}
+
+// impl returns Impl, bound to the context of a call if it implements shared.ContextHook.
+func (m *GRPCServer) impl(ctx context.Context) shared.Hook {
+	return shared.HookWithContext(m.Impl, ctx)
 }
 
 // OnInit is called when the discord plugin is initialized.
 func (m *GRPCServer) OnInit(ctx context.Context, req *proto.InitRequest) (*proto.InitResponse, error) {
-	// Dial the broker server using the helper server ID provided by runtime
-	conn, err := m.broker.Dial(req.HelperServerId)
-	if err != nil {
-		return nil, fmt.Errorf("failed to dial runtime helper server (ID %d): %w", req.HelperServerId, err)
+	var conn *grpc.ClientConn
+	var err error
+	if req.HelperServerAddress != "" {
+		// The runtime reattached to us after a restart, and our broker only served the previous
+		// runtime. So dial the helper server directly at the address provided by runtime.
+		target := "passthrough:///" + req.HelperServerAddress
+		if req.HelperServerNetwork == "unix" {
+			target = "unix://" + req.HelperServerAddress
+		}
+		conn, err = grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to dial runtime helper server (%s): %w", req.HelperServerAddress, err)
+		}
+	} else {
+		// Dial the broker server using the helper server ID provided by runtime
+		conn, err = m.broker.Dial(req.HelperServerId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dial runtime helper server (ID %d): %w", req.HelperServerId, err)
+		}
 	}
 
 	// Create Helper client using the connection to runtime's Helper server
@@ -41,8 +77,15 @@ func (m *GRPCServer) OnInit(ctx context.Context, req *proto.InitRequest) (*proto
 	// (runtime registers VoiceStreamServer in the same gRPC server)
 	voiceStreamClient := proto.NewVoiceStreamClient(conn)
 
-	// Store helper for later use and pass to hook implementation
-	m.helper = helperClient
+	// Store helper for later use and pass to hook implementation. The connection to a previous
+	// runtime is closed, as it's gone.
+	m.mu.Lock()
+	previous := m.conn
+	m.helper, m.conn = helperClient, conn
+	m.mu.Unlock()
+	if previous != nil {
+		previous.Close()
+	}
 
 	// Check if the module implements VoiceStreamAware interface
 	// If so, pass the VoiceStream client to it
@@ -62,30 +105,236 @@ func (m *GRPCServer) OnInit(ctx context.Context, req *proto.InitRequest) (*proto
 
 	return &proto.InitResponse{
 		Interactions: interactions,
+		Hooks:        shared.ImplementedHooks(m.Impl),
 	}, nil
 }
 
 // OnCreateChatMessage is called when a message is created from the runtime.
 func (m *GRPCServer) OnCreateMessage(ctx context.Context, req *proto.Message) (*proto_common.Empty, error) {
 	// Convert the protobuf message to a discordgo.Message struct
-	m.Impl.OnCreateChatMessage(buf2struct.Message(req))
+	m.impl(ctx).OnCreateChatMessage(buf2struct.Message(req))
 
 	// Hook function is not required to return anything to the client (runtime)
 	return &proto_common.Empty{}, nil
 }
 
-// OnCreateInteraction is called when an interaction is created from the runtime.
-func (m *GRPCServer) OnCreateInteraction(ctx context.Context, req *proto.Interaction) (*proto_common.Empty, error) {
-	// Convert the protobuf message to a discordgo.Interaction struct
-	m.Impl.OnCreateInteraction(buf2struct.Interaction(req))
+// OnUpdateMessage is called when a message is edited from the runtime.
+func (m *GRPCServer) OnUpdateMessage(ctx context.Context, req *proto.OnUpdateMessageRequest) (*proto_common.Empty, error) {
+	m.impl(ctx).OnUpdateChatMessage(buf2struct.Message(req.Message), buf2struct.Message(req.Before))
+
+	// Hook function is not required to return anything to the client (runtime)
+	return &proto_common.Empty{}, nil
+}
+
+// OnDeleteMessage is called when a message is deleted from the runtime.
+func (m *GRPCServer) OnDeleteMessage(ctx context.Context, req *proto.OnDeleteMessageRequest) (*proto_common.Empty, error) {
+	m.impl(ctx).OnDeleteChatMessage(buf2struct.Message(req.Message), buf2struct.Message(req.Before))
+
+	// Hook function is not required to return anything to the client (runtime)
+	return &proto_common.Empty{}, nil
+}
+
+// OnBulkDeleteMessages is called when messages are deleted at once from the runtime.
+func (m *GRPCServer) OnBulkDeleteMessages(ctx context.Context, req *proto.OnBulkDeleteMessagesRequest) (*proto_common.Empty, error) {
+	before := make([]*discordgo.Message, 0, len(req.Before))
+	for _, message := range req.Before {
+		before = append(before, buf2struct.Message(message))
+	}
+
+	m.impl(ctx).OnBulkDeleteChatMessages(&discordgo.MessageDeleteBulk{
+		Messages:  req.MessageIds,
+		ChannelID: req.ChannelId,
+		GuildID:   req.GuildId,
+	}, before)
+
+	// Hook function is not required to return anything to the client (runtime)
+	return &proto_common.Empty{}, nil
+}
+
+// OnAddReaction is called when a reaction is added from the runtime.
+func (m *GRPCServer) OnAddReaction(ctx context.Context, req *proto.OnAddReactionRequest) (*proto_common.Empty, error) {
+	m.impl(ctx).OnAddReaction(&discordgo.MessageReactionAdd{
+		MessageReaction: buf2struct.MessageReaction(req.Reaction),
+		Member:          buf2struct.Member(req.Member),
+	})
+
+	// Hook function is not required to return anything to the client (runtime)
+	return &proto_common.Empty{}, nil
+}
+
+// OnRemoveReaction is called when a reaction is removed from the runtime.
+func (m *GRPCServer) OnRemoveReaction(ctx context.Context, req *proto.MessageReaction) (*proto_common.Empty, error) {
+	m.impl(ctx).OnRemoveReaction(&discordgo.MessageReactionRemove{
+		MessageReaction: buf2struct.MessageReaction(req),
+	})
+
+	// Hook function is not required to return anything to the client (runtime)
+	return &proto_common.Empty{}, nil
+}
+
+// OnRemoveAllReactions is called when all reactions of a message are removed from the runtime.
+func (m *GRPCServer) OnRemoveAllReactions(ctx context.Context, req *proto.MessageReaction) (*proto_common.Empty, error) {
+	m.impl(ctx).OnRemoveAllReactions(&discordgo.MessageReactionRemoveAll{
+		MessageReaction: buf2struct.MessageReaction(req),
+	})
+
+	// Hook function is not required to return anything to the client (runtime)
+	return &proto_common.Empty{}, nil
+}
+
+// OnRemoveEmojiReactions is called when all reactions of an emoji are removed from the runtime.
+func (m *GRPCServer) OnRemoveEmojiReactions(ctx context.Context, req *proto.MessageReaction) (*proto_common.Empty, error) {
+	m.impl(ctx).OnRemoveEmojiReactions(buf2struct.MessageReaction(req))
+
+	// Hook function is not required to return anything to the client (runtime)
+	return &proto_common.Empty{}, nil
+}
+
+// OnAddGuildMember is called when a member joins a guild from the runtime.
+func (m *GRPCServer) OnAddGuildMember(ctx context.Context, req *proto.Member) (*proto_common.Empty, error) {
+	m.impl(ctx).OnAddGuildMember(buf2struct.Member(req))
+
+	// Hook function is not required to return anything to the client (runtime)
+	return &proto_common.Empty{}, nil
+}
+
+// OnRemoveGuildMember is called when a member leaves a guild from the runtime.
+func (m *GRPCServer) OnRemoveGuildMember(ctx context.Context, req *proto.Member) (*proto_common.Empty, error) {
+	m.impl(ctx).OnRemoveGuildMember(buf2struct.Member(req))
+
+	// Hook function is not required to return anything to the client (runtime)
+	return &proto_common.Empty{}, nil
+}
+
+// OnUpdateGuildMember is called when a member is updated from the runtime.
+func (m *GRPCServer) OnUpdateGuildMember(ctx context.Context, req *proto.OnUpdateGuildMemberRequest) (*proto_common.Empty, error) {
+	m.impl(ctx).OnUpdateGuildMember(buf2struct.Member(req.Member), buf2struct.Member(req.Before))
+
+	// Hook function is not required to return anything to the client (runtime)
+	return &proto_common.Empty{}, nil
+}
+
+// OnAddGuildBan is called when a user is banned from the runtime.
+func (m *GRPCServer) OnAddGuildBan(ctx context.Context, req *proto.GuildBanEvent) (*proto_common.Empty, error) {
+	m.impl(ctx).OnAddGuildBan(&discordgo.GuildBanAdd{GuildID: req.GuildId, User: buf2struct.User(req.User)})
+
+	// Hook function is not required to return anything to the client (runtime)
+	return &proto_common.Empty{}, nil
+}
+
+// OnRemoveGuildBan is called when a user is unbanned from the runtime.
+func (m *GRPCServer) OnRemoveGuildBan(ctx context.Context, req *proto.GuildBanEvent) (*proto_common.Empty, error) {
+	m.impl(ctx).OnRemoveGuildBan(&discordgo.GuildBanRemove{GuildID: req.GuildId, User: buf2struct.User(req.User)})
+
+	// Hook function is not required to return anything to the client (runtime)
+	return &proto_common.Empty{}, nil
+}
+
+// OnVoiceStateUpdate is called when a voice state is updated from the runtime.
+func (m *GRPCServer) OnVoiceStateUpdate(ctx context.Context, req *proto.OnVoiceStateUpdateRequest) (*proto_common.Empty, error) {
+	m.impl(ctx).OnVoiceStateUpdate(buf2struct.VoiceState(req.VoiceState), buf2struct.VoiceState(req.Before))
+
+	// Hook function is not required to return anything to the client (runtime)
+	return &proto_common.Empty{}, nil
+}
+
+// OnReady is called when the bot connected to the gateway from the runtime.
+func (m *GRPCServer) OnReady(ctx context.Context, req *proto.OnReadyRequest) (*proto_common.Empty, error) {
+	guilds := make([]*discordgo.Guild, 0, len(req.Guilds))
+	for _, guild := range req.Guilds {
+		guilds = append(guilds, buf2struct.Guild(guild))
+	}
+
+	m.impl(ctx).OnReady(&discordgo.Ready{
+		User:      buf2struct.User(req.User),
+		SessionID: req.SessionId,
+		Guilds:    guilds,
+	})
+
+	// Hook function is not required to return anything to the client (runtime)
+	return &proto_common.Empty{}, nil
+}
+
+// OnResumed is called when the bot resumed its gateway session from the runtime.
+func (m *GRPCServer) OnResumed(ctx context.Context, req *proto_common.Empty) (*proto_common.Empty, error) {
+	m.impl(ctx).OnResumed()
+
+	// Hook function is not required to return anything to the client (runtime)
+	return &proto_common.Empty{}, nil
+}
+
+// OnDisconnect is called when the bot disconnected from the gateway from the runtime.
+func (m *GRPCServer) OnDisconnect(ctx context.Context, req *proto_common.Empty) (*proto_common.Empty, error) {
+	m.impl(ctx).OnDisconnect()
+
+	// Hook function is not required to return anything to the client (runtime)
+	return &proto_common.Empty{}, nil
+}
+
+// OnCreateGuild is called when a guild becomes available from the runtime.
+func (m *GRPCServer) OnCreateGuild(ctx context.Context, req *proto.Guild) (*proto_common.Empty, error) {
+	m.impl(ctx).OnCreateGuild(buf2struct.Guild(req))
+
+	// Hook function is not required to return anything to the client (runtime)
+	return &proto_common.Empty{}, nil
+}
+
+// OnUpdateGuild is called when a guild is updated from the runtime.
+func (m *GRPCServer) OnUpdateGuild(ctx context.Context, req *proto.Guild) (*proto_common.Empty, error) {
+	m.impl(ctx).OnUpdateGuild(buf2struct.Guild(req))
+
+	// Hook function is not required to return anything to the client (runtime)
+	return &proto_common.Empty{}, nil
+}
+
+// OnDeleteGuild is called when a guild is deleted (or unavailable) from the runtime.
+func (m *GRPCServer) OnDeleteGuild(ctx context.Context, req *proto.OnDeleteGuildRequest) (*proto_common.Empty, error) {
+	m.impl(ctx).OnDeleteGuild(buf2struct.Guild(req.Guild), buf2struct.Guild(req.Before))
 
 	// Hook function is not required to return anything to the client (runtime)
 	return &proto_common.Empty{}, nil
 }
 
+// OnCreateInteraction is called when an interaction is created from the runtime.
+func (m *GRPCServer) OnCreateInteraction(ctx context.Context, req *proto.Interaction) (*proto.OnCreateInteractionResponse, error) {
+	// Convert the protobuf message to a discordgo.Interaction struct
+	interaction := buf2struct.Interaction(req)
+	impl := m.impl(ctx)
+
+	var resp *discordgo.InteractionResponse
+	var err error
+	if hook, ok := impl.(shared.AutocompleteHook); ok && interaction.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		var choices []*discordgo.ApplicationCommandOptionChoice
+		choices, err = hook.OnAutocomplete(interaction, shared.FocusedOption(interaction))
+		if err == nil {
+			resp = shared.AutocompleteResponse(choices)
+		}
+	} else {
+		resp, err = impl.OnCreateInteraction(interaction)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The runtime sends the response (if any) on behalf of the module, with the files uploaded
+	// through the helper
+	var buf *proto.InteractionResponse
+	if helper := m.currentHelper(); helper != nil {
+		buf, err = helper.WithContext(ctx).(*HelperClientImpl).interactionResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		buf = struct2buf.InteractionResponse(resp)
+	}
+	return &proto.OnCreateInteractionResponse{
+		Response: buf,
+	}, nil
+}
+
 // OnEvent is called when an (discord) event is created from the runtime.
-func (m *GRPCServer) OnEvent(ctx context.Context, req *proto.OnEventRequest) (*proto_common.Empty, error) {
-	m.Impl.OnEvent(req.Event)
+func (m *GRPCServer) OnEvent(ctx context.Context, req *proto.GatewayEvent) (*proto_common.Empty, error) {
+	m.impl(ctx).OnEvent(buf2struct.GatewayEvent(req))
 
 	// Hook function is not required to return anything to the client (runtime)
 	return &proto_common.Empty{}, nil
@@ -127,15 +376,169 @@ func (h *HookClient) OnCreateChatMessage(message *discordgo.Message) error {
 	return err
 }
 
-// OnCreateInteraction calls the runtime's OnCreateInteraction hook function
-func (h *HookClient) OnCreateInteraction(interaction *discordgo.Interaction) error {
-	_, err := h.client.OnCreateInteraction(context.Background(), struct2buf.Interaction(interaction))
+// OnUpdateChatMessage calls the runtime's OnUpdateChatMessage hook function
+func (h *HookClient) OnUpdateChatMessage(message *discordgo.Message, before *discordgo.Message) error {
+	_, err := h.client.OnUpdateMessage(context.Background(), &proto.OnUpdateMessageRequest{
+		Message: struct2buf.Message(message),
+		Before:  struct2buf.Message(before),
+	})
+	return err
+}
+
+// OnDeleteChatMessage calls the runtime's OnDeleteChatMessage hook function
+func (h *HookClient) OnDeleteChatMessage(message *discordgo.Message, before *discordgo.Message) error {
+	_, err := h.client.OnDeleteMessage(context.Background(), &proto.OnDeleteMessageRequest{
+		Message: struct2buf.Message(message),
+		Before:  struct2buf.Message(before),
+	})
+	return err
+}
+
+// OnBulkDeleteChatMessages calls the runtime's OnBulkDeleteChatMessages hook function
+func (h *HookClient) OnBulkDeleteChatMessages(bulk *discordgo.MessageDeleteBulk, before []*discordgo.Message) error {
+	messages := make([]*proto.Message, 0, len(before))
+	for _, message := range before {
+		messages = append(messages, struct2buf.Message(message))
+	}
+
+	_, err := h.client.OnBulkDeleteMessages(context.Background(), &proto.OnBulkDeleteMessagesRequest{
+		MessageIds: bulk.Messages,
+		ChannelId:  bulk.ChannelID,
+		GuildId:    bulk.GuildID,
+		Before:     messages,
+	})
+	return err
+}
+
+// OnAddReaction calls the runtime's OnAddReaction hook function
+func (h *HookClient) OnAddReaction(reaction *discordgo.MessageReactionAdd) error {
+	_, err := h.client.OnAddReaction(context.Background(), &proto.OnAddReactionRequest{
+		Reaction: struct2buf.MessageReaction(reaction.MessageReaction),
+		Member:   struct2buf.Member(reaction.Member),
+	})
+	return err
+}
+
+// OnRemoveReaction calls the runtime's OnRemoveReaction hook function
+func (h *HookClient) OnRemoveReaction(reaction *discordgo.MessageReactionRemove) error {
+	_, err := h.client.OnRemoveReaction(context.Background(), struct2buf.MessageReaction(reaction.MessageReaction))
+	return err
+}
+
+// OnRemoveAllReactions calls the runtime's OnRemoveAllReactions hook function
+func (h *HookClient) OnRemoveAllReactions(reaction *discordgo.MessageReactionRemoveAll) error {
+	_, err := h.client.OnRemoveAllReactions(context.Background(), struct2buf.MessageReaction(reaction.MessageReaction))
+	return err
+}
+
+// OnRemoveEmojiReactions calls the runtime's OnRemoveEmojiReactions hook function
+func (h *HookClient) OnRemoveEmojiReactions(reaction *discordgo.MessageReaction) error {
+	_, err := h.client.OnRemoveEmojiReactions(context.Background(), struct2buf.MessageReaction(reaction))
 	return err
 }
 
+// OnAddGuildMember calls the runtime's OnAddGuildMember hook function
+func (h *HookClient) OnAddGuildMember(member *discordgo.Member) error {
+	_, err := h.client.OnAddGuildMember(context.Background(), struct2buf.Member(member))
+	return err
+}
+
+// OnRemoveGuildMember calls the runtime's OnRemoveGuildMember hook function
+func (h *HookClient) OnRemoveGuildMember(member *discordgo.Member) error {
+	_, err := h.client.OnRemoveGuildMember(context.Background(), struct2buf.Member(member))
+	return err
+}
+
+// OnUpdateGuildMember calls the runtime's OnUpdateGuildMember hook function
+func (h *HookClient) OnUpdateGuildMember(member *discordgo.Member, before *discordgo.Member) error {
+	_, err := h.client.OnUpdateGuildMember(context.Background(), &proto.OnUpdateGuildMemberRequest{
+		Member: struct2buf.Member(member),
+		Before: struct2buf.Member(before),
+	})
+	return err
+}
+
+// OnAddGuildBan calls the runtime's OnAddGuildBan hook function
+func (h *HookClient) OnAddGuildBan(ban *discordgo.GuildBanAdd) error {
+	_, err := h.client.OnAddGuildBan(context.Background(), &proto.GuildBanEvent{GuildId: ban.GuildID, User: struct2buf.User(ban.User)})
+	return err
+}
+
+// OnRemoveGuildBan calls the runtime's OnRemoveGuildBan hook function
+func (h *HookClient) OnRemoveGuildBan(ban *discordgo.GuildBanRemove) error {
+	_, err := h.client.OnRemoveGuildBan(context.Background(), &proto.GuildBanEvent{GuildId: ban.GuildID, User: struct2buf.User(ban.User)})
+	return err
+}
+
+// OnVoiceStateUpdate calls the runtime's OnVoiceStateUpdate hook function
+func (h *HookClient) OnVoiceStateUpdate(state *discordgo.VoiceState, before *discordgo.VoiceState) error {
+	_, err := h.client.OnVoiceStateUpdate(context.Background(), &proto.OnVoiceStateUpdateRequest{
+		VoiceState: struct2buf.VoiceState(state),
+		Before:     struct2buf.VoiceState(before),
+	})
+	return err
+}
+
+// OnReady calls the runtime's OnReady hook function
+func (h *HookClient) OnReady(ready *discordgo.Ready) error {
+	guilds := make([]*proto.Guild, 0, len(ready.Guilds))
+	for _, guild := range ready.Guilds {
+		guilds = append(guilds, struct2buf.Guild(guild))
+	}
+
+	_, err := h.client.OnReady(context.Background(), &proto.OnReadyRequest{
+		User:      struct2buf.User(ready.User),
+		SessionId: ready.SessionID,
+		Guilds:    guilds,
+	})
+	return err
+}
+
+// OnResumed calls the runtime's OnResumed hook function
+func (h *HookClient) OnResumed() error {
+	_, err := h.client.OnResumed(context.Background(), &proto_common.Empty{})
+	return err
+}
+
+// OnDisconnect calls the runtime's OnDisconnect hook function
+func (h *HookClient) OnDisconnect() error {
+	_, err := h.client.OnDisconnect(context.Background(), &proto_common.Empty{})
+	return err
+}
+
+// OnCreateGuild calls the runtime's OnCreateGuild hook function
+func (h *HookClient) OnCreateGuild(guild *discordgo.Guild) error {
+	_, err := h.client.OnCreateGuild(context.Background(), struct2buf.Guild(guild))
+	return err
+}
+
+// OnUpdateGuild calls the runtime's OnUpdateGuild hook function
+func (h *HookClient) OnUpdateGuild(guild *discordgo.Guild) error {
+	_, err := h.client.OnUpdateGuild(context.Background(), struct2buf.Guild(guild))
+	return err
+}
+
+// OnDeleteGuild calls the runtime's OnDeleteGuild hook function
+func (h *HookClient) OnDeleteGuild(guild *discordgo.Guild, before *discordgo.Guild) error {
+	_, err := h.client.OnDeleteGuild(context.Background(), &proto.OnDeleteGuildRequest{
+		Guild:  struct2buf.Guild(guild),
+		Before: struct2buf.Guild(before),
+	})
+	return err
+}
+
+// OnCreateInteraction calls the runtime's OnCreateInteraction hook function
+func (h *HookClient) OnCreateInteraction(interaction *discordgo.Interaction) (*discordgo.InteractionResponse, error) {
+	resp, err := h.client.OnCreateInteraction(context.Background(), struct2buf.Interaction(interaction))
+	if err != nil {
+		return nil, err
+	}
+	return buf2struct.InteractionResponse(resp.Response), nil
+}
+
 // OnEvent calls the runtime's OnEvent hook function
-func (h *HookClient) OnEvent(eventType string) error {
-	_, err := h.client.OnEvent(context.Background(), &proto.OnEventRequest{Event: eventType})
+func (h *HookClient) OnEvent(event *discordgo.Event) error {
+	_, err := h.client.OnEvent(context.Background(), struct2buf.GatewayEvent(event))
 	return err
 }
 
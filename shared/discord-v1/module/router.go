@@ -0,0 +1,463 @@
+package module
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/bwmarrin/discordgo"
+	shared "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+)
+
+// ErrUnknownCommand is returned by Router.Dispatch for the commands without a handler.
+var ErrUnknownCommand = errors.New("unknown command")
+
+// Router declares the slash commands of a module and dispatches their interactions to typed handlers.
+//
+// Commands are declared with Command, with their options described by the fields of a struct:
+//
+//	type PlayOptions struct {
+//		URL    string             `option:"url,required" description:"URL of the song"`
+//		Volume *float64           `option:"volume,min=0,max=1" description:"Volume of the song"`
+//		Voice  *discordgo.Channel `option:"channel" description:"Voice channel to join"`
+//	}
+//
+//	router := module.NewRouter()
+//	module.Command(router, "play", "Play a song", func(h shared.Helper, i *discordgo.Interaction, options *PlayOptions) (*discordgo.InteractionResponse, error) {
+//		...
+//	})
+//
+// The "option" tag has the name of the option, then its flags: "required", "autocomplete", and
+// "min=N"/"max=N" (the value of numbers, or the length of strings in UTF-16 code units, as Discord
+// counts them). Fields without it are ignored.
+// Fields are strings, integers, floats, booleans, *discordgo.User, *discordgo.Member,
+// *discordgo.Channel, *discordgo.Role or *discordgo.MessageAttachment. Pointers to the basic types
+// are nil when the option isn't set.
+//
+// Subcommands are declared on a Group, which can itself have one level of groups.
+// Commands returns the commands to return in InitResponse, and Dispatch calls their handlers.
+// A router is meant to be built once, in OnInit: the handlers get the helper to use from Dispatch.
+type Router struct {
+	root  *Router
+	path  []string                               // Names from the command to this group, empty at the root
+	group *[]*discordgo.ApplicationCommandOption // Where the subcommands of the group are declared, nil at the root
+
+	commands []*discordgo.ApplicationCommand // At the root only
+	handlers map[string]commandHandler       // By path, at the root only
+}
+
+type commandHandler func(h shared.Helper, i *discordgo.Interaction, options []*discordgo.ApplicationCommandInteractionDataOption) (*discordgo.InteractionResponse, error)
+
+// NewRouter creates a router without commands.
+func NewRouter() *Router {
+	r := &Router{handlers: make(map[string]commandHandler)}
+	r.root = r
+	return r
+}
+
+// Commands returns the declared commands, to be returned in InitResponse.Interactions.
+// They can be changed (e.g. to set their permissions or localizations) before that.
+func (r *Router) Commands() []*discordgo.ApplicationCommand {
+	return r.root.commands
+}
+
+// Group declares a command (or a subcommand group in a command) whose subcommands are declared
+// on the returned router. Discord allows subcommand groups in commands only, so Group panics if
+// r is itself a subcommand group.
+func (r *Router) Group(name, description string) *Router {
+	group := &Router{root: r.root, path: append(slices.Clip(r.path), name)}
+	switch len(r.path) {
+	case 0:
+		command := &discordgo.ApplicationCommand{Name: name, Description: description}
+		r.root.commands = append(r.root.commands, command)
+		group.group = &command.Options
+	case 1:
+		option := &discordgo.ApplicationCommandOption{
+			Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+			Name:        name,
+			Description: description,
+		}
+		*r.group = append(*r.group, option)
+		group.group = &option.Options
+	default:
+		panic(fmt.Sprintf("module: subcommand group %s can't have groups", strings.Join(r.path, " ")))
+	}
+	return group
+}
+
+// Command declares a command (or a subcommand, on a Group) with the options of T, which must be
+// a struct (see Router). Invalid options are programming errors, so Command panics on them.
+//
+// handler is called by Router.Dispatch with its helper and the options of the interaction bound to T.
+func Command[T any](r *Router, name, description string, handler func(h shared.Helper, i *discordgo.Interaction, options *T) (*discordgo.InteractionResponse, error)) {
+	fields, err := optionFields(reflect.TypeFor[T]())
+	if err != nil {
+		panic(fmt.Sprintf("module: options of command %s: %v", strings.Join(append(slices.Clip(r.path), name), " "), err))
+	}
+
+	options := make([]*discordgo.ApplicationCommandOption, len(fields))
+	for i, field := range fields {
+		options[i] = field.option
+	}
+	// Discord rejects required options after optional ones
+	slices.SortStableFunc(options, func(a, b *discordgo.ApplicationCommandOption) int {
+		switch {
+		case a.Required == b.Required:
+			return 0
+		case a.Required:
+			return -1
+		default:
+			return 1
+		}
+	})
+
+	if r.group == nil {
+		r.root.commands = append(r.root.commands, &discordgo.ApplicationCommand{
+			Name:        name,
+			Description: description,
+			Options:     options,
+		})
+	} else {
+		*r.group = append(*r.group, &discordgo.ApplicationCommandOption{
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Name:        name,
+			Description: description,
+			Options:     options,
+		})
+	}
+
+	path := strings.Join(append(slices.Clip(r.path), name), " ")
+	r.root.handlers[path] = func(h shared.Helper, i *discordgo.Interaction, values []*discordgo.ApplicationCommandInteractionDataOption) (*discordgo.InteractionResponse, error) {
+		var bound T
+		if err := bindOptions(reflect.ValueOf(&bound).Elem(), fields, i, values); err != nil {
+			return EphemeralResponse("Invalid options: " + err.Error()), nil
+		}
+		return handler(h, i, &bound)
+	}
+}
+
+// Dispatch calls the handler of the command (or subcommand) of an application command interaction,
+// with h (usually the helper bound to the context of the hook, see shared.ContextHook).
+// The other interactions are left to the module, with no response and no error.
+//
+// If the options can't be bound (e.g. a value is out of range), the handler isn't called, and the
+// error is returned as an ephemeral response.
+func (r *Router) Dispatch(h shared.Helper, i *discordgo.Interaction) (*discordgo.InteractionResponse, error) {
+	data, ok := i.Data.(discordgo.ApplicationCommandInteractionData)
+	if !ok || i.Type != discordgo.InteractionApplicationCommand {
+		return nil, nil
+	}
+
+	// Subcommands (and their groups) come as a single option holding the options of the subcommand
+	path, options := []string{data.Name}, data.Options
+	for len(options) == 1 && (options[0].Type == discordgo.ApplicationCommandOptionSubCommand || options[0].Type == discordgo.ApplicationCommandOptionSubCommandGroup) {
+		path = append(path, options[0].Name)
+		options = options[0].Options
+	}
+
+	handler, ok := r.root.handlers[strings.Join(path, " ")]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCommand, strings.Join(path, " "))
+	}
+	return handler(h, i, options)
+}
+
+// EphemeralResponse returns a message response that only the user of the interaction can see.
+func EphemeralResponse(content string) *discordgo.InteractionResponse {
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	}
+}
+
+// optionField is a field of an options struct.
+type optionField struct {
+	index    int
+	option   *discordgo.ApplicationCommandOption
+	min, max *float64
+}
+
+var (
+	userType       = reflect.TypeFor[*discordgo.User]()
+	memberType     = reflect.TypeFor[*discordgo.Member]()
+	channelType    = reflect.TypeFor[*discordgo.Channel]()
+	roleType       = reflect.TypeFor[*discordgo.Role]()
+	attachmentType = reflect.TypeFor[*discordgo.MessageAttachment]()
+)
+
+// optionFields returns the options described by the tagged fields of typ.
+func optionFields(typ reflect.Type) ([]*optionField, error) {
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%s is not a struct", typ)
+	}
+
+	var fields []*optionField
+	for i := range typ.NumField() {
+		f := typ.Field(i)
+		tag, ok := f.Tag.Lookup("option")
+		if !ok || tag == "-" {
+			continue
+		}
+		if !f.IsExported() {
+			return nil, fmt.Errorf("field %s is not exported", f.Name)
+		}
+
+		name, flags, _ := strings.Cut(tag, ",")
+		optionType, err := optionTypeOf(f.Type)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.Name, err)
+		}
+		field := &optionField{
+			index: i,
+			option: &discordgo.ApplicationCommandOption{
+				Type:        optionType,
+				Name:        name,
+				Description: f.Tag.Get("description"),
+			},
+		}
+
+		for _, flag := range strings.Split(flags, ",") {
+			key, value, _ := strings.Cut(flag, "=")
+			switch key {
+			case "":
+			case "required":
+				field.option.Required = true
+			case "autocomplete":
+				field.option.Autocomplete = true
+			case "min", "max":
+				n, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return nil, fmt.Errorf("field %s: invalid %s %q", f.Name, key, value)
+				}
+				if key == "min" {
+					field.min = &n
+				} else {
+					field.max = &n
+				}
+			default:
+				return nil, fmt.Errorf("field %s: unknown flag %q", f.Name, key)
+			}
+		}
+		if err := field.limit(); err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.Name, err)
+		}
+
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// optionTypeOf returns the option type of the values of a field.
+func optionTypeOf(typ reflect.Type) (discordgo.ApplicationCommandOptionType, error) {
+	switch typ {
+	case userType, memberType:
+		return discordgo.ApplicationCommandOptionUser, nil
+	case channelType:
+		return discordgo.ApplicationCommandOptionChannel, nil
+	case roleType:
+		return discordgo.ApplicationCommandOptionRole, nil
+	case attachmentType:
+		return discordgo.ApplicationCommandOptionAttachment, nil
+	}
+
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	switch typ.Kind() {
+	case reflect.String:
+		return discordgo.ApplicationCommandOptionString, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return discordgo.ApplicationCommandOptionInteger, nil
+	case reflect.Float32, reflect.Float64:
+		return discordgo.ApplicationCommandOptionNumber, nil
+	case reflect.Bool:
+		return discordgo.ApplicationCommandOptionBoolean, nil
+	}
+	return 0, fmt.Errorf("unsupported type %s", typ)
+}
+
+// limit sets the limits of the option from min and max.
+func (f *optionField) limit() error {
+	if f.min == nil && f.max == nil {
+		return nil
+	}
+
+	switch f.option.Type {
+	case discordgo.ApplicationCommandOptionInteger, discordgo.ApplicationCommandOptionNumber:
+		f.option.MinValue = f.min
+		if f.max != nil {
+			f.option.MaxValue = *f.max
+		}
+	case discordgo.ApplicationCommandOptionString:
+		if f.min != nil {
+			minLength := int(*f.min)
+			f.option.MinLength = &minLength
+		}
+		if f.max != nil {
+			f.option.MaxLength = int(*f.max)
+		}
+	default:
+		return fmt.Errorf("min and max don't apply to %s options", f.option.Type)
+	}
+	return nil
+}
+
+// bindOptions sets the fields of v from the values of the options.
+func bindOptions(v reflect.Value, fields []*optionField, i *discordgo.Interaction, values []*discordgo.ApplicationCommandInteractionDataOption) error {
+	data := i.ApplicationCommandData()
+	for _, field := range fields {
+		var value *discordgo.ApplicationCommandInteractionDataOption
+		for _, o := range values {
+			if o.Name == field.option.Name {
+				value = o
+			}
+		}
+		if value == nil {
+			if field.option.Required {
+				return fmt.Errorf("option `%s` is required", field.option.Name)
+			}
+			continue
+		}
+
+		if err := field.bind(v.Field(field.index), value, data.Resolved); err != nil {
+			return fmt.Errorf("option `%s` %w", field.option.Name, err)
+		}
+	}
+	return nil
+}
+
+// bind sets the field dst to the value of an option, or returns why it can't (as in "option x <error>").
+func (f *optionField) bind(dst reflect.Value, o *discordgo.ApplicationCommandInteractionDataOption, resolved *discordgo.ApplicationCommandInteractionDataResolved) error {
+	if o.Type != f.option.Type {
+		return fmt.Errorf("must be of type %s", f.option.Type)
+	}
+
+	if resolved == nil {
+		resolved = &discordgo.ApplicationCommandInteractionDataResolved{}
+	}
+	id, _ := o.Value.(string)
+	switch dst.Type() {
+	case userType:
+		user := resolved.Users[id]
+		if user == nil {
+			user = &discordgo.User{ID: id}
+		}
+		dst.Set(reflect.ValueOf(user))
+		return nil
+	case memberType:
+		// The resolved members don't have their user, which is resolved separately
+		var member discordgo.Member
+		if resolved.Members[id] != nil {
+			member = *resolved.Members[id]
+		}
+		if member.User == nil {
+			member.User = resolved.Users[id]
+		}
+		if member.User == nil {
+			member.User = &discordgo.User{ID: id}
+		}
+		dst.Set(reflect.ValueOf(&member))
+		return nil
+	case channelType:
+		channel := resolved.Channels[id]
+		if channel == nil {
+			channel = &discordgo.Channel{ID: id}
+		}
+		dst.Set(reflect.ValueOf(channel))
+		return nil
+	case roleType:
+		role := resolved.Roles[id]
+		if role == nil {
+			role = &discordgo.Role{ID: id}
+		}
+		dst.Set(reflect.ValueOf(role))
+		return nil
+	case attachmentType:
+		attachment := resolved.Attachments[id]
+		if attachment == nil {
+			attachment = &discordgo.MessageAttachment{ID: id}
+		}
+		dst.Set(reflect.ValueOf(attachment))
+		return nil
+	}
+
+	if dst.Kind() == reflect.Pointer {
+		dst.Set(reflect.New(dst.Type().Elem()))
+		dst = dst.Elem()
+	}
+
+	switch dst.Kind() {
+	case reflect.String:
+		s, ok := o.Value.(string)
+		if !ok {
+			return errors.New("must be a string")
+		}
+		// Discord counts the length of strings in UTF-16 code units
+		if n := float64(len(utf16.Encode([]rune(s)))); (f.min != nil && n < *f.min) || (f.max != nil && n > *f.max) {
+			return fmt.Errorf("must be %s characters long", f.bounds())
+		}
+		dst.SetString(s)
+	case reflect.Bool:
+		b, ok := o.Value.(bool)
+		if !ok {
+			return errors.New("must be a boolean")
+		}
+		dst.SetBool(b)
+	default:
+		n, ok := number(o.Value)
+		if !ok {
+			return errors.New("must be a number")
+		}
+		if (f.min != nil && n < *f.min) || (f.max != nil && n > *f.max) {
+			return fmt.Errorf("must be %s", f.bounds())
+		}
+		switch dst.Kind() {
+		case reflect.Float32, reflect.Float64:
+			dst.SetFloat(n)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if n != math.Trunc(n) || dst.OverflowInt(int64(n)) {
+				return errors.New("is out of range")
+			}
+			dst.SetInt(int64(n))
+		default:
+			if n != math.Trunc(n) || n < 0 || dst.OverflowUint(uint64(n)) {
+				return errors.New("is out of range")
+			}
+			dst.SetUint(uint64(n))
+		}
+	}
+	return nil
+}
+
+// bounds describes the limits of the option, as in "between 1 and 10".
+func (f *optionField) bounds() string {
+	format := func(n float64) string { return strconv.FormatFloat(n, 'f', -1, 64) }
+	switch {
+	case f.min != nil && f.max != nil:
+		return fmt.Sprintf("between %s and %s", format(*f.min), format(*f.max))
+	case f.min != nil:
+		return fmt.Sprintf("at least %s", format(*f.min))
+	default:
+		return fmt.Sprintf("at most %s", format(*f.max))
+	}
+}
+
+// number returns the value of a numeric option, which discordgo decodes to float64.
+func number(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
@@ -0,0 +1,180 @@
+package module_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	shared "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/module"
+	"github.com/thirdscam/chatanium-flexmodule/shared/flextest"
+)
+
+type addOptions struct {
+	Position *int            `option:"position,min=1,max=50" description:"Position"`
+	Song     string          `option:"song,required,autocomplete,max=100" description:"Song"`
+	Volume   float64         `option:"volume" description:"Volume"`
+	User     *discordgo.User `option:"user" description:"Requested by"`
+	Ignored  string
+}
+
+func testRouter(t *testing.T, added *addOptions) *module.Router {
+	t.Helper()
+
+	r := module.NewRouter()
+	module.Command(r, "ping", "Ping", func(h shared.Helper, i *discordgo.Interaction, _ *struct{}) (*discordgo.InteractionResponse, error) {
+		return module.EphemeralResponse("pong"), nil
+	})
+	queue := r.Group("music", "Music commands").Group("queue", "Manage the queue")
+	module.Command(queue, "add", "Add a song", func(h shared.Helper, i *discordgo.Interaction, options *addOptions) (*discordgo.InteractionResponse, error) {
+		*added = *options
+		return nil, nil
+	})
+	return r
+}
+
+func TestRouterCommands(t *testing.T) {
+	commands := testRouter(t, &addOptions{}).Commands()
+	if len(commands) != 2 || commands[0].Name != "ping" || commands[1].Name != "music" {
+		t.Fatalf("got %#v", commands)
+	}
+
+	group := commands[1].Options
+	if len(group) != 1 || group[0].Type != discordgo.ApplicationCommandOptionSubCommandGroup || group[0].Name != "queue" {
+		t.Fatalf("group: got %#v", group)
+	}
+	add := group[0].Options
+	if len(add) != 1 || add[0].Type != discordgo.ApplicationCommandOptionSubCommand || add[0].Name != "add" {
+		t.Fatalf("subcommand: got %#v", add)
+	}
+
+	options := add[0].Options
+	if len(options) != 4 {
+		t.Fatalf("options: got %#v", options)
+	}
+	// Required options come first
+	song, position := options[0], options[1]
+	if song.Name != "song" || song.Type != discordgo.ApplicationCommandOptionString || !song.Required || !song.Autocomplete || song.MaxLength != 100 {
+		t.Errorf("song: got %#v", song)
+	}
+	if position.Name != "position" || position.Type != discordgo.ApplicationCommandOptionInteger || position.MinValue == nil || *position.MinValue != 1 || position.MaxValue != 50 {
+		t.Errorf("position: got %#v", position)
+	}
+	if options[2].Type != discordgo.ApplicationCommandOptionNumber || options[3].Type != discordgo.ApplicationCommandOptionUser {
+		t.Errorf("volume and user: got %#v, %#v", options[2], options[3])
+	}
+}
+
+func addInteraction(options ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.Interaction {
+	return &discordgo.Interaction{
+		Type: discordgo.InteractionApplicationCommand,
+		Data: discordgo.ApplicationCommandInteractionData{
+			Name: "music",
+			Options: []*discordgo.ApplicationCommandInteractionDataOption{
+				{Name: "queue", Type: discordgo.ApplicationCommandOptionSubCommandGroup, Options: []*discordgo.ApplicationCommandInteractionDataOption{
+					{Name: "add", Type: discordgo.ApplicationCommandOptionSubCommand, Options: options},
+				}},
+			},
+			Resolved: &discordgo.ApplicationCommandInteractionDataResolved{
+				Users: map[string]*discordgo.User{"111": {ID: "111", Username: "alice"}},
+			},
+		},
+	}
+}
+
+func TestRouterDispatch(t *testing.T) {
+	var added addOptions
+	r := testRouter(t, &added)
+
+	resp, err := r.Dispatch(nil, addInteraction(
+		// As unmarshaled by discordgo
+		&discordgo.ApplicationCommandInteractionDataOption{Name: "song", Type: discordgo.ApplicationCommandOptionString, Value: "never gonna"},
+		&discordgo.ApplicationCommandInteractionDataOption{Name: "position", Type: discordgo.ApplicationCommandOptionInteger, Value: float64(3)},
+		&discordgo.ApplicationCommandInteractionDataOption{Name: "user", Type: discordgo.ApplicationCommandOptionUser, Value: "111"},
+	))
+	if err != nil || resp != nil {
+		t.Fatalf("got %#v, %v", resp, err)
+	}
+	if added.Song != "never gonna" || added.Position == nil || *added.Position != 3 || added.Volume != 0 {
+		t.Errorf("options: got %#v", added)
+	}
+	if added.User == nil || added.User.Username != "alice" {
+		t.Errorf("user: got %#v", added.User)
+	}
+
+	resp, err = r.Dispatch(nil, &discordgo.Interaction{
+		Type: discordgo.InteractionApplicationCommand,
+		Data: discordgo.ApplicationCommandInteractionData{Name: "ping"},
+	})
+	if err != nil || resp == nil || resp.Data.Content != "pong" {
+		t.Errorf("ping: got %#v, %v", resp, err)
+	}
+
+	_, err = r.Dispatch(nil, &discordgo.Interaction{
+		Type: discordgo.InteractionApplicationCommand,
+		Data: discordgo.ApplicationCommandInteractionData{Name: "unknown"},
+	})
+	if !errors.Is(err, module.ErrUnknownCommand) {
+		t.Errorf("unknown command: got %v", err)
+	}
+
+	// The handlers get the helper passed to Dispatch
+	helper := flextest.NewHelper()
+	var got shared.Helper
+	r = module.NewRouter()
+	module.Command(r, "ping", "Ping", func(h shared.Helper, i *discordgo.Interaction, _ *struct{}) (*discordgo.InteractionResponse, error) {
+		got = h
+		return nil, nil
+	})
+	if _, err := r.Dispatch(helper, &discordgo.Interaction{
+		Type: discordgo.InteractionApplicationCommand,
+		Data: discordgo.ApplicationCommandInteractionData{Name: "ping"},
+	}); err != nil || got != helper {
+		t.Errorf("helper: got %v, %v", got, err)
+	}
+
+	// Other interactions are left to the module
+	resp, err = r.Dispatch(nil, &discordgo.Interaction{
+		Type: discordgo.InteractionMessageComponent,
+		Data: discordgo.MessageComponentInteractionData{CustomID: "button"},
+	})
+	if err != nil || resp != nil {
+		t.Errorf("component: got %#v, %v", resp, err)
+	}
+}
+
+func TestRouterValidation(t *testing.T) {
+	for name, options := range map[string][]*discordgo.ApplicationCommandInteractionDataOption{
+		"missing": {},
+		"range": {
+			{Name: "song", Type: discordgo.ApplicationCommandOptionString, Value: "song"},
+			{Name: "position", Type: discordgo.ApplicationCommandOptionInteger, Value: float64(51)},
+		},
+		// 51 characters, but 102 UTF-16 code units
+		"length": {
+			{Name: "song", Type: discordgo.ApplicationCommandOptionString, Value: strings.Repeat("😀", 51)},
+		},
+		"type": {
+			{Name: "song", Type: discordgo.ApplicationCommandOptionInteger, Value: float64(1)},
+		},
+	} {
+		called := false
+		r := module.NewRouter()
+		queue := r.Group("music", "Music commands").Group("queue", "Manage the queue")
+		module.Command(queue, "add", "Add a song", func(h shared.Helper, i *discordgo.Interaction, options *addOptions) (*discordgo.InteractionResponse, error) {
+			called = true
+			return nil, nil
+		})
+
+		resp, err := r.Dispatch(nil, addInteraction(options...))
+		if err != nil || called {
+			t.Errorf("%s: got %v, called %v", name, err, called)
+		}
+		if resp == nil || resp.Data == nil || resp.Data.Flags != discordgo.MessageFlagsEphemeral {
+			t.Errorf("%s: got %#v", name, resp)
+		} else {
+			t.Logf("%s: %s", name, resp.Data.Content)
+		}
+	}
+}
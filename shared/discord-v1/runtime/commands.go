@@ -0,0 +1,364 @@
+package runtime
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandAPI is the part of the Discord API used to reconcile the application commands.
+// *discordgo.Session implements it.
+type CommandAPI interface {
+	ApplicationCommands(appID, guildID string, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error)
+	ApplicationCommandBulkOverwrite(appID string, guildID string, commands []*discordgo.ApplicationCommand, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error)
+}
+
+// CommandRegistry collects the application commands of every module, and reconciles the commands
+// registered on Discord with them (see Sync).
+//
+// Commands are registered in guilds, or globally with the guild "". A command name (of a given
+// command type) belongs to a single module: it can't be registered by another module in the same
+// guild, nor in any guild if it's global.
+type CommandRegistry struct {
+	mu       sync.Mutex
+	commands map[string]map[commandKey]*registeredCommand // By guild ("" for global)
+	synced   map[string]bool                              // Guilds whose commands were synced since they last changed
+	remote   map[string]bool                              // Guilds with commands on Discord, as of their last sync
+}
+
+type commandKey struct {
+	typ  discordgo.ApplicationCommandType
+	name string
+}
+
+type registeredCommand struct {
+	owner   string
+	command *discordgo.ApplicationCommand
+}
+
+// NewCommandRegistry creates an empty registry.
+func NewCommandRegistry() *CommandRegistry {
+	return &CommandRegistry{
+		commands: make(map[string]map[commandKey]*registeredCommand),
+		synced:   make(map[string]bool),
+		remote:   make(map[string]bool),
+	}
+}
+
+func keyOf(command *discordgo.ApplicationCommand) commandKey {
+	typ := command.Type
+	if typ == 0 {
+		typ = discordgo.ChatApplicationCommand
+	}
+	return commandKey{typ: typ, name: command.Name}
+}
+
+// Register sets the commands of owner in guilds (or globally with ""), replacing its previous ones.
+//
+// The commands that conflict with the ones of another owner are skipped, and returned as errors.
+func (r *CommandRegistry) Register(owner string, commands []*discordgo.ApplicationCommand, guilds []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.unregister(owner)
+
+	var errs []error
+	for _, command := range commands {
+		key := keyOf(command)
+		for _, guildID := range guilds {
+			if other := r.conflict(key, guildID); other != "" && other != owner {
+				errs = append(errs, fmt.Errorf("command %s (in guild %q) is already registered by %s", command.Name, guildID, other))
+				continue
+			}
+
+			if r.commands[guildID] == nil {
+				r.commands[guildID] = make(map[commandKey]*registeredCommand)
+			}
+			r.commands[guildID][key] = &registeredCommand{owner: owner, command: command}
+			delete(r.synced, guildID)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// conflict returns the owner of the command that key would conflict with in guildID, or "".
+func (r *CommandRegistry) conflict(key commandKey, guildID string) string {
+	if guildID == "" {
+		// A global command conflicts with the commands of every guild
+		for _, commands := range r.commands {
+			if c, ok := commands[key]; ok {
+				return c.owner
+			}
+		}
+		return ""
+	}
+
+	for _, id := range []string{guildID, ""} {
+		if c, ok := r.commands[id][key]; ok {
+			return c.owner
+		}
+	}
+	return ""
+}
+
+// Unregister removes the commands of owner. They are deleted from Discord by the next Sync.
+func (r *CommandRegistry) Unregister(owner string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.unregister(owner)
+}
+
+func (r *CommandRegistry) unregister(owner string) {
+	for guildID, commands := range r.commands {
+		for key, c := range commands {
+			if c.owner == owner {
+				delete(commands, key)
+				delete(r.synced, guildID)
+			}
+		}
+		if len(commands) == 0 {
+			delete(r.commands, guildID)
+		}
+	}
+}
+
+// Registered reports whether owner has commands in guildID ("" for the global ones).
+func (r *CommandRegistry) Registered(owner, guildID string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, c := range r.commands[guildID] {
+		if c.owner == owner {
+			return true
+		}
+	}
+	return false
+}
+
+// Synced reports whether the commands of guildID were synced since they last changed.
+func (r *CommandRegistry) Synced(guildID string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.synced[guildID]
+}
+
+// Owner returns the owner of the command used in guildID (its own commands first, then the
+// global ones), or "".
+func (r *CommandRegistry) Owner(guildID string, typ discordgo.ApplicationCommandType, name string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := keyOf(&discordgo.ApplicationCommand{Type: typ, Name: name})
+	if c, ok := r.commands[guildID][key]; ok {
+		return c.owner
+	}
+	if c, ok := r.commands[""][key]; ok {
+		return c.owner
+	}
+	return ""
+}
+
+// Commands returns the commands registered in guildID ("" for the global ones), sorted by name.
+func (r *CommandRegistry) Commands(guildID string) []*discordgo.ApplicationCommand {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	commands := make([]*discordgo.ApplicationCommand, 0, len(r.commands[guildID]))
+	for _, c := range r.commands[guildID] {
+		commands = append(commands, c.command)
+	}
+	slices.SortFunc(commands, func(a, b *discordgo.ApplicationCommand) int {
+		ka, kb := keyOf(a), keyOf(b)
+		if c := strings.Compare(ka.name, kb.name); c != 0 {
+			return c
+		}
+		return cmp.Compare(ka.typ, kb.typ)
+	})
+	return commands
+}
+
+// RestoreRemote records guilds that had commands on Discord (see RemoteGuilds, e.g. saved by a
+// previous runtime), so that the next Sync deletes them if no module registers them anymore.
+func (r *CommandRegistry) RestoreRemote(guilds []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, guildID := range guilds {
+		r.remote[guildID] = true
+	}
+}
+
+// RemoteGuilds returns the guilds that have commands on Discord as of their last sync, sorted.
+func (r *CommandRegistry) RemoteGuilds() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	guilds := make([]string, 0, len(r.remote))
+	for guildID := range r.remote {
+		if guildID != "" {
+			guilds = append(guilds, guildID)
+		}
+	}
+	slices.Sort(guilds)
+	return guilds
+}
+
+// Sync reconciles the commands of the application on Discord with the registry: the global
+// commands, the ones of the guilds with registered commands, and the ones of the guilds that had
+// commands at their last sync (or in RestoreRemote). The other guilds aren't fetched, so bots in
+// many guilds don't run into the rate limits. The commands of a guild are overwritten at once when
+// they differ, which also deletes the commands that no module registers anymore.
+//
+// The guilds that fail to sync don't stop the others, their errors are returned together.
+func (r *CommandRegistry) Sync(api CommandAPI, appID string) error {
+	r.mu.Lock()
+	scopes := []string{""}
+	for guildID := range r.commands {
+		if guildID != "" {
+			scopes = append(scopes, guildID)
+		}
+	}
+	for guildID := range r.remote {
+		if guildID != "" && r.commands[guildID] == nil {
+			scopes = append(scopes, guildID)
+		}
+	}
+	r.mu.Unlock()
+	slices.Sort(scopes)
+
+	var errs []error
+	for _, guildID := range scopes {
+		if err := r.sync(api, appID, guildID); err != nil {
+			if guildID == "" {
+				err = fmt.Errorf("global commands: %w", err)
+			} else {
+				err = fmt.Errorf("commands of guild %s: %w", guildID, err)
+			}
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SyncGuild reconciles the commands of a single guild (e.g. one that the bot just joined) like Sync.
+func (r *CommandRegistry) SyncGuild(api CommandAPI, appID, guildID string) error {
+	return r.sync(api, appID, guildID)
+}
+
+func (r *CommandRegistry) sync(api CommandAPI, appID, guildID string) error {
+	current, err := api.ApplicationCommands(appID, guildID)
+	if err != nil {
+		return err
+	}
+
+	desired := r.Commands(guildID)
+	if !commandsEqual(current, desired) {
+		if _, err := api.ApplicationCommandBulkOverwrite(appID, guildID, desired); err != nil {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	r.synced[guildID] = true
+	if len(desired) > 0 {
+		r.remote[guildID] = true
+	} else {
+		delete(r.remote, guildID)
+	}
+	r.mu.Unlock()
+	return nil
+}
+
+// commandsEqual reports whether the commands on Discord are the same as the registered ones,
+// ignoring the fields set by Discord and the defaults it fills in. The fields that discordgo
+// doesn't model (e.g. integration_types and contexts) are dropped when decoding the commands,
+// so they're ignored too.
+func commandsEqual(current, desired []*discordgo.ApplicationCommand) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+
+	normalized := make(map[commandKey]interface{}, len(current))
+	for _, command := range current {
+		normalized[keyOf(command)] = normalizeCommand(command)
+	}
+	for _, command := range desired {
+		c, ok := normalized[keyOf(command)]
+		if !ok || !reflect.DeepEqual(c, normalizeCommand(command)) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeCommand returns the JSON form of a command without its IDs, defaults and empty values
+// (e.g. the false nsfw, and the false required and autocomplete of the options).
+func normalizeCommand(command *discordgo.ApplicationCommand) interface{} {
+	c := *command
+	c.ID, c.ApplicationID, c.GuildID, c.Version = "", "", "", ""
+	c.Type = keyOf(command).typ
+
+	// Discord fills in these (deprecated) permissions
+	allowed := true
+	if c.DefaultPermission == nil {
+		c.DefaultPermission = &allowed
+	}
+	if c.DMPermission == nil {
+		c.DMPermission = &allowed
+	}
+
+	data, err := json.Marshal(&c)
+	if err != nil {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil
+	}
+	return dropEmpty(v)
+}
+
+// dropEmpty removes the null, false, empty string, empty array and empty object values of a JSON value.
+func dropEmpty(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			value = dropEmpty(value)
+			if isEmpty(value) {
+				delete(v, key)
+			} else {
+				v[key] = value
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = dropEmpty(value)
+		}
+	}
+	return v
+}
+
+func isEmpty(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package runtime_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+)
+
+// commandAPI is a CommandAPI that records the scopes it's called for.
+type commandAPI struct {
+	commands   map[string][]*discordgo.ApplicationCommand // By guild
+	fetched    []string
+	overwrites []string
+}
+
+func (a *commandAPI) ApplicationCommands(appID, guildID string, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error) {
+	a.fetched = append(a.fetched, guildID)
+	return a.commands[guildID], nil
+}
+
+func (a *commandAPI) ApplicationCommandBulkOverwrite(appID string, guildID string, commands []*discordgo.ApplicationCommand, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error) {
+	a.overwrites = append(a.overwrites, guildID)
+	a.commands[guildID] = commands
+	return commands, nil
+}
+
+// discordCommands decodes commands as Discord returns them, with the fields it fills in.
+const discordCommands = `[{
+	"id": "1", "application_id": "2", "version": "3", "type": 1,
+	"name": "play", "description": "Play a song", "name_localizations": null,
+	"default_member_permissions": null, "dm_permission": true, "nsfw": false,
+	"integration_types": [0], "contexts": [0, 1, 2],
+	"options": [{"type": 3, "name": "song", "description": "Song", "required": true, "autocomplete": false},
+		{"type": 4, "name": "volume", "description": "Volume", "required": false, "min_value": 0, "max_value": 10}]
+}]`
+
+func TestCommandRegistrySyncScopes(t *testing.T) {
+	var global []*discordgo.ApplicationCommand
+	if err := json.Unmarshal([]byte(discordCommands), &global); err != nil {
+		t.Fatal(err)
+	}
+	api := &commandAPI{commands: map[string][]*discordgo.ApplicationCommand{
+		"":      global,
+		"stale": {{Name: "stale", Description: "Left by a previous runtime"}},
+	}}
+
+	registry := runtime.NewCommandRegistry()
+	registry.RestoreRemote([]string{"stale"})
+	zero := 0.0
+	err := registry.Register("music", []*discordgo.ApplicationCommand{{
+		Name:        "play",
+		Description: "Play a song",
+		Options: []*discordgo.ApplicationCommandOption{
+			{Type: discordgo.ApplicationCommandOptionString, Name: "song", Description: "Song", Required: true},
+			{Type: discordgo.ApplicationCommandOptionInteger, Name: "volume", Description: "Volume", MinValue: &zero, MaxValue: 10},
+		},
+	}}, []string{""})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.Register("games", []*discordgo.ApplicationCommand{{Name: "roll", Description: "Roll a die"}}, []string{"games"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The guilds without commands aren't fetched, and the fields filled in by Discord don't differ
+	if err := registry.Sync(api, "app"); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"", "games", "stale"}; !slices.Equal(api.fetched, want) {
+		t.Errorf("fetched %q, want %q", api.fetched, want)
+	}
+	if want := []string{"games", "stale"}; !slices.Equal(api.overwrites, want) {
+		t.Errorf("overwrote %q, want %q", api.overwrites, want)
+	}
+	if got := registry.RemoteGuilds(); !slices.Equal(got, []string{"games"}) {
+		t.Errorf("got remote guilds %q", got)
+	}
+
+	// The stale guild is forgotten once its commands were deleted
+	api.fetched, api.overwrites = nil, nil
+	if err := registry.Sync(api, "app"); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"", "games"}; !slices.Equal(api.fetched, want) || len(api.overwrites) != 0 {
+		t.Errorf("fetched %q and overwrote %q", api.fetched, api.overwrites)
+	}
+
+	// The guilds whose commands were all unregistered are still synced once
+	registry.Unregister("games")
+	api.fetched, api.overwrites = nil, nil
+	if err := registry.Sync(api, "app"); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"", "games"}; !slices.Equal(api.fetched, want) || !slices.Equal(api.overwrites, []string{"games"}) {
+		t.Errorf("fetched %q and overwrote %q", api.fetched, api.overwrites)
+	}
+	if got := registry.RemoteGuilds(); len(got) != 0 {
+		t.Errorf("got remote guilds %q", got)
+	}
+}
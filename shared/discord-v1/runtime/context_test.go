@@ -0,0 +1,139 @@
+package runtime_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	plugin "github.com/hashicorp/go-plugin"
+	discord "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/module"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+	"github.com/thirdscam/chatanium-flexmodule/shared/flextest"
+	"google.golang.org/grpc"
+)
+
+// pluginPair serves the module side of the plugin and dispenses its runtime side.
+type pluginPair struct {
+	plugin.NetRPCUnsupportedPlugin
+
+	module  *module.Plugin
+	runtime *runtime.Plugin
+}
+
+func (p *pluginPair) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	return p.module.GRPCServer(broker, s)
+}
+
+func (p *pluginPair) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return p.runtime.GRPCClient(ctx, broker, c)
+}
+
+// blockingHelper sends the context of its ChannelMessageSend calls to calls, and blocks them until
+// it's done.
+type blockingHelper struct {
+	*flextest.Helper
+	ctx   context.Context
+	calls chan context.Context
+}
+
+func (h *blockingHelper) WithContext(ctx context.Context) discord.Helper {
+	c := *h
+	c.ctx = ctx
+	return &c
+}
+
+func (h *blockingHelper) ChannelMessageSend(channelID string, content string) (*discordgo.Message, error) {
+	h.calls <- h.ctx
+	<-h.ctx.Done()
+	return nil, h.ctx.Err()
+}
+
+// relayHook sends a message with the helper bound to the context of each hook.
+type relayHook struct {
+	discord.AbstractHooks
+	helper discord.Helper
+	ctx    context.Context
+	errs   chan error
+}
+
+func (h *relayHook) OnInit(helper discord.Helper) discord.InitResponse {
+	h.helper = helper
+	return discord.InitResponse{}
+}
+
+func (h *relayHook) WithContext(ctx context.Context) discord.Hook {
+	c := *h
+	c.ctx = ctx
+	return &c
+}
+
+func (h *relayHook) OnCreateChatMessage(m *discordgo.Message) error {
+	_, err := discord.HelperWithContext(h.helper, h.ctx).ChannelMessageSend(m.ChannelID, m.Content)
+	h.errs <- err
+	return err
+}
+
+func TestContextPropagation(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		deadlines discord.Deadlines
+		cancel    bool
+	}{
+		{name: "cancel", cancel: true},
+		{name: "hook deadline", deadlines: discord.Deadlines{Methods: map[string]time.Duration{"OnCreateChatMessage": 50 * time.Millisecond}}},
+	} {
+		helper := &blockingHelper{Helper: flextest.NewHelper(), calls: make(chan context.Context, 1)}
+		hook := &relayHook{errs: make(chan error, 1)}
+		client, server := plugin.TestPluginGRPCConn(t, false, map[string]plugin.Plugin{
+			"discord-v1": &pluginPair{
+				module:  &module.Plugin{Impl: hook},
+				runtime: &runtime.Plugin{Helper: helper, HookDeadlines: test.deadlines},
+			},
+		})
+		defer client.Close()
+		defer server.Stop()
+
+		raw, err := client.Dispense("discord-v1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		clients := raw.(discord.RuntimeClients)
+		clients.GetHook().OnInit(helper)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		done := make(chan error, 1)
+		go func() {
+			done <- discord.HookWithContext(clients.GetHook(), ctx).OnCreateChatMessage(&discordgo.Message{ChannelID: "a1", Content: "hi"})
+		}()
+
+		// The runtime's helper is called with the context of the hook, through the module
+		var called context.Context
+		select {
+		case called = <-helper.calls:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s: the helper wasn't called", test.name)
+		}
+		if test.cancel {
+			cancel()
+		}
+
+		select {
+		case <-called.Done():
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s: the helper call wasn't cancelled", test.name)
+		}
+		for what, errs := range map[string]chan error{"hook": done, "helper call": hook.errs} {
+			select {
+			case err := <-errs:
+				if err == nil {
+					t.Errorf("%s: the %s succeeded", test.name, what)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("%s: the %s didn't return", test.name, what)
+			}
+		}
+	}
+}
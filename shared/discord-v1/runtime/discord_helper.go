@@ -1,6 +1,11 @@
 package runtime
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
 	"github.com/bwmarrin/discordgo"
 	shared "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
 )
@@ -8,6 +13,7 @@ import (
 // DiscordHelper implements the Helper interface using an actual Discord session
 type DiscordHelper struct {
 	session *discordgo.Session
+	ctx     context.Context // Context of the requests (see WithContext), nil for none
 }
 
 // NewDiscordHelper creates a new DiscordHelper with the given Discord session
@@ -17,44 +23,59 @@ func NewDiscordHelper(session *discordgo.Session) shared.Helper {
 	}
 }
 
+// WithContext returns a copy of the helper whose requests are made with ctx.
+func (h *DiscordHelper) WithContext(ctx context.Context) shared.Helper {
+	c := *h
+	c.ctx = ctx
+	return &c
+}
+
+// options returns the options of the requests.
+func (h *DiscordHelper) options() []discordgo.RequestOption {
+	if h.ctx == nil {
+		return nil
+	}
+	return []discordgo.RequestOption{discordgo.WithContext(h.ctx)}
+}
+
 // ================================================
 // Message operations
 // ================================================
 
 func (h *DiscordHelper) ChannelMessageSend(channelID string, content string) (*discordgo.Message, error) {
-	return h.session.ChannelMessageSend(channelID, content)
+	return h.session.ChannelMessageSend(channelID, content, h.options()...)
 }
 
 func (h *DiscordHelper) ChannelMessageSendComplex(channelID string, data *discordgo.MessageSend) (*discordgo.Message, error) {
-	return h.session.ChannelMessageSendComplex(channelID, data)
+	return h.session.ChannelMessageSendComplex(channelID, data, h.options()...)
 }
 
 func (h *DiscordHelper) ChannelMessageSendEmbed(channelID string, embed *discordgo.MessageEmbed) (*discordgo.Message, error) {
-	return h.session.ChannelMessageSendEmbed(channelID, embed)
+	return h.session.ChannelMessageSendEmbed(channelID, embed, h.options()...)
 }
 
 func (h *DiscordHelper) ChannelMessageSendEmbeds(channelID string, embeds []*discordgo.MessageEmbed) (*discordgo.Message, error) {
-	return h.session.ChannelMessageSendEmbeds(channelID, embeds)
+	return h.session.ChannelMessageSendEmbeds(channelID, embeds, h.options()...)
 }
 
 func (h *DiscordHelper) ChannelMessageEdit(channelID, messageID, content string) (*discordgo.Message, error) {
-	return h.session.ChannelMessageEdit(channelID, messageID, content)
+	return h.session.ChannelMessageEdit(channelID, messageID, content, h.options()...)
 }
 
 func (h *DiscordHelper) ChannelMessageEditComplex(m *discordgo.MessageEdit) (*discordgo.Message, error) {
-	return h.session.ChannelMessageEditComplex(m)
+	return h.session.ChannelMessageEditComplex(m, h.options()...)
 }
 
 func (h *DiscordHelper) ChannelMessageDelete(channelID, messageID string) error {
-	return h.session.ChannelMessageDelete(channelID, messageID)
+	return h.session.ChannelMessageDelete(channelID, messageID, h.options()...)
 }
 
 func (h *DiscordHelper) ChannelMessages(channelID string, limit int, beforeID, afterID, aroundID string) ([]*discordgo.Message, error) {
-	return h.session.ChannelMessages(channelID, limit, beforeID, afterID, aroundID)
+	return h.session.ChannelMessages(channelID, limit, beforeID, afterID, aroundID, h.options()...)
 }
 
 func (h *DiscordHelper) ChannelMessage(channelID, messageID string) (*discordgo.Message, error) {
-	return h.session.ChannelMessage(channelID, messageID)
+	return h.session.ChannelMessage(channelID, messageID, h.options()...)
 }
 
 // ================================================
@@ -62,19 +83,19 @@ func (h *DiscordHelper) ChannelMessage(channelID, messageID string) (*discordgo.
 // ================================================
 
 func (h *DiscordHelper) Channel(channelID string) (*discordgo.Channel, error) {
-	return h.session.Channel(channelID)
+	return h.session.Channel(channelID, h.options()...)
 }
 
 func (h *DiscordHelper) ChannelEdit(channelID string, data *discordgo.ChannelEdit) (*discordgo.Channel, error) {
-	return h.session.ChannelEdit(channelID, data)
+	return h.session.ChannelEdit(channelID, data, h.options()...)
 }
 
 func (h *DiscordHelper) ChannelDelete(channelID string) (*discordgo.Channel, error) {
-	return h.session.ChannelDelete(channelID)
+	return h.session.ChannelDelete(channelID, h.options()...)
 }
 
 func (h *DiscordHelper) ChannelTyping(channelID string) error {
-	return h.session.ChannelTyping(channelID)
+	return h.session.ChannelTyping(channelID, h.options()...)
 }
 
 // ================================================
@@ -82,23 +103,23 @@ func (h *DiscordHelper) ChannelTyping(channelID string) error {
 // ================================================
 
 func (h *DiscordHelper) Guild(guildID string) (*discordgo.Guild, error) {
-	return h.session.Guild(guildID)
+	return h.session.Guild(guildID, h.options()...)
 }
 
 func (h *DiscordHelper) GuildChannels(guildID string) ([]*discordgo.Channel, error) {
-	return h.session.GuildChannels(guildID)
+	return h.session.GuildChannels(guildID, h.options()...)
 }
 
 func (h *DiscordHelper) GuildMembers(guildID string, after string, limit int) ([]*discordgo.Member, error) {
-	return h.session.GuildMembers(guildID, after, limit)
+	return h.session.GuildMembers(guildID, after, limit, h.options()...)
 }
 
 func (h *DiscordHelper) GuildMember(guildID, userID string) (*discordgo.Member, error) {
-	return h.session.GuildMember(guildID, userID)
+	return h.session.GuildMember(guildID, userID, h.options()...)
 }
 
 func (h *DiscordHelper) GuildRoles(guildID string) ([]*discordgo.Role, error) {
-	return h.session.GuildRoles(guildID)
+	return h.session.GuildRoles(guildID, h.options()...)
 }
 
 // ================================================
@@ -106,27 +127,40 @@ func (h *DiscordHelper) GuildRoles(guildID string) ([]*discordgo.Role, error) {
 // ================================================
 
 func (h *DiscordHelper) User(userID string) (*discordgo.User, error) {
-	return h.session.User(userID)
+	return h.session.User(userID, h.options()...)
 }
 
 func (h *DiscordHelper) UserChannelCreate(recipientID string) (*discordgo.Channel, error) {
-	return h.session.UserChannelCreate(recipientID)
+	return h.session.UserChannelCreate(recipientID, h.options()...)
 }
 
 // ================================================
 // Interaction operations
 // ================================================
 
+// noChoicesResponse is the body of an autocomplete response without choices. discordgo leaves out an
+// empty choices list, but Discord rejects the response without it.
+var noChoicesResponse = json.RawMessage(fmt.Sprintf(`{"type":%d,"data":{"choices":[]}}`, discordgo.InteractionApplicationCommandAutocompleteResult))
+
 func (h *DiscordHelper) InteractionRespond(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse) error {
-	return h.session.InteractionRespond(interaction, resp)
+	if resp.Type == discordgo.InteractionApplicationCommandAutocompleteResult && (resp.Data == nil || len(resp.Data.Choices) == 0) {
+		endpoint := discordgo.EndpointInteractionResponse(interaction.ID, interaction.Token)
+		_, err := h.session.RequestWithBucketID(http.MethodPost, endpoint, noChoicesResponse, endpoint, h.options()...)
+		return err
+	}
+	return h.session.InteractionRespond(interaction, resp, h.options()...)
 }
 
 func (h *DiscordHelper) InteractionResponseEdit(interaction *discordgo.Interaction, newresp *discordgo.WebhookEdit) (*discordgo.Message, error) {
-	return h.session.InteractionResponseEdit(interaction, newresp)
+	return h.session.InteractionResponseEdit(interaction, newresp, h.options()...)
 }
 
 func (h *DiscordHelper) InteractionResponseDelete(interaction *discordgo.Interaction) error {
-	return h.session.InteractionResponseDelete(interaction)
+	return h.session.InteractionResponseDelete(interaction, h.options()...)
+}
+
+func (h *DiscordHelper) FollowupMessageCreate(interaction *discordgo.Interaction, wait bool, data *discordgo.WebhookParams) (*discordgo.Message, error) {
+	return h.session.FollowupMessageCreate(interaction, wait, data, h.options()...)
 }
 
 // ================================================
@@ -134,19 +168,19 @@ func (h *DiscordHelper) InteractionResponseDelete(interaction *discordgo.Interac
 // ================================================
 
 func (h *DiscordHelper) ApplicationCommandCreate(appID string, guildID string, cmd *discordgo.ApplicationCommand) (*discordgo.ApplicationCommand, error) {
-	return h.session.ApplicationCommandCreate(appID, guildID, cmd)
+	return h.session.ApplicationCommandCreate(appID, guildID, cmd, h.options()...)
 }
 
 func (h *DiscordHelper) ApplicationCommandEdit(appID, guildID, cmdID string, cmd *discordgo.ApplicationCommand) (*discordgo.ApplicationCommand, error) {
-	return h.session.ApplicationCommandEdit(appID, guildID, cmdID, cmd)
+	return h.session.ApplicationCommandEdit(appID, guildID, cmdID, cmd, h.options()...)
 }
 
 func (h *DiscordHelper) ApplicationCommandDelete(appID, guildID, cmdID string) error {
-	return h.session.ApplicationCommandDelete(appID, guildID, cmdID)
+	return h.session.ApplicationCommandDelete(appID, guildID, cmdID, h.options()...)
 }
 
 func (h *DiscordHelper) ApplicationCommands(appID, guildID string) ([]*discordgo.ApplicationCommand, error) {
-	return h.session.ApplicationCommands(appID, guildID)
+	return h.session.ApplicationCommands(appID, guildID, h.options()...)
 }
 
 // ================================================
@@ -154,15 +188,15 @@ func (h *DiscordHelper) ApplicationCommands(appID, guildID string) ([]*discordgo
 // ================================================
 
 func (h *DiscordHelper) MessageReactionAdd(channelID, messageID, emojiID string) error {
-	return h.session.MessageReactionAdd(channelID, messageID, emojiID)
+	return h.session.MessageReactionAdd(channelID, messageID, emojiID, h.options()...)
 }
 
 func (h *DiscordHelper) MessageReactionRemove(channelID, messageID, emojiID, userID string) error {
-	return h.session.MessageReactionRemove(channelID, messageID, emojiID, userID)
+	return h.session.MessageReactionRemove(channelID, messageID, emojiID, userID, h.options()...)
 }
 
 func (h *DiscordHelper) MessageReactionsRemoveAll(channelID, messageID string) error {
-	return h.session.MessageReactionsRemoveAll(channelID, messageID)
+	return h.session.MessageReactionsRemoveAll(channelID, messageID, h.options()...)
 }
 
 // ================================================
@@ -170,23 +204,23 @@ func (h *DiscordHelper) MessageReactionsRemoveAll(channelID, messageID string) e
 // ================================================
 
 func (h *DiscordHelper) ThreadStart(channelID, name string, typ discordgo.ChannelType, archiveDuration int) (*discordgo.Channel, error) {
-	return h.session.ThreadStart(channelID, name, typ, archiveDuration)
+	return h.session.ThreadStart(channelID, name, typ, archiveDuration, h.options()...)
 }
 
 func (h *DiscordHelper) ThreadJoin(threadID string) error {
-	return h.session.ThreadJoin(threadID)
+	return h.session.ThreadJoin(threadID, h.options()...)
 }
 
 func (h *DiscordHelper) ThreadLeave(threadID string) error {
-	return h.session.ThreadLeave(threadID)
+	return h.session.ThreadLeave(threadID, h.options()...)
 }
 
 func (h *DiscordHelper) ThreadMemberAdd(threadID, memberID string) error {
-	return h.session.ThreadMemberAdd(threadID, memberID)
+	return h.session.ThreadMemberAdd(threadID, memberID, h.options()...)
 }
 
 func (h *DiscordHelper) ThreadMemberRemove(threadID, memberID string) error {
-	return h.session.ThreadMemberRemove(threadID, memberID)
+	return h.session.ThreadMemberRemove(threadID, memberID, h.options()...)
 }
 
 // ================================================
@@ -194,7 +228,54 @@ func (h *DiscordHelper) ThreadMemberRemove(threadID, memberID string) error {
 // ================================================
 
 func (h *DiscordHelper) VoiceRegions() ([]*discordgo.VoiceRegion, error) {
-	return h.session.VoiceRegions()
+	return h.session.VoiceRegions(h.options()...)
+}
+
+// GuildVoiceStates returns the voice states of the guild from the state cache (there is no REST endpoint for them).
+func (h *DiscordHelper) GuildVoiceStates(guildID string) ([]*discordgo.VoiceState, error) {
+	guild, err := h.session.State.Guild(guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	h.session.State.RLock()
+	defer h.session.State.RUnlock()
+
+	return append([]*discordgo.VoiceState{}, guild.VoiceStates...), nil
+}
+
+// ================================================
+// File operations
+// ================================================
+
+// AttachmentDownload fetches an attachment from its URL, which must be on Discord's CDN.
+func (h *DiscordHelper) AttachmentDownload(attachment *discordgo.MessageAttachment) (*shared.Download, error) {
+	if !CDNURL(attachment.URL) {
+		return nil, fmt.Errorf("attachment %s: %q is not a Discord CDN URL", attachment.ID, attachment.URL)
+	}
+
+	ctx := h.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, attachment.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := h.session.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("attachment %s: %s", attachment.ID, resp.Status)
+	}
+
+	return &shared.Download{
+		ReadCloser:  resp.Body,
+		ContentType: resp.Header.Get("Content-Type"),
+		Size:        resp.ContentLength,
+	}, nil
 }
 
 // ================================================
@@ -202,11 +283,11 @@ func (h *DiscordHelper) VoiceRegions() ([]*discordgo.VoiceRegion, error) {
 // ================================================
 
 func (h *DiscordHelper) WebhookCreate(channelID, name, avatar string) (*discordgo.Webhook, error) {
-	return h.session.WebhookCreate(channelID, name, avatar)
+	return h.session.WebhookCreate(channelID, name, avatar, h.options()...)
 }
 
 func (h *DiscordHelper) WebhookExecute(webhookID, token string, wait bool, data *discordgo.WebhookParams) (*discordgo.Message, error) {
-	return h.session.WebhookExecute(webhookID, token, wait, data)
+	return h.session.WebhookExecute(webhookID, token, wait, data, h.options()...)
 }
 
 // ================================================
@@ -214,7 +295,7 @@ func (h *DiscordHelper) WebhookExecute(webhookID, token string, wait bool, data
 // ================================================
 
 func (h *DiscordHelper) UserChannelPermissions(userID, channelID string) (int64, error) {
-	return h.session.UserChannelPermissions(userID, channelID)
+	return h.session.UserChannelPermissions(userID, channelID, h.options()...)
 }
 
 // ================================================
@@ -222,9 +303,9 @@ func (h *DiscordHelper) UserChannelPermissions(userID, channelID string) (int64,
 // ================================================
 
 func (h *DiscordHelper) Gateway() (string, error) {
-	return h.session.Gateway()
+	return h.session.Gateway(h.options()...)
 }
 
 func (h *DiscordHelper) GatewayBot() (*discordgo.GatewayBotResponse, error) {
-	return h.session.GatewayBot()
+	return h.session.GatewayBot(h.options()...)
 }
@@ -0,0 +1,43 @@
+package runtime_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+)
+
+func TestDiscordHelperGuildVoiceStates(t *testing.T) {
+	state := discordgo.NewState()
+	state.GuildAdd(&discordgo.Guild{ID: "A"})
+	session := &discordgo.Session{State: state, StateEnabled: true}
+	helper := runtime.NewDiscordHelper(session)
+
+	for _, update := range []*discordgo.VoiceState{
+		{GuildID: "A", UserID: "1", ChannelID: "v1"},
+		{GuildID: "A", UserID: "2", ChannelID: "v1"},
+		{GuildID: "A", UserID: "1", ChannelID: "v2"}, // Moved
+		{GuildID: "A", UserID: "2"},                  // Left
+	} {
+		state.OnInterface(session, &discordgo.VoiceStateUpdate{VoiceState: update})
+	}
+
+	states, err := helper.GuildVoiceStates("A")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []*discordgo.VoiceState{{GuildID: "A", UserID: "1", ChannelID: "v2"}}; !reflect.DeepEqual(states, want) {
+		t.Errorf("got %+v, want %+v", states, want)
+	}
+
+	// The result is a copy of the state's list
+	states[0] = nil
+	if states, _ := helper.GuildVoiceStates("A"); len(states) != 1 || states[0] == nil {
+		t.Errorf("the state's list was modified: %+v", states)
+	}
+
+	if _, err := helper.GuildVoiceStates("B"); err == nil {
+		t.Error("expected an error for an unknown guild")
+	}
+}
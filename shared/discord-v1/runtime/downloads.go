@@ -0,0 +1,268 @@
+package runtime
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	shared "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+)
+
+// DefaultMaxDownloadSize is the default size cap of the attachments downloaded by a module.
+const DefaultMaxDownloadSize = 25 << 20
+
+// DefaultAttachmentCacheSize is the default size of an AttachmentCache.
+const DefaultAttachmentCacheSize = 256 << 20
+
+// Downloader downloads the attachments requested by a module (see Helper.AttachmentDownload),
+// within its limits.
+type Downloader struct {
+	Cache        *AttachmentCache // Cache shared by the modules, nil for none
+	MaxSize      int64            // Size cap of the attachments in bytes (DefaultMaxDownloadSize if zero)
+	ContentTypes []string         // Allowed content types ("audio/*" allows any audio type), all if empty
+}
+
+// AttachmentChecker is implemented by the helpers that restrict the attachments a module can
+// download (e.g. ScopedHelper), so that the attachments in the cache are checked as well.
+type AttachmentChecker interface {
+	CheckAttachment(attachment *discordgo.MessageAttachment) error
+}
+
+// Download returns the content of an attachment, from the cache or fetched through helper.
+// A nil Downloader has the default limits and no cache.
+func (d *Downloader) Download(helper shared.Helper, attachment *discordgo.MessageAttachment) (*shared.Download, error) {
+	var limits Downloader
+	if d != nil {
+		limits = *d
+	}
+	if limits.MaxSize <= 0 {
+		limits.MaxSize = DefaultMaxDownloadSize
+	}
+
+	// The size of the attachment is known in advance, but the actual content is checked anyway
+	if int64(attachment.Size) > limits.MaxSize {
+		return nil, limits.errTooLarge(attachment)
+	}
+
+	if checker, ok := helper.(AttachmentChecker); ok {
+		if err := checker.CheckAttachment(attachment); err != nil {
+			return nil, err
+		}
+	}
+
+	// The attachments are cached by the URL that was fetched, since the module can claim any ID
+	key := AttachmentPath(attachment.URL)
+	download, cached := limits.Cache.open(key)
+	if !cached {
+		var err error
+		download, err = helper.AttachmentDownload(attachment)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := limits.allowed(download.ContentType); err != nil {
+		download.Close()
+		return nil, fmt.Errorf("attachment %s: %w", attachment.ID, err)
+	}
+	if download.Size > limits.MaxSize {
+		download.Close()
+		return nil, limits.errTooLarge(attachment)
+	}
+
+	if cached {
+		return download, nil
+	}
+	if limits.Cache != nil && key != "" {
+		return limits.Cache.store(key, download, limits.MaxSize)
+	}
+	download.ReadCloser = &limitedReader{ReadCloser: download.ReadCloser, remaining: limits.MaxSize, err: limits.errTooLarge(attachment)}
+	return download, nil
+}
+
+func (d *Downloader) errTooLarge(attachment *discordgo.MessageAttachment) error {
+	return fmt.Errorf("attachment %s is larger than the limit of %d bytes", attachment.ID, d.MaxSize)
+}
+
+// allowed returns an error if contentType isn't allowed.
+func (d *Downloader) allowed(contentType string) error {
+	if len(d.ContentTypes) == 0 {
+		return nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("content type %q is not allowed", contentType)
+	}
+	for _, allowed := range d.ContentTypes {
+		allowed = strings.ToLower(allowed)
+		if allowed == mediaType || (strings.HasSuffix(allowed, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(allowed, "*"))) {
+			return nil
+		}
+	}
+	return fmt.Errorf("content type %q is not allowed", contentType)
+}
+
+// limitedReader returns err once more than remaining bytes were read.
+type limitedReader struct {
+	io.ReadCloser
+	remaining int64
+	err       error
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	// Read one more byte than allowed to detect content that is too large
+	if int64(len(p)) > r.remaining+1 {
+		p = p[:r.remaining+1]
+	}
+	n, err := r.ReadCloser.Read(p)
+	r.remaining -= int64(n)
+	if r.remaining < 0 {
+		return n + int(r.remaining), r.err
+	}
+	return n, err
+}
+
+// AttachmentCache caches downloaded attachments on disk by their path on the CDN (see AttachmentPath),
+// evicting the least recently used ones beyond its size. It is shared by the modules.
+type AttachmentCache struct {
+	dir     string
+	maxSize int64
+
+	mu      sync.Mutex
+	entries map[string]*cachedAttachment // By path on the CDN
+	size    int64                        // Total size of the entries
+}
+
+type cachedAttachment struct {
+	path        string
+	contentType string
+	size        int64
+	used        time.Time
+}
+
+// NewAttachmentCache creates a cache of up to maxSize bytes (DefaultAttachmentCacheSize if zero)
+// in dir, deleting the attachments that a previous runtime left there.
+func NewAttachmentCache(dir string, maxSize int64) (*AttachmentCache, error) {
+	if maxSize <= 0 {
+		maxSize = DefaultAttachmentCacheSize
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	stale, err := filepath.Glob(filepath.Join(dir, "attachment-*"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range stale {
+		os.Remove(path)
+	}
+
+	return &AttachmentCache{
+		dir:     dir,
+		maxSize: maxSize,
+		entries: make(map[string]*cachedAttachment),
+	}, nil
+}
+
+// open opens a cached attachment. It is safe to call on a nil cache.
+func (c *AttachmentCache) open(key string) (*shared.Download, bool) {
+	if c == nil || key == "" {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	file, err := os.Open(entry.path)
+	if err != nil {
+		c.remove(key)
+		return nil, false
+	}
+	entry.used = time.Now()
+
+	return &shared.Download{
+		ReadCloser:  file,
+		ContentType: entry.contentType,
+		Size:        entry.size,
+	}, true
+}
+
+// store writes a download of up to maxSize bytes to the cache (closing it), and opens it.
+func (c *AttachmentCache) store(key string, download *shared.Download, maxSize int64) (*shared.Download, error) {
+	defer download.Close()
+
+	file, err := os.CreateTemp(c.dir, "attachment-*")
+	if err != nil {
+		return nil, err
+	}
+
+	// Read one more byte than allowed to detect content that is too large
+	size, err := io.Copy(file, io.LimitReader(download, maxSize+1))
+	if err == nil && size > maxSize {
+		err = fmt.Errorf("attachment %s is larger than the limit of %d bytes", key, maxSize)
+	}
+	if err == nil {
+		_, err = file.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.remove(key)
+	c.entries[key] = &cachedAttachment{
+		path:        file.Name(),
+		contentType: download.ContentType,
+		size:        size,
+		used:        time.Now(),
+	}
+	c.size += size
+	c.evict()
+
+	// The file stays readable even if it was evicted right away
+	return &shared.Download{
+		ReadCloser:  file,
+		ContentType: download.ContentType,
+		Size:        size,
+	}, nil
+}
+
+// remove deletes an entry. c.mu must be held.
+func (c *AttachmentCache) remove(key string) {
+	entry, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	delete(c.entries, key)
+	c.size -= entry.size
+	os.Remove(entry.path)
+}
+
+// evict deletes the least recently used entries until the cache fits in its size. c.mu must be held.
+func (c *AttachmentCache) evict() {
+	for c.size > c.maxSize {
+		var oldest string
+		for key, entry := range c.entries {
+			if oldest == "" || entry.used.Before(c.entries[oldest].used) {
+				oldest = key
+			}
+		}
+		c.remove(oldest)
+	}
+}
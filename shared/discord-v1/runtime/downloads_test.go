@@ -0,0 +1,118 @@
+package runtime_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	discord "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+	"github.com/thirdscam/chatanium-flexmodule/shared/flextest"
+)
+
+const cdn = "https://cdn.discordapp.com"
+
+func TestAttachmentPath(t *testing.T) {
+	for url, want := range map[string]string{
+		cdn + "/attachments/1/2/a.mp3?ex=1&is=2&hm=3":        "/attachments/1/2/a.mp3",
+		"https://media.discordapp.net/attachments/1/2/a.mp3": "/attachments/1/2/a.mp3",
+		cdn + "/avatars/1/a.png":                             "/avatars/1/a.png",
+		"https://example.com/attachments/1/2/a.mp3":          "",
+		"http://cdn.discordapp.com/attachments/1/2/a.mp3":    "",
+	} {
+		if got := runtime.AttachmentPath(url); got != want {
+			t.Errorf("%s: got %q, want %q", url, got, want)
+		}
+	}
+}
+
+// download downloads an attachment and reads it.
+func download(t *testing.T, d *runtime.Downloader, helper discord.Helper, attachment *discordgo.MessageAttachment) string {
+	t.Helper()
+
+	download, err := d.Download(helper, attachment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer download.Close()
+	content, err := io.ReadAll(download)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(content)
+}
+
+// content returns a download with content.
+func content(s string) *discord.Download {
+	return &discord.Download{ReadCloser: io.NopCloser(strings.NewReader(s)), ContentType: "text/plain", Size: int64(len(s))}
+}
+
+func TestDownloaderCache(t *testing.T) {
+	cache, err := runtime.NewAttachmentCache(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake := flextest.NewHelper()
+	downloads := &runtime.Downloader{Cache: cache}
+
+	attachment := &discordgo.MessageAttachment{ID: "2", URL: cdn + "/attachments/1/2/a.txt?ex=1"}
+	fake.Respond("AttachmentDownload", content("first"), nil)
+	if got := download(t, downloads, fake, attachment); got != "first" {
+		t.Errorf("got %q", got)
+	}
+
+	// The signature of the URL changes, but it's the same attachment
+	resigned := &discordgo.MessageAttachment{ID: "2", URL: cdn + "/attachments/1/2/a.txt?ex=2"}
+	if got := download(t, downloads, fake, resigned); got != "first" {
+		t.Errorf("resigned: got %q", got)
+	}
+
+	// Another file claiming the ID of the cached attachment is fetched, and doesn't replace it
+	other := &discordgo.MessageAttachment{ID: "2", URL: cdn + "/attachments/1/3/b.txt"}
+	fake.Respond("AttachmentDownload", content("other"), nil)
+	if got := download(t, downloads, fake, other); got != "other" {
+		t.Errorf("other attachment: got %q", got)
+	}
+	if got := download(t, downloads, fake, attachment); got != "first" {
+		t.Errorf("after the other attachment: got %q", got)
+	}
+
+	if calls := fake.CallsTo("AttachmentDownload"); len(calls) != 2 {
+		t.Errorf("fetched %d times", len(calls))
+	}
+}
+
+func TestDownloaderScope(t *testing.T) {
+	cache, err := runtime.NewAttachmentCache(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := discordgo.NewState()
+	state.GuildAdd(&discordgo.Guild{ID: "A", Channels: []*discordgo.Channel{{ID: "a1", GuildID: "A"}}})
+	state.GuildAdd(&discordgo.Guild{ID: "B", Channels: []*discordgo.Channel{{ID: "b1", GuildID: "B"}}})
+
+	fake := flextest.NewHelper()
+	downloads := &runtime.Downloader{Cache: cache}
+	inB := &discordgo.MessageAttachment{ID: "2", URL: cdn + "/attachments/b1/2/a.txt"}
+
+	// A module of guild B caches the attachment
+	scopedB := runtime.NewNamespacedHelper(runtime.NewScopedHelper(fake, state, runtime.GuildScope{Allow: []string{"B"}}), "b")
+	fake.Respond("AttachmentDownload", content("secret"), nil)
+	if got := download(t, downloads, scopedB, inB); got != "secret" {
+		t.Errorf("in scope: got %q", got)
+	}
+
+	// A module of guild A can't download it, from the cache or not
+	scopedA := runtime.NewNamespacedHelper(runtime.NewScopedHelper(fake, state, runtime.GuildScope{Allow: []string{"A"}}), "a")
+	if _, err := downloads.Download(scopedA, inB); !errors.Is(err, runtime.ErrOutOfScope) {
+		t.Errorf("cached out of scope: got %v", err)
+	}
+	if _, err := scopedA.AttachmentDownload(inB); !errors.Is(err, runtime.ErrOutOfScope) {
+		t.Errorf("out of scope: got %v", err)
+	}
+	if calls := fake.CallsTo("AttachmentDownload"); len(calls) != 1 {
+		t.Errorf("fetched %d times", len(calls))
+	}
+}
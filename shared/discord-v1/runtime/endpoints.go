@@ -0,0 +1,98 @@
+package runtime
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// OverrideEndpoints points discordgo at another Discord API (e.g. a local stand-in server for tests).
+//
+// base replaces "https://discord.com/" and cdn replaces "https://cdn.discordapp.com/".
+// An empty cdn keeps the real CDN. The gateway URL is whatever the API at base returns.
+//
+// discordgo derives its endpoints from a few package-level variables at init time, so every derived
+// variable has to be reassigned here. It must be called before creating any session.
+func OverrideEndpoints(base, cdn string) {
+	if !strings.HasSuffix(base, "/") {
+		base += "/"
+	}
+
+	discordgo.EndpointDiscord = base
+	discordgo.EndpointAPI = discordgo.EndpointDiscord + "api/v" + discordgo.APIVersion + "/"
+	discordgo.EndpointGuilds = discordgo.EndpointAPI + "guilds/"
+	discordgo.EndpointChannels = discordgo.EndpointAPI + "channels/"
+	discordgo.EndpointUsers = discordgo.EndpointAPI + "users/"
+	discordgo.EndpointGateway = discordgo.EndpointAPI + "gateway"
+	discordgo.EndpointGatewayBot = discordgo.EndpointGateway + "/bot"
+	discordgo.EndpointWebhooks = discordgo.EndpointAPI + "webhooks/"
+	discordgo.EndpointStickers = discordgo.EndpointAPI + "stickers/"
+	discordgo.EndpointStageInstances = discordgo.EndpointAPI + "stage-instances"
+	discordgo.EndpointVoice = discordgo.EndpointAPI + "/voice/"
+	discordgo.EndpointVoiceRegions = discordgo.EndpointVoice + "regions"
+	discordgo.EndpointNitroStickersPacks = discordgo.EndpointAPI + "/sticker-packs"
+	discordgo.EndpointGuildCreate = discordgo.EndpointAPI + "guilds"
+	discordgo.EndpointApplications = discordgo.EndpointAPI + "applications"
+
+	if cdn == "" {
+		return
+	}
+	if !strings.HasSuffix(cdn, "/") {
+		cdn += "/"
+	}
+
+	discordgo.EndpointCDN = cdn
+	discordgo.EndpointCDNAttachments = discordgo.EndpointCDN + "attachments/"
+	discordgo.EndpointCDNAvatars = discordgo.EndpointCDN + "avatars/"
+	discordgo.EndpointCDNIcons = discordgo.EndpointCDN + "icons/"
+	discordgo.EndpointCDNSplashes = discordgo.EndpointCDN + "splashes/"
+	discordgo.EndpointCDNChannelIcons = discordgo.EndpointCDN + "channel-icons/"
+	discordgo.EndpointCDNBanners = discordgo.EndpointCDN + "banners/"
+	discordgo.EndpointCDNGuilds = discordgo.EndpointCDN + "guilds/"
+	discordgo.EndpointCDNRoleIcons = discordgo.EndpointCDN + "role-icons/"
+}
+
+// CDNURL reports whether rawURL is on Discord's CDN (or the one set by OverrideEndpoints), where
+// the attachments are served from.
+func CDNURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	if cdn, err := url.Parse(discordgo.EndpointCDN); err == nil && u.Scheme == cdn.Scheme && u.Host == cdn.Host {
+		return true
+	}
+	// The media proxy serves the same attachments
+	return u.Scheme == "https" && u.Host == "media.discordapp.net"
+}
+
+// AttachmentPath returns the path of a file on the CDN (e.g. "/attachments/<channel>/<attachment>/<name>"),
+// which identifies it whatever the host and the signature of its URL, or "" if rawURL isn't on the CDN.
+func AttachmentPath(rawURL string) string {
+	if !CDNURL(rawURL) {
+		return ""
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+
+	// The path of the CDN set by OverrideEndpoints is left out
+	path := u.Path
+	if cdn, err := url.Parse(discordgo.EndpointCDN); err == nil && u.Host == cdn.Host {
+		path = strings.TrimPrefix(path, strings.TrimSuffix(cdn.Path, "/"))
+	}
+	return path
+}
+
+// attachmentChannel returns the channel of an attachment URL ("/attachments/<channel>/..."), or "" if
+// it isn't the URL of an attachment.
+func attachmentChannel(rawURL string) string {
+	rest, ok := strings.CutPrefix(AttachmentPath(rawURL), "/attachments/")
+	if !ok {
+		return ""
+	}
+	channelID, _, _ := strings.Cut(rest, "/")
+	return channelID
+}
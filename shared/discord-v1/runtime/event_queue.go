@@ -0,0 +1,256 @@
+package runtime
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+)
+
+// OverflowPolicy decides what happens to an event pushed to a full EventQueue.
+type OverflowPolicy string
+
+const (
+	OverflowDropOldest OverflowPolicy = "drop_oldest" // Drop the oldest queued event of the worker (default)
+	OverflowDropNewest OverflowPolicy = "drop_newest" // Drop the pushed event
+	OverflowBlock      OverflowPolicy = "block"       // Wait for room, blocking the gateway handlers
+)
+
+// QueueConfig configures the event queue of a module.
+type QueueConfig struct {
+	Size     int            `json:"size,omitempty"`     // Maximum number of queued events (default 1000)
+	Workers  int            `json:"workers,omitempty"`  // Number of events handled concurrently (default 1)
+	Overflow OverflowPolicy `json:"overflow,omitempty"` // What to do when the queue is full (default drop_oldest)
+}
+
+// Validate checks the overflow policy and the sizes.
+func (c QueueConfig) Validate() error {
+	switch c.Overflow {
+	case "", OverflowDropOldest, OverflowDropNewest, OverflowBlock:
+	default:
+		return fmt.Errorf("unknown queue overflow policy %q", c.Overflow)
+	}
+	if c.Size < 0 || c.Workers < 0 {
+		return fmt.Errorf("queue size and workers can't be negative")
+	}
+	return nil
+}
+
+// QueueStats are the counters of an EventQueue.
+type QueueStats struct {
+	Depth     int    `json:"depth"`     // Events waiting to be handled
+	Capacity  int    `json:"capacity"`  // Maximum number of waiting events
+	Pushed    uint64 `json:"pushed"`    // Events accepted by Push
+	Handled   uint64 `json:"handled"`   // Events handled by the workers
+	Dropped   uint64 `json:"dropped"`   // Events dropped because the queue was full (or closed, or not handled in time when closing)
+	Overflows uint64 `json:"overflows"` // Pushes to a full queue, including the ones that blocked
+}
+
+// EventQueue delivers events to a module asynchronously, so that a slow module doesn't block
+// the gateway handlers or the other modules.
+//
+// Events with the same key (the channel, or the guild for events without one) always go to the
+// same worker, so they are handled in the order they were pushed. Each worker has an equal share
+// of the queue size.
+type EventQueue struct {
+	workers  []*eventWorker
+	overflow OverflowPolicy
+	wg       sync.WaitGroup
+}
+
+type eventWorker struct {
+	mu       sync.Mutex
+	cond     *sync.Cond
+	events   []queuedEvent
+	capacity int
+	closed   bool
+
+	pushed, handled, dropped, overflows uint64
+}
+
+// queuedEvent is an event handler, and the function to call if it's dropped (or nil).
+type queuedEvent struct {
+	handle, dropped func()
+}
+
+// drop calls the dropped function of the event, in its own goroutine since the queue may be locked.
+func (e queuedEvent) drop() {
+	if e.dropped != nil {
+		go e.dropped()
+	}
+}
+
+// NewEventQueue starts the workers of a queue configured by config.
+func NewEventQueue(config QueueConfig) *EventQueue {
+	if config.Size <= 0 {
+		config.Size = 1000
+	}
+	if config.Workers <= 0 {
+		config.Workers = 1
+	}
+	if config.Overflow == "" {
+		config.Overflow = OverflowDropOldest
+	}
+
+	q := &EventQueue{overflow: config.Overflow}
+	for i := 0; i < config.Workers; i++ {
+		// Round up, so that the queue holds at least Size events
+		w := &eventWorker{capacity: (config.Size + config.Workers - 1) / config.Workers}
+		w.cond = sync.NewCond(&w.mu)
+		q.workers = append(q.workers, w)
+
+		q.wg.Add(1)
+		go func() {
+			defer q.wg.Done()
+			w.run()
+		}()
+	}
+	return q
+}
+
+// PushResult tells what Push did with an event.
+type PushResult int
+
+const (
+	PushQueued        PushResult = iota // The event was queued
+	PushDroppedOldest                   // The event was queued, dropping the oldest one of its worker (drop_oldest)
+	PushDropped                         // The event was dropped, as the queue was full (drop_newest) or closed
+)
+
+// Push queues an event handler, applying the overflow policy if the queue of its key is full.
+func (q *EventQueue) Push(key string, event func()) PushResult {
+	return q.push(key, queuedEvent{handle: event})
+}
+
+// PushDroppable queues an event handler like Push, and calls dropped (in its own goroutine) if the
+// event is dropped instead of handled: by the overflow policy, or because the queue was closed.
+func (q *EventQueue) PushDroppable(key string, event, dropped func()) PushResult {
+	return q.push(key, queuedEvent{handle: event, dropped: dropped})
+}
+
+func (q *EventQueue) push(key string, event queuedEvent) PushResult {
+	w := q.workers[0]
+	if len(q.workers) > 1 {
+		h := fnv.New32a()
+		h.Write([]byte(key))
+		w = q.workers[h.Sum32()%uint32(len(q.workers))]
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	result := PushQueued
+	if len(w.events) >= w.capacity && !w.closed {
+		w.overflows++
+		switch q.overflow {
+		case OverflowDropNewest:
+			w.dropped++
+			event.drop()
+			return PushDropped
+		case OverflowBlock:
+			for len(w.events) >= w.capacity && !w.closed {
+				w.cond.Wait()
+			}
+		default:
+			w.events[0].drop()
+			w.events[0] = queuedEvent{}
+			w.events = w.events[1:]
+			w.dropped++
+			result = PushDroppedOldest
+		}
+	}
+	if w.closed {
+		w.dropped++
+		event.drop()
+		return PushDropped
+	}
+
+	w.events = append(w.events, event)
+	w.pushed++
+	w.cond.Broadcast()
+	return result
+}
+
+// Stats returns the counters of the queue, summed over its workers.
+func (q *EventQueue) Stats() QueueStats {
+	var stats QueueStats
+	for _, w := range q.workers {
+		w.mu.Lock()
+		stats.Depth += len(w.events)
+		stats.Capacity += w.capacity
+		stats.Pushed += w.pushed
+		stats.Handled += w.handled
+		stats.Dropped += w.dropped
+		stats.Overflows += w.overflows
+		w.mu.Unlock()
+	}
+	return stats
+}
+
+// Close stops accepting events and waits up to timeout (if not zero) for the queued ones to be
+// handled. It returns false if they weren't handled in time: the events still queued are then
+// dropped, and the ones being handled are left running.
+func (q *EventQueue) Close(timeout time.Duration) bool {
+	for _, w := range q.workers {
+		w.mu.Lock()
+		w.closed = true
+		w.cond.Broadcast()
+		w.mu.Unlock()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		q.wg.Wait()
+		close(done)
+	}()
+	if timeout == 0 {
+		<-done
+		return true
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return true
+	case <-timer.C:
+	}
+
+	for _, w := range q.workers {
+		w.mu.Lock()
+		for i := range w.events {
+			w.events[i].drop()
+			w.events[i] = queuedEvent{}
+		}
+		w.dropped += uint64(len(w.events))
+		w.events = nil
+		w.mu.Unlock()
+	}
+	return false
+}
+
+func (w *eventWorker) run() {
+	for {
+		w.mu.Lock()
+		for len(w.events) == 0 && !w.closed {
+			w.cond.Wait()
+		}
+		if len(w.events) == 0 {
+			w.mu.Unlock()
+			return
+		}
+
+		event := w.events[0]
+		w.events[0] = queuedEvent{}
+		w.events = w.events[1:]
+		// Wake up pushers waiting for room
+		w.cond.Broadcast()
+		w.mu.Unlock()
+
+		event.handle()
+
+		w.mu.Lock()
+		w.handled++
+		w.mu.Unlock()
+	}
+}
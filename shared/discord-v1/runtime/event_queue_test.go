@@ -0,0 +1,207 @@
+package runtime_test
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+)
+
+// blockedQueue returns a queue of one worker busy with an event until release is closed.
+func blockedQueue(t *testing.T, config runtime.QueueConfig) (*runtime.EventQueue, chan struct{}) {
+	t.Helper()
+
+	q := runtime.NewEventQueue(config)
+	started, release := make(chan struct{}), make(chan struct{})
+	q.Push("", func() {
+		close(started)
+		<-release
+	})
+	<-started
+	return q, release
+}
+
+func TestEventQueueOverflow(t *testing.T) {
+	for _, test := range []struct {
+		policy  runtime.OverflowPolicy
+		results []runtime.PushResult
+		pushed  uint64 // Including the event being handled
+		handled []int
+	}{
+		{
+			policy:  runtime.OverflowDropOldest,
+			results: []runtime.PushResult{runtime.PushQueued, runtime.PushQueued, runtime.PushDroppedOldest},
+			pushed:  4,
+			handled: []int{2, 3},
+		},
+		{
+			policy:  runtime.OverflowDropNewest,
+			results: []runtime.PushResult{runtime.PushQueued, runtime.PushQueued, runtime.PushDropped},
+			pushed:  3,
+			handled: []int{1, 2},
+		},
+	} {
+		q, release := blockedQueue(t, runtime.QueueConfig{Size: 2, Overflow: test.policy})
+
+		var mu sync.Mutex
+		var handled []int
+		var results []runtime.PushResult
+		for i := 1; i <= 3; i++ {
+			results = append(results, q.Push("", func() {
+				mu.Lock()
+				defer mu.Unlock()
+				handled = append(handled, i)
+			}))
+		}
+		if !slices.Equal(results, test.results) {
+			t.Errorf("%s: got results %v, want %v", test.policy, results, test.results)
+		}
+
+		stats := q.Stats()
+		if stats.Depth != 2 || stats.Capacity != 2 || stats.Pushed != test.pushed || stats.Dropped != 1 || stats.Overflows != 1 {
+			t.Errorf("%s: got stats %+v", test.policy, stats)
+		}
+
+		close(release)
+		if !q.Close(time.Second) {
+			t.Fatalf("%s: not closed in time", test.policy)
+		}
+		if !slices.Equal(handled, test.handled) {
+			t.Errorf("%s: handled %v, want %v", test.policy, handled, test.handled)
+		}
+		if stats := q.Stats(); stats.Depth != 0 || stats.Handled != 3 {
+			t.Errorf("%s: got stats %+v after closing", test.policy, stats)
+		}
+	}
+}
+
+func TestEventQueueBlock(t *testing.T) {
+	q, release := blockedQueue(t, runtime.QueueConfig{Size: 1, Overflow: runtime.OverflowBlock})
+	q.Push("", func() {})
+
+	pushed := make(chan runtime.PushResult)
+	go func() { pushed <- q.Push("", func() {}) }()
+	select {
+	case result := <-pushed:
+		t.Fatalf("pushed to a full queue: %v", result)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	if result := <-pushed; result != runtime.PushQueued {
+		t.Errorf("got %v once there was room", result)
+	}
+	q.Close(0)
+	if stats := q.Stats(); stats.Pushed != 3 || stats.Handled != 3 || stats.Dropped != 0 || stats.Overflows != 1 {
+		t.Errorf("got stats %+v", stats)
+	}
+}
+
+func TestEventQueueOrder(t *testing.T) {
+	q := runtime.NewEventQueue(runtime.QueueConfig{Workers: 4})
+
+	var mu sync.Mutex
+	handled := make(map[string][]int)
+	for i := 0; i < 100; i++ {
+		key := []string{"a", "b", "c"}[i%3]
+		q.Push(key, func() {
+			mu.Lock()
+			defer mu.Unlock()
+			handled[key] = append(handled[key], i)
+		})
+	}
+	q.Close(0)
+
+	// The events of a key are handled in order, even with several workers
+	for key, events := range handled {
+		if !slices.IsSorted(events) {
+			t.Errorf("%s: handled out of order: %v", key, events)
+		}
+	}
+	if stats := q.Stats(); stats.Capacity != 1000 || stats.Handled != 100 {
+		t.Errorf("got stats %+v", stats)
+	}
+}
+
+func TestEventQueueClose(t *testing.T) {
+	q, release := blockedQueue(t, runtime.QueueConfig{})
+	defer close(release)
+	q.Push("", func() {})
+
+	if q.Close(10 * time.Millisecond) {
+		t.Fatal("closed while an event was being handled")
+	}
+	if result := q.Push("", func() {}); result != runtime.PushDropped {
+		t.Errorf("pushed to a closed queue: %v", result)
+	}
+	// The queued event was dropped as well
+	if stats := q.Stats(); stats.Depth != 0 || stats.Dropped != 2 {
+		t.Errorf("got stats %+v", stats)
+	}
+}
+
+// TestEventQueueDropped checks that the events dropped by every policy (and by Close) are told so.
+func TestEventQueueDropped(t *testing.T) {
+	for _, test := range []struct {
+		policy  runtime.OverflowPolicy
+		dropped []int
+	}{
+		{policy: runtime.OverflowDropOldest, dropped: []int{1}},
+		{policy: runtime.OverflowDropNewest, dropped: []int{3}},
+	} {
+		q, release := blockedQueue(t, runtime.QueueConfig{Size: 2, Overflow: test.policy})
+
+		dropped := make(chan int, 3)
+		for i := 1; i <= 3; i++ {
+			q.PushDroppable("", func() {}, func() { dropped <- i })
+		}
+		for _, want := range test.dropped {
+			select {
+			case got := <-dropped:
+				if got != want {
+					t.Errorf("%s: dropped %d, want %d", test.policy, got, want)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("%s: not told about the dropped event", test.policy)
+			}
+		}
+
+		close(release)
+		q.Close(0)
+		select {
+		case got := <-dropped:
+			t.Errorf("%s: dropped %d after handling it", test.policy, got)
+		default:
+		}
+	}
+
+	// The events still queued when closing, and the ones pushed after that
+	q, release := blockedQueue(t, runtime.QueueConfig{})
+	defer close(release)
+	dropped := make(chan struct{}, 2)
+	q.PushDroppable("", func() {}, func() { dropped <- struct{}{} })
+	q.Close(10 * time.Millisecond)
+	q.PushDroppable("", func() {}, func() { dropped <- struct{}{} })
+	for range 2 {
+		select {
+		case <-dropped:
+		case <-time.After(5 * time.Second):
+			t.Fatal("not told about an event dropped by Close")
+		}
+	}
+}
+
+func TestQueueConfigValidate(t *testing.T) {
+	for _, config := range []runtime.QueueConfig{{}, {Size: 10, Workers: 2, Overflow: runtime.OverflowBlock}} {
+		if err := config.Validate(); err != nil {
+			t.Errorf("%+v: %v", config, err)
+		}
+	}
+	for _, config := range []runtime.QueueConfig{{Overflow: "drop_all"}, {Size: -1}, {Workers: -1}} {
+		if err := config.Validate(); err == nil {
+			t.Errorf("%+v: validated", config)
+		}
+	}
+}
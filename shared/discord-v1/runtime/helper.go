@@ -2,6 +2,8 @@ package runtime
 
 import (
 	"context"
+	"errors"
+	"io"
 
 	"github.com/bwmarrin/discordgo"
 	plugin "github.com/hashicorp/go-plugin"
@@ -18,6 +20,17 @@ type HelperServerImpl struct {
 	proto.UnimplementedHelperServer
 	Impl   shared.Helper // Helper implementation that provides Discord operations
 	broker *plugin.GRPCBroker
+
+	deadlines shared.Deadlines // Default deadlines of the calls
+	uploads   *UploadStore     // Files uploaded by the module
+	downloads *Downloader      // Attachments downloaded by the module
+}
+
+// impl returns Impl bound to the context of a call to method (cancelled when the module cancels
+// the call), with the deadline of method.
+func (h *HelperServerImpl) impl(ctx context.Context, method string) (shared.Helper, context.CancelFunc) {
+	ctx, cancel := h.deadlines.Context(ctx, method)
+	return shared.HelperWithContext(h.Impl, ctx), cancel
 }
 
 // ================================================
@@ -26,7 +39,10 @@ type HelperServerImpl struct {
 
 // ChannelMessageSend handles sending a simple text message.
 func (h *HelperServerImpl) ChannelMessageSend(ctx context.Context, req *proto.ChannelMessageSendRequest) (*proto.ChannelMessageSendResponse, error) {
-	message, err := h.Impl.ChannelMessageSend(req.ChannelId, req.Content)
+	impl, cancel := h.impl(ctx, "ChannelMessageSend")
+	defer cancel()
+
+	message, err := impl.ChannelMessageSend(req.ChannelId, req.Content)
 	if err != nil {
 		return nil, err
 	}
@@ -38,8 +54,20 @@ func (h *HelperServerImpl) ChannelMessageSend(ctx context.Context, req *proto.Ch
 
 // ChannelMessageSendComplex handles sending a complex message with attachments, embeds, etc.
 func (h *HelperServerImpl) ChannelMessageSendComplex(ctx context.Context, req *proto.ChannelMessageSendComplexRequest) (*proto.ChannelMessageSendComplexResponse, error) {
+	impl, cancel := h.impl(ctx, "ChannelMessageSendComplex")
+	defer cancel()
+
 	data := buf2struct.MessageSend(req.Data)
-	message, err := h.Impl.ChannelMessageSendComplex(req.ChannelId, data)
+	files, done, err := h.uploads.Files(req.Data.GetFiles())
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	if data != nil {
+		data.Files = files
+	}
+
+	message, err := impl.ChannelMessageSendComplex(req.ChannelId, data)
 	if err != nil {
 		return nil, err
 	}
@@ -51,8 +79,11 @@ func (h *HelperServerImpl) ChannelMessageSendComplex(ctx context.Context, req *p
 
 // ChannelMessageSendEmbed handles sending a message with a single embed.
 func (h *HelperServerImpl) ChannelMessageSendEmbed(ctx context.Context, req *proto.ChannelMessageSendEmbedRequest) (*proto.ChannelMessageSendEmbedResponse, error) {
+	impl, cancel := h.impl(ctx, "ChannelMessageSendEmbed")
+	defer cancel()
+
 	embed := buf2struct.MessageEmbed(req.Embed)
-	message, err := h.Impl.ChannelMessageSendEmbed(req.ChannelId, embed)
+	message, err := impl.ChannelMessageSendEmbed(req.ChannelId, embed)
 	if err != nil {
 		return nil, err
 	}
@@ -64,12 +95,15 @@ func (h *HelperServerImpl) ChannelMessageSendEmbed(ctx context.Context, req *pro
 
 // ChannelMessageSendEmbeds handles sending a message with multiple embeds.
 func (h *HelperServerImpl) ChannelMessageSendEmbeds(ctx context.Context, req *proto.ChannelMessageSendEmbedsRequest) (*proto.ChannelMessageSendEmbedsResponse, error) {
+	impl, cancel := h.impl(ctx, "ChannelMessageSendEmbeds")
+	defer cancel()
+
 	embeds := make([]*discordgo.MessageEmbed, 0, len(req.Embeds))
 	for _, embed := range req.Embeds {
 		embeds = append(embeds, buf2struct.MessageEmbed(embed))
 	}
 
-	message, err := h.Impl.ChannelMessageSendEmbeds(req.ChannelId, embeds)
+	message, err := impl.ChannelMessageSendEmbeds(req.ChannelId, embeds)
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +115,10 @@ func (h *HelperServerImpl) ChannelMessageSendEmbeds(ctx context.Context, req *pr
 
 // ChannelMessageEdit handles editing a message with simple text content.
 func (h *HelperServerImpl) ChannelMessageEdit(ctx context.Context, req *proto.ChannelMessageEditRequest) (*proto.ChannelMessageEditResponse, error) {
-	message, err := h.Impl.ChannelMessageEdit(req.ChannelId, req.MessageId, req.Content)
+	impl, cancel := h.impl(ctx, "ChannelMessageEdit")
+	defer cancel()
+
+	message, err := impl.ChannelMessageEdit(req.ChannelId, req.MessageId, req.Content)
 	if err != nil {
 		return nil, err
 	}
@@ -93,8 +130,20 @@ func (h *HelperServerImpl) ChannelMessageEdit(ctx context.Context, req *proto.Ch
 
 // ChannelMessageEditComplex handles editing a message with complex data.
 func (h *HelperServerImpl) ChannelMessageEditComplex(ctx context.Context, req *proto.ChannelMessageEditComplexRequest) (*proto.ChannelMessageEditComplexResponse, error) {
+	impl, cancel := h.impl(ctx, "ChannelMessageEditComplex")
+	defer cancel()
+
 	messageEdit := buf2struct.MessageEdit(req.MessageEdit)
-	message, err := h.Impl.ChannelMessageEditComplex(messageEdit)
+	files, done, err := h.uploads.Files(req.MessageEdit.GetFiles())
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	if messageEdit != nil {
+		messageEdit.Files = files
+	}
+
+	message, err := impl.ChannelMessageEditComplex(messageEdit)
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +155,10 @@ func (h *HelperServerImpl) ChannelMessageEditComplex(ctx context.Context, req *p
 
 // ChannelMessageDelete handles deleting a message from a channel.
 func (h *HelperServerImpl) ChannelMessageDelete(ctx context.Context, req *proto.ChannelMessageDeleteRequest) (*proto_common.Empty, error) {
-	err := h.Impl.ChannelMessageDelete(req.ChannelId, req.MessageId)
+	impl, cancel := h.impl(ctx, "ChannelMessageDelete")
+	defer cancel()
+
+	err := impl.ChannelMessageDelete(req.ChannelId, req.MessageId)
 	if err != nil {
 		return nil, err
 	}
@@ -116,7 +168,10 @@ func (h *HelperServerImpl) ChannelMessageDelete(ctx context.Context, req *proto.
 
 // ChannelMessages handles retrieving multiple messages from a channel.
 func (h *HelperServerImpl) ChannelMessages(ctx context.Context, req *proto.ChannelMessagesRequest) (*proto.ChannelMessagesResponse, error) {
-	messages, err := h.Impl.ChannelMessages(req.ChannelId, int(req.Limit), req.BeforeId, req.AfterId, req.AroundId)
+	impl, cancel := h.impl(ctx, "ChannelMessages")
+	defer cancel()
+
+	messages, err := impl.ChannelMessages(req.ChannelId, int(req.Limit), req.BeforeId, req.AfterId, req.AroundId)
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +188,10 @@ func (h *HelperServerImpl) ChannelMessages(ctx context.Context, req *proto.Chann
 
 // ChannelMessage handles retrieving a single message from a channel.
 func (h *HelperServerImpl) ChannelMessage(ctx context.Context, req *proto.ChannelMessageRequest) (*proto.ChannelMessageResponse, error) {
-	message, err := h.Impl.ChannelMessage(req.ChannelId, req.MessageId)
+	impl, cancel := h.impl(ctx, "ChannelMessage")
+	defer cancel()
+
+	message, err := impl.ChannelMessage(req.ChannelId, req.MessageId)
 	if err != nil {
 		return nil, err
 	}
@@ -149,7 +207,10 @@ func (h *HelperServerImpl) ChannelMessage(ctx context.Context, req *proto.Channe
 
 // Channel handles getting a channel by ID.
 func (h *HelperServerImpl) Channel(ctx context.Context, req *proto.ChannelRequest) (*proto.ChannelResponse, error) {
-	channel, err := h.Impl.Channel(req.ChannelId)
+	impl, cancel := h.impl(ctx, "Channel")
+	defer cancel()
+
+	channel, err := impl.Channel(req.ChannelId)
 	if err != nil {
 		return nil, err
 	}
@@ -161,8 +222,11 @@ func (h *HelperServerImpl) Channel(ctx context.Context, req *proto.ChannelReques
 
 // ChannelEdit handles editing a channel.
 func (h *HelperServerImpl) ChannelEdit(ctx context.Context, req *proto.ChannelEditRequest) (*proto.ChannelEditResponse, error) {
+	impl, cancel := h.impl(ctx, "ChannelEdit")
+	defer cancel()
+
 	data := buf2struct.ChannelEdit(req.Data)
-	channel, err := h.Impl.ChannelEdit(req.ChannelId, data)
+	channel, err := impl.ChannelEdit(req.ChannelId, data)
 	if err != nil {
 		return nil, err
 	}
@@ -174,7 +238,10 @@ func (h *HelperServerImpl) ChannelEdit(ctx context.Context, req *proto.ChannelEd
 
 // ChannelDelete handles deleting a channel.
 func (h *HelperServerImpl) ChannelDelete(ctx context.Context, req *proto.ChannelDeleteRequest) (*proto.ChannelDeleteResponse, error) {
-	channel, err := h.Impl.ChannelDelete(req.ChannelId)
+	impl, cancel := h.impl(ctx, "ChannelDelete")
+	defer cancel()
+
+	channel, err := impl.ChannelDelete(req.ChannelId)
 	if err != nil {
 		return nil, err
 	}
@@ -186,7 +253,10 @@ func (h *HelperServerImpl) ChannelDelete(ctx context.Context, req *proto.Channel
 
 // ChannelTyping handles sending typing indicator.
 func (h *HelperServerImpl) ChannelTyping(ctx context.Context, req *proto.ChannelTypingRequest) (*proto_common.Empty, error) {
-	err := h.Impl.ChannelTyping(req.ChannelId)
+	impl, cancel := h.impl(ctx, "ChannelTyping")
+	defer cancel()
+
+	err := impl.ChannelTyping(req.ChannelId)
 	if err != nil {
 		return nil, err
 	}
@@ -200,7 +270,10 @@ func (h *HelperServerImpl) ChannelTyping(ctx context.Context, req *proto.Channel
 
 // Guild handles getting a guild by ID.
 func (h *HelperServerImpl) Guild(ctx context.Context, req *proto.GuildRequest) (*proto.GuildResponse, error) {
-	guild, err := h.Impl.Guild(req.GuildId)
+	impl, cancel := h.impl(ctx, "Guild")
+	defer cancel()
+
+	guild, err := impl.Guild(req.GuildId)
 	if err != nil {
 		return nil, err
 	}
@@ -212,7 +285,10 @@ func (h *HelperServerImpl) Guild(ctx context.Context, req *proto.GuildRequest) (
 
 // GuildChannels handles getting channels in a guild.
 func (h *HelperServerImpl) GuildChannels(ctx context.Context, req *proto.GuildChannelsRequest) (*proto.GuildChannelsResponse, error) {
-	channels, err := h.Impl.GuildChannels(req.GuildId)
+	impl, cancel := h.impl(ctx, "GuildChannels")
+	defer cancel()
+
+	channels, err := impl.GuildChannels(req.GuildId)
 	if err != nil {
 		return nil, err
 	}
@@ -229,7 +305,10 @@ func (h *HelperServerImpl) GuildChannels(ctx context.Context, req *proto.GuildCh
 
 // GuildMembers handles getting members in a guild.
 func (h *HelperServerImpl) GuildMembers(ctx context.Context, req *proto.GuildMembersRequest) (*proto.GuildMembersResponse, error) {
-	members, err := h.Impl.GuildMembers(req.GuildId, req.After, int(req.Limit))
+	impl, cancel := h.impl(ctx, "GuildMembers")
+	defer cancel()
+
+	members, err := impl.GuildMembers(req.GuildId, req.After, int(req.Limit))
 	if err != nil {
 		return nil, err
 	}
@@ -246,7 +325,10 @@ func (h *HelperServerImpl) GuildMembers(ctx context.Context, req *proto.GuildMem
 
 // GuildMember handles getting a specific member in a guild.
 func (h *HelperServerImpl) GuildMember(ctx context.Context, req *proto.GuildMemberRequest) (*proto.GuildMemberResponse, error) {
-	member, err := h.Impl.GuildMember(req.GuildId, req.UserId)
+	impl, cancel := h.impl(ctx, "GuildMember")
+	defer cancel()
+
+	member, err := impl.GuildMember(req.GuildId, req.UserId)
 	if err != nil {
 		return nil, err
 	}
@@ -258,7 +340,10 @@ func (h *HelperServerImpl) GuildMember(ctx context.Context, req *proto.GuildMemb
 
 // GuildRoles handles getting roles in a guild.
 func (h *HelperServerImpl) GuildRoles(ctx context.Context, req *proto.GuildRolesRequest) (*proto.GuildRolesResponse, error) {
-	roles, err := h.Impl.GuildRoles(req.GuildId)
+	impl, cancel := h.impl(ctx, "GuildRoles")
+	defer cancel()
+
+	roles, err := impl.GuildRoles(req.GuildId)
 	if err != nil {
 		return nil, err
 	}
@@ -279,7 +364,10 @@ func (h *HelperServerImpl) GuildRoles(ctx context.Context, req *proto.GuildRoles
 
 // User handles getting a user by ID.
 func (h *HelperServerImpl) User(ctx context.Context, req *proto.UserRequest) (*proto.UserResponse, error) {
-	user, err := h.Impl.User(req.UserId)
+	impl, cancel := h.impl(ctx, "User")
+	defer cancel()
+
+	user, err := impl.User(req.UserId)
 	if err != nil {
 		return nil, err
 	}
@@ -291,7 +379,10 @@ func (h *HelperServerImpl) User(ctx context.Context, req *proto.UserRequest) (*p
 
 // UserChannelCreate handles creating a DM channel with a user.
 func (h *HelperServerImpl) UserChannelCreate(ctx context.Context, req *proto.UserChannelCreateRequest) (*proto.UserChannelCreateResponse, error) {
-	channel, err := h.Impl.UserChannelCreate(req.RecipientId)
+	impl, cancel := h.impl(ctx, "UserChannelCreate")
+	defer cancel()
+
+	channel, err := impl.UserChannelCreate(req.RecipientId)
 	if err != nil {
 		return nil, err
 	}
@@ -307,10 +398,21 @@ func (h *HelperServerImpl) UserChannelCreate(ctx context.Context, req *proto.Use
 
 // InteractionRespond handles responding to an interaction.
 func (h *HelperServerImpl) InteractionRespond(ctx context.Context, req *proto.InteractionRespondRequest) (*proto_common.Empty, error) {
+	impl, cancel := h.impl(ctx, "InteractionRespond")
+	defer cancel()
+
 	interaction := buf2struct.Interaction(req.Interaction)
 	response := buf2struct.InteractionResponse(req.Response)
+	files, done, err := h.uploads.Files(req.Response.GetData().GetFiles())
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	if response != nil && response.Data != nil {
+		response.Data.Files = files
+	}
 
-	err := h.Impl.InteractionRespond(interaction, response)
+	err = impl.InteractionRespond(interaction, response)
 	if err != nil {
 		return nil, err
 	}
@@ -320,10 +422,21 @@ func (h *HelperServerImpl) InteractionRespond(ctx context.Context, req *proto.In
 
 // InteractionResponseEdit handles editing an interaction response.
 func (h *HelperServerImpl) InteractionResponseEdit(ctx context.Context, req *proto.InteractionResponseEditRequest) (*proto.InteractionResponseEditResponse, error) {
+	impl, cancel := h.impl(ctx, "InteractionResponseEdit")
+	defer cancel()
+
 	interaction := buf2struct.Interaction(req.Interaction)
 	edit := buf2struct.WebhookEdit(req.WebhookEdit)
+	files, done, err := h.uploads.Files(req.WebhookEdit.GetFiles())
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	if edit != nil {
+		edit.Files = files
+	}
 
-	message, err := h.Impl.InteractionResponseEdit(interaction, edit)
+	message, err := impl.InteractionResponseEdit(interaction, edit)
 	if err != nil {
 		return nil, err
 	}
@@ -333,6 +446,139 @@ func (h *HelperServerImpl) InteractionResponseEdit(ctx context.Context, req *pro
 	}, nil
 }
 
+// ================================================
+// File operations
+// ================================================
+
+// UploadFile handles the upload of a large file, buffering it to disk until a call references it.
+func (h *HelperServerImpl) UploadFile(stream proto.Helper_UploadFileServer) error {
+	id, size, err := h.uploads.Store(&chunkReader{recv: stream.Recv})
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(&proto.UploadFileResponse{
+		UploadId: id,
+		Size:     size,
+	})
+}
+
+// downloadChunkSize is the size of the chunks of the downloaded attachments.
+const downloadChunkSize = 64 << 10
+
+// AttachmentDownload handles downloading an attachment, streaming its content to the module.
+func (h *HelperServerImpl) AttachmentDownload(req *proto.AttachmentDownloadRequest, stream proto.Helper_AttachmentDownloadServer) error {
+	impl, cancel := h.impl(stream.Context(), "AttachmentDownload")
+	defer cancel()
+
+	if req.Attachment == nil {
+		return errors.New("no attachment to download")
+	}
+	download, err := h.downloads.Download(impl, buf2struct.MessageAttachment(req.Attachment))
+	if err != nil {
+		return err
+	}
+	defer download.Close()
+
+	// The module gets the metadata (or the error) before the content
+	err = stream.Send(&proto.AttachmentDownloadChunk{
+		ContentType: download.ContentType,
+		Size:        download.Size,
+	})
+	if err != nil {
+		return err
+	}
+
+	chunk := make([]byte, downloadChunkSize)
+	for {
+		n, err := download.Read(chunk)
+		if n > 0 {
+			if err := stream.Send(&proto.AttachmentDownloadChunk{Data: chunk[:n]}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// chunkReader reads the data of the chunks of an upload stream.
+type chunkReader struct {
+	recv func() (*proto.UploadFileChunk, error)
+	data []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		chunk, err := r.recv()
+		if err != nil {
+			return 0, err // io.EOF once the module closed the stream
+		}
+		r.data = chunk.Data
+	}
+
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+// ================================================
+// Webhook operations
+// ================================================
+
+// WebhookExecute handles executing a webhook.
+func (h *HelperServerImpl) WebhookExecute(ctx context.Context, req *proto.WebhookExecuteRequest) (*proto.WebhookExecuteResponse, error) {
+	impl, cancel := h.impl(ctx, "WebhookExecute")
+	defer cancel()
+
+	data := buf2struct.WebhookParams(req.Data)
+	files, done, err := h.uploads.Files(req.Data.GetFiles())
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	if data != nil {
+		data.Files = files
+	}
+
+	message, err := impl.WebhookExecute(req.WebhookId, req.Token, req.Wait, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.WebhookExecuteResponse{
+		Message: struct2buf.Message(message),
+	}, nil
+}
+
+// ================================================
+// Voice operations (implemented in proto)
+// ================================================
+
+// GuildVoiceStates handles getting the voice states of a guild.
+func (h *HelperServerImpl) GuildVoiceStates(ctx context.Context, req *proto.GuildVoiceStatesRequest) (*proto.GuildVoiceStatesResponse, error) {
+	impl, cancel := h.impl(ctx, "GuildVoiceStates")
+	defer cancel()
+
+	states, err := impl.GuildVoiceStates(req.GuildId)
+	if err != nil {
+		return nil, err
+	}
+
+	protoStates := make([]*proto.VoiceState, 0, len(states))
+	for _, state := range states {
+		protoStates = append(protoStates, struct2buf.VoiceState(state))
+	}
+
+	return &proto.GuildVoiceStatesResponse{
+		VoiceStates: protoStates,
+	}, nil
+}
+
 // ================================================
 // Helper Client for runtime (stub implementation for interface compliance)
 // ================================================
@@ -345,6 +591,10 @@ type HelperClientImpl struct {
 }
 
 // Minimal implementation for interface compliance - just enough to satisfy shared.Helper interface
+func (h *HelperClientImpl) WithContext(ctx context.Context) shared.Helper {
+	return h
+}
+
 func (h *HelperClientImpl) ChannelMessageSend(channelID string, content string) (*discordgo.Message, error) {
 	return nil, nil
 }
@@ -485,6 +735,14 @@ func (h *HelperClientImpl) VoiceRegions() ([]*discordgo.VoiceRegion, error) {
 	return nil, nil
 }
 
+func (h *HelperClientImpl) GuildVoiceStates(guildID string) ([]*discordgo.VoiceState, error) {
+	return nil, nil
+}
+
+func (h *HelperClientImpl) AttachmentDownload(attachment *discordgo.MessageAttachment) (*shared.Download, error) {
+	return nil, nil
+}
+
 func (h *HelperClientImpl) WebhookCreate(channelID, name, avatar string) (*discordgo.Webhook, error) {
 	return nil, nil
 }
@@ -506,4 +764,7 @@ func (h *HelperClientImpl) GatewayBot() (*discordgo.GatewayBotResponse, error) {
 }
 
 // Ensure HelperClientImpl implements the Helper interface
-var _ shared.Helper = &HelperClientImpl{}
+var (
+	_ shared.Helper        = &HelperClientImpl{}
+	_ shared.ContextHelper = &HelperClientImpl{}
+)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/bwmarrin/discordgo"
 	plugin "github.com/hashicorp/go-plugin"
+	proto_common "github.com/thirdscam/chatanium-flexmodule/proto"
 	proto "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
 	shared "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
 	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/convert/buf2struct"
@@ -18,6 +19,28 @@ type HookClient struct {
 	broker         *plugin.GRPCBroker
 	client         proto.HookClient
 	helperServerID uint32 // Broker server ID for Helper service
+	helperNetwork  string // Direct Helper server address (only set after reattaching)
+	helperAddress  string
+
+	ctx       context.Context  // Context of the calls (see WithContext), Background if nil
+	deadlines shared.Deadlines // Default deadlines of the hooks
+	uploads   *UploadStore     // Files uploaded by the module (attached to its responses)
+}
+
+// WithContext returns a copy of the client whose calls are made with ctx.
+func (m *HookClient) WithContext(ctx context.Context) shared.Hook {
+	c := *m
+	c.ctx = ctx
+	return &c
+}
+
+// callContext returns the context of a call to hook, with its deadline.
+func (m *HookClient) callContext(hook string) (context.Context, context.CancelFunc) {
+	ctx := m.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return m.deadlines.Context(ctx, hook)
 }
 
 // ================================================
@@ -26,9 +49,14 @@ type HookClient struct {
 
 // OnInit calls the OnInit RPC method and returns the initialization response.
 func (m *HookClient) OnInit(helper shared.Helper) shared.InitResponse {
+	ctx, cancel := m.callContext("OnInit")
+	defer cancel()
+
 	// Pass the helper server ID to the module so it can dial the broker server
-	resp, err := m.client.OnInit(context.Background(), &proto.InitRequest{
-		HelperServerId: m.helperServerID,
+	resp, err := m.client.OnInit(ctx, &proto.InitRequest{
+		HelperServerId:      m.helperServerID,
+		HelperServerNetwork: m.helperNetwork,
+		HelperServerAddress: m.helperAddress,
 	})
 	if err != nil {
 		return shared.InitResponse{}
@@ -44,32 +72,326 @@ func (m *HookClient) OnInit(helper shared.Helper) shared.InitResponse {
 
 	return shared.InitResponse{
 		Interactions: cmds,
+		Hooks:        resp.Hooks,
 	}
 }
 
 // OnCreateChatMessage sends a message to the plugin via RPC.
 func (m *HookClient) OnCreateChatMessage(message *discordgo.Message) error {
+	ctx, cancel := m.callContext("OnCreateChatMessage")
+	defer cancel()
+
 	_, err := m.client.OnCreateMessage(
-		context.Background(),
+		ctx,
 		struct2buf.Message(message),
 	)
 	return err
 }
 
-// OnCreateInteraction sends an interaction to the plugin via RPC.
-func (m *HookClient) OnCreateInteraction(interaction *discordgo.Interaction) error {
-	_, err := m.client.OnCreateInteraction(
-		context.Background(),
-		struct2buf.Interaction(interaction),
+// OnUpdateChatMessage sends an edited message (and its previous version) to the plugin via RPC.
+func (m *HookClient) OnUpdateChatMessage(message *discordgo.Message, before *discordgo.Message) error {
+	ctx, cancel := m.callContext("OnUpdateChatMessage")
+	defer cancel()
+
+	_, err := m.client.OnUpdateMessage(
+		ctx,
+		&proto.OnUpdateMessageRequest{
+			Message: struct2buf.Message(message),
+			Before:  struct2buf.Message(before),
+		},
+	)
+	return err
+}
+
+// OnDeleteChatMessage sends a deleted message (and its previous version) to the plugin via RPC.
+func (m *HookClient) OnDeleteChatMessage(message *discordgo.Message, before *discordgo.Message) error {
+	ctx, cancel := m.callContext("OnDeleteChatMessage")
+	defer cancel()
+
+	_, err := m.client.OnDeleteMessage(
+		ctx,
+		&proto.OnDeleteMessageRequest{
+			Message: struct2buf.Message(message),
+			Before:  struct2buf.Message(before),
+		},
+	)
+	return err
+}
+
+// OnBulkDeleteChatMessages sends bulk-deleted messages to the plugin via RPC.
+func (m *HookClient) OnBulkDeleteChatMessages(bulk *discordgo.MessageDeleteBulk, before []*discordgo.Message) error {
+	ctx, cancel := m.callContext("OnBulkDeleteChatMessages")
+	defer cancel()
+
+	messages := make([]*proto.Message, 0, len(before))
+	for _, message := range before {
+		messages = append(messages, struct2buf.Message(message))
+	}
+
+	_, err := m.client.OnBulkDeleteMessages(
+		ctx,
+		&proto.OnBulkDeleteMessagesRequest{
+			MessageIds: bulk.Messages,
+			ChannelId:  bulk.ChannelID,
+			GuildId:    bulk.GuildID,
+			Before:     messages,
+		},
+	)
+	return err
+}
+
+// OnAddReaction sends an added reaction to the plugin via RPC.
+func (m *HookClient) OnAddReaction(reaction *discordgo.MessageReactionAdd) error {
+	ctx, cancel := m.callContext("OnAddReaction")
+	defer cancel()
+
+	_, err := m.client.OnAddReaction(
+		ctx,
+		&proto.OnAddReactionRequest{
+			Reaction: struct2buf.MessageReaction(reaction.MessageReaction),
+			Member:   struct2buf.Member(reaction.Member),
+		},
+	)
+	return err
+}
+
+// OnRemoveReaction sends a removed reaction to the plugin via RPC.
+func (m *HookClient) OnRemoveReaction(reaction *discordgo.MessageReactionRemove) error {
+	ctx, cancel := m.callContext("OnRemoveReaction")
+	defer cancel()
+
+	_, err := m.client.OnRemoveReaction(
+		ctx,
+		struct2buf.MessageReaction(reaction.MessageReaction),
+	)
+	return err
+}
+
+// OnRemoveAllReactions sends the removal of all reactions of a message to the plugin via RPC.
+func (m *HookClient) OnRemoveAllReactions(reaction *discordgo.MessageReactionRemoveAll) error {
+	ctx, cancel := m.callContext("OnRemoveAllReactions")
+	defer cancel()
+
+	_, err := m.client.OnRemoveAllReactions(
+		ctx,
+		struct2buf.MessageReaction(reaction.MessageReaction),
+	)
+	return err
+}
+
+// OnRemoveEmojiReactions sends the removal of all reactions of an emoji to the plugin via RPC.
+func (m *HookClient) OnRemoveEmojiReactions(reaction *discordgo.MessageReaction) error {
+	ctx, cancel := m.callContext("OnRemoveEmojiReactions")
+	defer cancel()
+
+	_, err := m.client.OnRemoveEmojiReactions(
+		ctx,
+		struct2buf.MessageReaction(reaction),
+	)
+	return err
+}
+
+// OnAddGuildMember sends a joined member to the plugin via RPC.
+func (m *HookClient) OnAddGuildMember(member *discordgo.Member) error {
+	ctx, cancel := m.callContext("OnAddGuildMember")
+	defer cancel()
+
+	_, err := m.client.OnAddGuildMember(
+		ctx,
+		struct2buf.Member(member),
+	)
+	return err
+}
+
+// OnRemoveGuildMember sends a left member to the plugin via RPC.
+func (m *HookClient) OnRemoveGuildMember(member *discordgo.Member) error {
+	ctx, cancel := m.callContext("OnRemoveGuildMember")
+	defer cancel()
+
+	_, err := m.client.OnRemoveGuildMember(
+		ctx,
+		struct2buf.Member(member),
+	)
+	return err
+}
+
+// OnUpdateGuildMember sends an updated member (and its previous version) to the plugin via RPC.
+func (m *HookClient) OnUpdateGuildMember(member *discordgo.Member, before *discordgo.Member) error {
+	ctx, cancel := m.callContext("OnUpdateGuildMember")
+	defer cancel()
+
+	_, err := m.client.OnUpdateGuildMember(
+		ctx,
+		&proto.OnUpdateGuildMemberRequest{
+			Member: struct2buf.Member(member),
+			Before: struct2buf.Member(before),
+		},
+	)
+	return err
+}
+
+// OnAddGuildBan sends a ban to the plugin via RPC.
+func (m *HookClient) OnAddGuildBan(ban *discordgo.GuildBanAdd) error {
+	ctx, cancel := m.callContext("OnAddGuildBan")
+	defer cancel()
+
+	_, err := m.client.OnAddGuildBan(
+		ctx,
+		&proto.GuildBanEvent{GuildId: ban.GuildID, User: struct2buf.User(ban.User)},
+	)
+	return err
+}
+
+// OnRemoveGuildBan sends an unban to the plugin via RPC.
+func (m *HookClient) OnRemoveGuildBan(ban *discordgo.GuildBanRemove) error {
+	ctx, cancel := m.callContext("OnRemoveGuildBan")
+	defer cancel()
+
+	_, err := m.client.OnRemoveGuildBan(
+		ctx,
+		&proto.GuildBanEvent{GuildId: ban.GuildID, User: struct2buf.User(ban.User)},
+	)
+	return err
+}
+
+// OnVoiceStateUpdate sends a voice state update (and the previous state) to the plugin via RPC.
+func (m *HookClient) OnVoiceStateUpdate(state *discordgo.VoiceState, before *discordgo.VoiceState) error {
+	ctx, cancel := m.callContext("OnVoiceStateUpdate")
+	defer cancel()
+
+	_, err := m.client.OnVoiceStateUpdate(
+		ctx,
+		&proto.OnVoiceStateUpdateRequest{
+			VoiceState: struct2buf.VoiceState(state),
+			Before:     struct2buf.VoiceState(before),
+		},
+	)
+	return err
+}
+
+// OnReady sends the ready event to the plugin via RPC.
+func (m *HookClient) OnReady(ready *discordgo.Ready) error {
+	ctx, cancel := m.callContext("OnReady")
+	defer cancel()
+
+	guilds := make([]*proto.Guild, 0, len(ready.Guilds))
+	for _, guild := range ready.Guilds {
+		guilds = append(guilds, struct2buf.Guild(guild))
+	}
+
+	_, err := m.client.OnReady(
+		ctx,
+		&proto.OnReadyRequest{
+			User:      struct2buf.User(ready.User),
+			SessionId: ready.SessionID,
+			Guilds:    guilds,
+		},
+	)
+	return err
+}
+
+// OnResumed sends the resumed event to the plugin via RPC.
+func (m *HookClient) OnResumed() error {
+	ctx, cancel := m.callContext("OnResumed")
+	defer cancel()
+
+	_, err := m.client.OnResumed(ctx, &proto_common.Empty{})
+	return err
+}
+
+// OnDisconnect sends the disconnect event to the plugin via RPC.
+func (m *HookClient) OnDisconnect() error {
+	ctx, cancel := m.callContext("OnDisconnect")
+	defer cancel()
+
+	_, err := m.client.OnDisconnect(ctx, &proto_common.Empty{})
+	return err
+}
+
+// OnCreateGuild sends an available guild to the plugin via RPC.
+func (m *HookClient) OnCreateGuild(guild *discordgo.Guild) error {
+	ctx, cancel := m.callContext("OnCreateGuild")
+	defer cancel()
+
+	_, err := m.client.OnCreateGuild(
+		ctx,
+		struct2buf.Guild(guild),
+	)
+	return err
+}
+
+// OnUpdateGuild sends an updated guild to the plugin via RPC.
+func (m *HookClient) OnUpdateGuild(guild *discordgo.Guild) error {
+	ctx, cancel := m.callContext("OnUpdateGuild")
+	defer cancel()
+
+	_, err := m.client.OnUpdateGuild(
+		ctx,
+		struct2buf.Guild(guild),
+	)
+	return err
+}
+
+// OnDeleteGuild sends a deleted (or unavailable) guild to the plugin via RPC.
+func (m *HookClient) OnDeleteGuild(guild *discordgo.Guild, before *discordgo.Guild) error {
+	ctx, cancel := m.callContext("OnDeleteGuild")
+	defer cancel()
+
+	_, err := m.client.OnDeleteGuild(
+		ctx,
+		&proto.OnDeleteGuildRequest{
+			Guild:  struct2buf.Guild(guild),
+			Before: struct2buf.Guild(before),
+		},
 	)
 	return err
 }
 
+// OnCreateInteraction sends an interaction to the plugin via RPC, and returns the response of the plugin.
+func (m *HookClient) OnCreateInteraction(interaction *discordgo.Interaction) (*discordgo.InteractionResponse, error) {
+	ctx, cancel := m.callContext("OnCreateInteraction")
+	defer cancel()
+
+	resp, err := m.client.OnCreateInteraction(
+		ctx,
+		struct2buf.Interaction(interaction),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := buf2struct.InteractionResponse(resp.Response)
+	if response != nil && response.Data != nil {
+		// The response is sent after the call returns, so its uploads are deleted once its files are
+		// closed (see InteractionResponder.Handle), or when they expire
+		files, _, err := m.uploads.Files(resp.Response.GetData().GetFiles())
+		if err != nil {
+			return nil, err
+		}
+		response.Data.Files = files
+	}
+	return response, nil
+}
+
 // OnEvent sends an event to the plugin via RPC.
-func (m *HookClient) OnEvent(event string) error {
+func (m *HookClient) OnEvent(event *discordgo.Event) error {
+	return m.OnGatewayEvent(struct2buf.GatewayEvent(event))
+}
+
+// GatewayEventHook is implemented by the hooks that take the gateway events already converted, so
+// that an event sent to several modules is converted once (see struct2buf.GatewayEvent).
+type GatewayEventHook interface {
+	OnGatewayEvent(event *proto.GatewayEvent) error
+}
+
+// OnGatewayEvent sends a converted event to the plugin via RPC.
+func (m *HookClient) OnGatewayEvent(event *proto.GatewayEvent) error {
+	ctx, cancel := m.callContext("OnEvent")
+	defer cancel()
+
 	_, err := m.client.OnEvent(
-		context.Background(),
-		&proto.OnEventRequest{Event: event},
+		ctx,
+		event,
 	)
 	return err
 }
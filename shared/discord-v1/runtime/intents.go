@@ -0,0 +1,156 @@
+package runtime
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	core "github.com/thirdscam/chatanium-flexmodule/shared/core-v1"
+	shared "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+)
+
+// RuntimeIntents are always requested, for the runtime itself: the guild list (command registration,
+// guild scopes) and the voice states (voice connections).
+const RuntimeIntents = discordgo.IntentGuilds | discordgo.IntentGuildVoiceStates
+
+// PrivilegedIntents must be enabled for the bot in the Developer Portal, otherwise Discord closes
+// the gateway connection when they are requested.
+const PrivilegedIntents = discordgo.IntentGuildMembers | discordgo.IntentGuildPresences | discordgo.IntentMessageContent
+
+var privilegedIntentNames = map[string]discordgo.Intent{
+	"GUILD_MEMBERS":   discordgo.IntentGuildMembers,
+	"GUILD_PRESENCES": discordgo.IntentGuildPresences,
+	"MESSAGE_CONTENT": discordgo.IntentMessageContent,
+}
+
+// eventIntents are the intents that deliver each gateway event.
+var eventIntents = map[string]discordgo.Intent{
+	"GUILD_CREATE":          discordgo.IntentGuilds,
+	"GUILD_UPDATE":          discordgo.IntentGuilds,
+	"GUILD_DELETE":          discordgo.IntentGuilds,
+	"GUILD_ROLE_CREATE":     discordgo.IntentGuilds,
+	"GUILD_ROLE_UPDATE":     discordgo.IntentGuilds,
+	"GUILD_ROLE_DELETE":     discordgo.IntentGuilds,
+	"CHANNEL_CREATE":        discordgo.IntentGuilds,
+	"CHANNEL_UPDATE":        discordgo.IntentGuilds,
+	"CHANNEL_DELETE":        discordgo.IntentGuilds,
+	"CHANNEL_PINS_UPDATE":   discordgo.IntentGuilds | discordgo.IntentDirectMessages,
+	"THREAD_CREATE":         discordgo.IntentGuilds,
+	"THREAD_UPDATE":         discordgo.IntentGuilds,
+	"THREAD_DELETE":         discordgo.IntentGuilds,
+	"THREAD_LIST_SYNC":      discordgo.IntentGuilds,
+	"THREAD_MEMBER_UPDATE":  discordgo.IntentGuilds,
+	"STAGE_INSTANCE_CREATE": discordgo.IntentGuilds,
+	"STAGE_INSTANCE_UPDATE": discordgo.IntentGuilds,
+	"STAGE_INSTANCE_DELETE": discordgo.IntentGuilds,
+
+	"GUILD_MEMBER_ADD":      discordgo.IntentGuildMembers,
+	"GUILD_MEMBER_UPDATE":   discordgo.IntentGuildMembers,
+	"GUILD_MEMBER_REMOVE":   discordgo.IntentGuildMembers,
+	"THREAD_MEMBERS_UPDATE": discordgo.IntentGuildMembers,
+
+	"GUILD_BAN_ADD":                discordgo.IntentGuildModeration,
+	"GUILD_BAN_REMOVE":             discordgo.IntentGuildModeration,
+	"GUILD_AUDIT_LOG_ENTRY_CREATE": discordgo.IntentGuildModeration,
+
+	"GUILD_EMOJIS_UPDATE":       discordgo.IntentGuildEmojis,
+	"GUILD_STICKERS_UPDATE":     discordgo.IntentGuildEmojis,
+	"GUILD_INTEGRATIONS_UPDATE": discordgo.IntentGuildIntegrations,
+	"INTEGRATION_CREATE":        discordgo.IntentGuildIntegrations,
+	"INTEGRATION_UPDATE":        discordgo.IntentGuildIntegrations,
+	"INTEGRATION_DELETE":        discordgo.IntentGuildIntegrations,
+	"WEBHOOKS_UPDATE":           discordgo.IntentGuildWebhooks,
+	"INVITE_CREATE":             discordgo.IntentGuildInvites,
+	"INVITE_DELETE":             discordgo.IntentGuildInvites,
+	"VOICE_STATE_UPDATE":        discordgo.IntentGuildVoiceStates,
+	"PRESENCE_UPDATE":           discordgo.IntentGuildPresences,
+
+	"MESSAGE_CREATE":                discordgo.IntentGuildMessages | discordgo.IntentDirectMessages,
+	"MESSAGE_UPDATE":                discordgo.IntentGuildMessages | discordgo.IntentDirectMessages,
+	"MESSAGE_DELETE":                discordgo.IntentGuildMessages | discordgo.IntentDirectMessages,
+	"MESSAGE_DELETE_BULK":           discordgo.IntentGuildMessages,
+	"MESSAGE_REACTION_ADD":          discordgo.IntentGuildMessageReactions | discordgo.IntentDirectMessageReactions,
+	"MESSAGE_REACTION_REMOVE":       discordgo.IntentGuildMessageReactions | discordgo.IntentDirectMessageReactions,
+	"MESSAGE_REACTION_REMOVE_ALL":   discordgo.IntentGuildMessageReactions | discordgo.IntentDirectMessageReactions,
+	"MESSAGE_REACTION_REMOVE_EMOJI": discordgo.IntentGuildMessageReactions | discordgo.IntentDirectMessageReactions,
+	"TYPING_START":                  discordgo.IntentGuildMessageTyping | discordgo.IntentDirectMessageTyping,
+
+	"GUILD_SCHEDULED_EVENT_CREATE":      discordgo.IntentGuildScheduledEvents,
+	"GUILD_SCHEDULED_EVENT_UPDATE":      discordgo.IntentGuildScheduledEvents,
+	"GUILD_SCHEDULED_EVENT_DELETE":      discordgo.IntentGuildScheduledEvents,
+	"GUILD_SCHEDULED_EVENT_USER_ADD":    discordgo.IntentGuildScheduledEvents,
+	"GUILD_SCHEDULED_EVENT_USER_REMOVE": discordgo.IntentGuildScheduledEvents,
+
+	"AUTO_MODERATION_RULE_CREATE":      discordgo.IntentAutoModerationConfiguration,
+	"AUTO_MODERATION_RULE_UPDATE":      discordgo.IntentAutoModerationConfiguration,
+	"AUTO_MODERATION_RULE_DELETE":      discordgo.IntentAutoModerationConfiguration,
+	"AUTO_MODERATION_ACTION_EXECUTION": discordgo.IntentAutoModerationExecution,
+}
+
+// Intents returns the gateway intents needed by a module with the given manifest.
+//
+// A module without subscriptions (or with a subscription to "*" or to an empty event) receives
+// every event, so it gets every non-privileged intent. Otherwise only the intents of its
+// subscribed events are requested. Privileged intents are only requested for subscribed events
+// that need them, and the message content intent for modules with the PermissionOnCreateMessage
+// permission or a prefix subscription.
+func Intents(manifest core.Manifest) discordgo.Intent {
+	var intents discordgo.Intent
+	if len(manifest.Subscriptions) == 0 {
+		intents |= discordgo.IntentsAllWithoutPrivileged
+	}
+
+	for _, sub := range manifest.Subscriptions {
+		if sub.Event == "" || sub.Event == "*" {
+			intents |= discordgo.IntentsAllWithoutPrivileged
+		}
+		intents |= eventIntents[sub.Event]
+
+		// The runtime needs the content to match the prefix
+		if sub.MessagePrefix != "" {
+			intents |= discordgo.IntentMessageContent
+		}
+	}
+
+	if manifest.Permissions.Has(shared.PermissionOnCreateMessage) {
+		intents |= discordgo.IntentMessageContent
+	}
+
+	return intents
+}
+
+// UnsubscribedHooks returns the hooks (see shared.PrivilegedHooks) whose privileged intent isn't
+// in intents, so that they are never called.
+func UnsubscribedHooks(hooks []string, intents discordgo.Intent) []string {
+	var unsubscribed []string
+	for _, hook := range hooks {
+		if intent, ok := shared.PrivilegedHooks[hook]; ok && intents&intent == 0 {
+			unsubscribed = append(unsubscribed, hook)
+		}
+	}
+	return unsubscribed
+}
+
+// ParsePrivilegedIntents parses the names of privileged intents (GUILD_MEMBERS, GUILD_PRESENCES, MESSAGE_CONTENT).
+func ParsePrivilegedIntents(names []string) (discordgo.Intent, error) {
+	var intents discordgo.Intent
+	for _, name := range names {
+		intent, ok := privilegedIntentNames[strings.ToUpper(name)]
+		if !ok {
+			return 0, fmt.Errorf("unknown privileged intent %q", name)
+		}
+		intents |= intent
+	}
+	return intents, nil
+}
+
+// PrivilegedIntentNames returns the names of the privileged intents in intents.
+func PrivilegedIntentNames(intents discordgo.Intent) []string {
+	var names []string
+	for _, name := range []string{"GUILD_MEMBERS", "GUILD_PRESENCES", "MESSAGE_CONTENT"} {
+		if intents&privilegedIntentNames[name] != 0 {
+			names = append(names, name)
+		}
+	}
+	return names
+}
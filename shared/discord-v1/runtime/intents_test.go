@@ -0,0 +1,85 @@
+package runtime_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	core "github.com/thirdscam/chatanium-flexmodule/shared/core-v1"
+	shared "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+)
+
+func TestIntents(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		manifest core.Manifest
+		want     discordgo.Intent
+	}{
+		{name: "no subscriptions", want: discordgo.IntentsAllWithoutPrivileged},
+		{
+			name:     "any event",
+			manifest: core.Manifest{Subscriptions: []core.Subscription{{Event: "*"}}},
+			want:     discordgo.IntentsAllWithoutPrivileged,
+		},
+		{
+			name:     "empty event",
+			manifest: core.Manifest{Subscriptions: []core.Subscription{{Guilds: []string{"A"}}}},
+			want:     discordgo.IntentsAllWithoutPrivileged,
+		},
+		{
+			name:     "events",
+			manifest: core.Manifest{Subscriptions: []core.Subscription{{Event: "INTERACTION_CREATE"}, {Event: "MESSAGE_REACTION_ADD"}}},
+			want:     discordgo.IntentGuildMessageReactions | discordgo.IntentDirectMessageReactions,
+		},
+		{
+			name:     "privileged event",
+			manifest: core.Manifest{Subscriptions: []core.Subscription{{Event: "GUILD_MEMBER_ADD"}}},
+			want:     discordgo.IntentGuildMembers,
+		},
+		{
+			name:     "prefix",
+			manifest: core.Manifest{Subscriptions: []core.Subscription{{Event: "MESSAGE_CREATE", MessagePrefix: "!"}}},
+			want:     discordgo.IntentGuildMessages | discordgo.IntentDirectMessages | discordgo.IntentMessageContent,
+		},
+		{
+			name: "message permission",
+			manifest: core.Manifest{
+				Permissions:   core.Permissions{shared.PermissionOnCreateMessage},
+				Subscriptions: []core.Subscription{{Event: "MESSAGE_CREATE"}},
+			},
+			want: discordgo.IntentGuildMessages | discordgo.IntentDirectMessages | discordgo.IntentMessageContent,
+		},
+	} {
+		if got := runtime.Intents(test.manifest); got != test.want {
+			t.Errorf("%s: got %b, want %b", test.name, got, test.want)
+		}
+	}
+}
+
+func TestPrivilegedIntents(t *testing.T) {
+	intents, err := runtime.ParsePrivilegedIntents([]string{"guild_members", "MESSAGE_CONTENT"})
+	if err != nil || intents != discordgo.IntentGuildMembers|discordgo.IntentMessageContent {
+		t.Errorf("got %b, %v", intents, err)
+	}
+	if names := runtime.PrivilegedIntentNames(intents | discordgo.IntentGuilds); !slices.Equal(names, []string{"GUILD_MEMBERS", "MESSAGE_CONTENT"}) {
+		t.Errorf("names: got %v", names)
+	}
+	if _, err := runtime.ParsePrivilegedIntents([]string{"GUILDS"}); err == nil {
+		t.Error("non-privileged intent parsed")
+	}
+}
+
+func TestUnsubscribedHooks(t *testing.T) {
+	hooks := []string{"OnAddGuildMember", "OnUpdateGuildMember"}
+	if got := runtime.UnsubscribedHooks(hooks, discordgo.IntentsAllWithoutPrivileged); !slices.Equal(got, hooks) {
+		t.Errorf("without the intent: got %v", got)
+	}
+	if got := runtime.UnsubscribedHooks(hooks, discordgo.IntentGuildMembers); len(got) != 0 {
+		t.Errorf("with the intent: got %v", got)
+	}
+	// Unknown hooks (e.g. of a newer SDK) are ignored
+	if got := runtime.UnsubscribedHooks([]string{"OnSomething"}, 0); len(got) != 0 {
+		t.Errorf("unknown hook: got %v", got)
+	}
+}
@@ -0,0 +1,295 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	shared "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+)
+
+// interactionTokenLifetime is how long the token of an interaction can be used to edit its response.
+const interactionTokenLifetime = 15 * time.Minute
+
+// InteractionResponder sends the responses returned by OnCreateInteraction, and acknowledges the
+// interactions that the modules don't answer in time (Discord fails an interaction that isn't
+// acknowledged within 3 seconds).
+//
+// It tracks which interactions were acknowledged, so that a response (returned by the hook or sent
+// with Helper.InteractionRespond) to an interaction deferred by the runtime edits the deferred response.
+type InteractionResponder struct {
+	helper shared.Helper
+
+	mu   sync.Mutex
+	acks map[string]*interactionAck // By interaction ID
+}
+
+type interactionAck struct {
+	mu       sync.Mutex
+	created  time.Time
+	acked    bool                              // A response (or a deferral) was sent
+	deferred discordgo.InteractionResponseType // Type of the deferral sent by the runtime, if any
+}
+
+// NewInteractionResponder creates a responder that sends the responses with helper.
+func NewInteractionResponder(helper shared.Helper) *InteractionResponder {
+	return &InteractionResponder{
+		helper: helper,
+		acks:   make(map[string]*interactionAck),
+	}
+}
+
+// Helper returns the helper of the responder, with InteractionRespond aware of the deferrals.
+// The modules must use it so that their responses don't conflict with the runtime's.
+func (r *InteractionResponder) Helper() shared.Helper {
+	return &responderHelper{Helper: r.helper, responder: r}
+}
+
+// failedContent replaces the deferred response of an interaction whose hook failed.
+const failedContent = "This interaction failed, try again later."
+
+// PendingInteraction is an interaction received by the runtime, deferred by the responder if it
+// isn't answered by its deadline. It must be handled (or dropped) once.
+type PendingInteraction struct {
+	responder *InteractionResponder
+	i         *discordgo.Interaction
+	ack       *interactionAck
+
+	timer    *time.Timer
+	deferred chan struct{} // Closed once the timer deferred the interaction
+	deferErr error
+}
+
+// Start starts the deferral of an interaction at deadline (unless it's zero), as soon as it's
+// received: the time an interaction waits in the queue of its module counts toward the 3 seconds.
+func (r *InteractionResponder) Start(i *discordgo.Interaction, deadline time.Time) *PendingInteraction {
+	p := &PendingInteraction{responder: r, i: i, ack: r.ack(i.ID), deferred: make(chan struct{})}
+	if !deadline.IsZero() {
+		p.timer = time.AfterFunc(time.Until(deadline), func() {
+			defer close(p.deferred)
+			if err := r.deferResponse(i, p.ack); err != nil {
+				p.deferErr = fmt.Errorf("deferring interaction: %w", err)
+			}
+		})
+	}
+	return p
+}
+
+// Handle starts the interaction (see Start) and handles it (see PendingInteraction.Handle).
+func (r *InteractionResponder) Handle(i *discordgo.Interaction, deadline time.Time, hook func() (*discordgo.InteractionResponse, error)) error {
+	return r.Start(i, deadline).Handle(hook)
+}
+
+// Handle calls hook for the interaction and sends its response, then closes its files. If hook didn't
+// return by the deadline, the interaction is deferred, and the response edits it. If hook fails
+// after that, the deferred message is edited into an error.
+//
+// Components are deferred as message updates, so they can only be answered with UpdateMessage
+// afterwards. Autocompletes can't be deferred, so they are answered with no choices, and the late
+// choices are dropped. The other interactions can only be answered with ChannelMessageWithSource,
+// and an ephemeral one replaces the deferred response with a follow-up message.
+func (p *PendingInteraction) Handle(hook func() (*discordgo.InteractionResponse, error)) error {
+	resp, err := hook()
+	for _, closer := range fileClosers(resp) {
+		defer closer.Close()
+	}
+	p.stop()
+	if err != nil {
+		return errors.Join(p.deferErr, err, p.responder.fail(p.i, p.ack))
+	}
+	if resp == nil {
+		return p.deferErr
+	}
+	return errors.Join(p.deferErr, p.responder.respond(p.responder.helper, p.i, p.ack, resp))
+}
+
+// Drop answers the interaction with BusyResponse instead of handling it, e.g. when the queue of
+// its module is full.
+func (p *PendingInteraction) Drop() error {
+	p.stop()
+	return errors.Join(p.deferErr, p.responder.respond(p.responder.helper, p.i, p.ack, BusyResponse(p.i)))
+}
+
+// BusyResponse is the response to an interaction that its module couldn't handle: an ephemeral
+// error message, or no choices for an autocomplete.
+func BusyResponse(i *discordgo.Interaction) *discordgo.InteractionResponse {
+	if i.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		return shared.AutocompleteResponse(nil)
+	}
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: "This module is busy, try again later.",
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	}
+}
+
+// stop stops the deferral, or waits for it to be done.
+func (p *PendingInteraction) stop() {
+	if p.timer != nil && !p.timer.Stop() {
+		<-p.deferred
+	}
+}
+
+// fileClosers returns the files of a response that must be closed once it's sent, or not (e.g. the
+// uploads of a module). They are collected before sending, since the files can be replaced by then.
+func fileClosers(resp *discordgo.InteractionResponse) []io.Closer {
+	if resp == nil || resp.Data == nil {
+		return nil
+	}
+	var closers []io.Closer
+	for _, file := range resp.Data.Files {
+		if closer, ok := file.Reader.(io.Closer); ok {
+			closers = append(closers, closer)
+		}
+	}
+	return closers
+}
+
+// ack returns the acknowledgement state of an interaction, forgetting the expired interactions.
+func (r *InteractionResponder) ack(id string) *interactionAck {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for key, ack := range r.acks {
+		if now.Sub(ack.created) > interactionTokenLifetime {
+			delete(r.acks, key)
+		}
+	}
+
+	ack, ok := r.acks[id]
+	if !ok {
+		ack = &interactionAck{created: now}
+		r.acks[id] = ack
+	}
+	return ack
+}
+
+// deferResponse acknowledges the interaction, unless it already was.
+func (r *InteractionResponder) deferResponse(i *discordgo.Interaction, ack *interactionAck) error {
+	ack.mu.Lock()
+	defer ack.mu.Unlock()
+
+	if ack.acked {
+		return nil
+	}
+
+	// Components update their message, the other interactions get a "thinking..." message
+	typ := discordgo.InteractionResponseDeferredChannelMessageWithSource
+	switch i.Type {
+	case discordgo.InteractionMessageComponent:
+		typ = discordgo.InteractionResponseDeferredMessageUpdate
+	case discordgo.InteractionApplicationCommandAutocomplete:
+		typ = discordgo.InteractionApplicationCommandAutocompleteResult
+	}
+	if err := r.helper.InteractionRespond(i, &discordgo.InteractionResponse{Type: typ}); err != nil {
+		return err
+	}
+
+	ack.acked, ack.deferred = true, typ
+	return nil
+}
+
+// fail edits the "thinking..." message of an interaction deferred by the runtime into an error, so
+// that it doesn't stay up. The other deferrals have nothing to show.
+func (r *InteractionResponder) fail(i *discordgo.Interaction, ack *interactionAck) error {
+	ack.mu.Lock()
+	defer ack.mu.Unlock()
+
+	if ack.deferred != discordgo.InteractionResponseDeferredChannelMessageWithSource {
+		return nil
+	}
+	content := failedContent
+	_, err := r.helper.InteractionResponseEdit(i, &discordgo.WebhookEdit{Content: &content})
+	return err
+}
+
+// respond sends resp with helper, or edits the deferred response with it.
+func (r *InteractionResponder) respond(helper shared.Helper, i *discordgo.Interaction, ack *interactionAck, resp *discordgo.InteractionResponse) error {
+	ack.mu.Lock()
+	defer ack.mu.Unlock()
+
+	if ack.deferred == 0 {
+		if err := helper.InteractionRespond(i, resp); err != nil {
+			return err
+		}
+		ack.acked = true
+		return nil
+	}
+
+	switch {
+	case ack.deferred == discordgo.InteractionApplicationCommandAutocompleteResult:
+		return fmt.Errorf("autocomplete interaction %s was answered too late, its choices were dropped", i.ID)
+	case resp.Type == ack.deferred:
+		// Already done by the runtime
+		return nil
+	case resp.Type == discordgo.InteractionResponseChannelMessageWithSource && ack.deferred == discordgo.InteractionResponseDeferredChannelMessageWithSource &&
+		resp.Data != nil && resp.Data.Flags&discordgo.MessageFlagsEphemeral != 0:
+		// The deferred response is public, and an edit can't make it ephemeral
+		return followUp(helper, i, resp.Data)
+	case resp.Type == discordgo.InteractionResponseChannelMessageWithSource && ack.deferred == discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		resp.Type == discordgo.InteractionResponseUpdateMessage && ack.deferred == discordgo.InteractionResponseDeferredMessageUpdate:
+		// The deferred response is the message (or the message of the component) to edit
+		edit := &discordgo.WebhookEdit{}
+		if data := resp.Data; data != nil {
+			edit.Content = &data.Content
+			edit.Components = &data.Components
+			edit.Embeds = &data.Embeds
+			edit.Files = data.Files
+			edit.AllowedMentions = data.AllowedMentions
+		}
+		_, err := helper.InteractionResponseEdit(i, edit)
+		return err
+	default:
+		return fmt.Errorf("can't send a response of type %d to interaction %s, which was deferred by the runtime with type %d", resp.Type, i.ID, ack.deferred)
+	}
+}
+
+// followupHelper is implemented by the helpers that can send follow-up messages (e.g. DiscordHelper).
+type followupHelper interface {
+	InteractionResponseDelete(interaction *discordgo.Interaction) error
+	FollowupMessageCreate(interaction *discordgo.Interaction, wait bool, data *discordgo.WebhookParams) (*discordgo.Message, error)
+}
+
+// followUp replaces the deferred response of an interaction with an ephemeral follow-up message.
+func followUp(helper shared.Helper, i *discordgo.Interaction, data *discordgo.InteractionResponseData) error {
+	h, ok := helper.(followupHelper)
+	if !ok {
+		return fmt.Errorf("can't send an ephemeral response to interaction %s, which was deferred publicly by the runtime", i.ID)
+	}
+
+	// The first follow-up of a deferred interaction would edit the deferred response instead
+	if err := h.InteractionResponseDelete(i); err != nil {
+		return err
+	}
+	_, err := h.FollowupMessageCreate(i, true, &discordgo.WebhookParams{
+		Content:         data.Content,
+		TTS:             data.TTS,
+		Components:      data.Components,
+		Embeds:          data.Embeds,
+		Files:           data.Files,
+		AllowedMentions: data.AllowedMentions,
+		Flags:           data.Flags,
+	})
+	return err
+}
+
+// responderHelper routes InteractionRespond through the responder.
+type responderHelper struct {
+	shared.Helper
+	responder *InteractionResponder
+}
+
+func (h *responderHelper) WithContext(ctx context.Context) shared.Helper {
+	return &responderHelper{Helper: shared.HelperWithContext(h.Helper, ctx), responder: h.responder}
+}
+
+func (h *responderHelper) InteractionRespond(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse) error {
+	return h.responder.respond(h.Helper, interaction, h.responder.ack(interaction.ID), resp)
+}
@@ -0,0 +1,365 @@
+package runtime_test
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+	"github.com/thirdscam/chatanium-flexmodule/shared/flextest"
+)
+
+// followupHelper records the follow-up messages and the deleted responses, which flextest.Helper can't.
+type followupHelper struct {
+	*flextest.Helper
+
+	mu        sync.Mutex
+	followups []*discordgo.WebhookParams
+	deleted   int
+}
+
+func (h *followupHelper) InteractionResponseDelete(interaction *discordgo.Interaction) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.deleted++
+	return nil
+}
+
+func (h *followupHelper) FollowupMessageCreate(interaction *discordgo.Interaction, wait bool, data *discordgo.WebhookParams) (*discordgo.Message, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.followups = append(h.followups, data)
+	return &discordgo.Message{ID: "1", Flags: data.Flags}, nil
+}
+
+// responses returns the types of the responses sent with InteractionRespond.
+func responses(h *flextest.Helper) []discordgo.InteractionResponseType {
+	var types []discordgo.InteractionResponseType
+	for _, call := range h.CallsTo("InteractionRespond") {
+		types = append(types, call.Args[1].(*discordgo.InteractionResponse).Type)
+	}
+	return types
+}
+
+func TestInteractionResponder(t *testing.T) {
+	message := &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Content: "done"},
+	}
+	ephemeral := &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Content: "done", Flags: discordgo.MessageFlagsEphemeral},
+	}
+	update := &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{Content: "updated"},
+	}
+	choices := &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{Choices: []*discordgo.ApplicationCommandOptionChoice{{Name: "a", Value: "a"}}},
+	}
+
+	for _, test := range []struct {
+		name       string
+		typ        discordgo.InteractionType
+		resp       *discordgo.InteractionResponse
+		err        error
+		slow       bool // The hook returns once the interaction was deferred
+		noDeadline bool
+
+		responses []discordgo.InteractionResponseType
+		edits     int
+		followups int
+		wantErr   bool
+	}{
+		{
+			name:      "in time",
+			typ:       discordgo.InteractionApplicationCommand,
+			resp:      message,
+			responses: []discordgo.InteractionResponseType{discordgo.InteractionResponseChannelMessageWithSource},
+		},
+		{
+			name:    "error",
+			typ:     discordgo.InteractionApplicationCommand,
+			err:     errors.New("failed"),
+			wantErr: true,
+		},
+		{
+			name:      "no response",
+			typ:       discordgo.InteractionApplicationCommand,
+			slow:      true,
+			responses: []discordgo.InteractionResponseType{discordgo.InteractionResponseDeferredChannelMessageWithSource},
+		},
+		{
+			name:      "late message",
+			typ:       discordgo.InteractionApplicationCommand,
+			resp:      message,
+			slow:      true,
+			responses: []discordgo.InteractionResponseType{discordgo.InteractionResponseDeferredChannelMessageWithSource},
+			edits:     1,
+		},
+		{
+			name:      "late error",
+			typ:       discordgo.InteractionApplicationCommand,
+			err:       errors.New("failed"),
+			slow:      true,
+			responses: []discordgo.InteractionResponseType{discordgo.InteractionResponseDeferredChannelMessageWithSource},
+			edits:     1,
+			wantErr:   true,
+		},
+		{
+			name:      "late error to a component",
+			typ:       discordgo.InteractionMessageComponent,
+			err:       errors.New("failed"),
+			slow:      true,
+			responses: []discordgo.InteractionResponseType{discordgo.InteractionResponseDeferredMessageUpdate},
+			wantErr:   true,
+		},
+		{
+			name:      "late ephemeral message",
+			typ:       discordgo.InteractionApplicationCommand,
+			resp:      ephemeral,
+			slow:      true,
+			responses: []discordgo.InteractionResponseType{discordgo.InteractionResponseDeferredChannelMessageWithSource},
+			followups: 1,
+		},
+		{
+			name:      "late update",
+			typ:       discordgo.InteractionMessageComponent,
+			resp:      update,
+			slow:      true,
+			responses: []discordgo.InteractionResponseType{discordgo.InteractionResponseDeferredMessageUpdate},
+			edits:     1,
+		},
+		{
+			name:      "late message to a component",
+			typ:       discordgo.InteractionMessageComponent,
+			resp:      message,
+			slow:      true,
+			responses: []discordgo.InteractionResponseType{discordgo.InteractionResponseDeferredMessageUpdate},
+			wantErr:   true,
+		},
+		{
+			name:      "late choices",
+			typ:       discordgo.InteractionApplicationCommandAutocomplete,
+			resp:      choices,
+			slow:      true,
+			responses: []discordgo.InteractionResponseType{discordgo.InteractionApplicationCommandAutocompleteResult},
+			wantErr:   true,
+		},
+		{
+			name:       "no deadline",
+			typ:        discordgo.InteractionApplicationCommand,
+			resp:       message,
+			slow:       true,
+			noDeadline: true,
+			responses:  []discordgo.InteractionResponseType{discordgo.InteractionResponseChannelMessageWithSource},
+		},
+	} {
+		helper := &followupHelper{Helper: flextest.NewHelper()}
+		responder := runtime.NewInteractionResponder(helper)
+		i := &discordgo.Interaction{ID: test.name, Type: test.typ}
+
+		deadline := time.Now().Add(10 * time.Millisecond)
+		if test.noDeadline {
+			deadline = time.Time{}
+		}
+		err := responder.Handle(i, deadline, func() (*discordgo.InteractionResponse, error) {
+			switch {
+			case test.slow && test.noDeadline:
+				time.Sleep(50 * time.Millisecond)
+			case test.slow:
+				for start := time.Now(); len(responses(helper.Helper)) == 0; time.Sleep(time.Millisecond) {
+					if time.Since(start) > 5*time.Second {
+						t.Errorf("%s: not deferred", test.name)
+						break
+					}
+				}
+			}
+			return test.resp, test.err
+		})
+
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: got error %v", test.name, err)
+		}
+		if got := responses(helper.Helper); !slices.Equal(got, test.responses) {
+			t.Errorf("%s: got responses %v, want %v", test.name, got, test.responses)
+		}
+		if edits := helper.CallsTo("InteractionResponseEdit"); len(edits) != test.edits {
+			t.Errorf("%s: got %d edits, want %d", test.name, len(edits), test.edits)
+		}
+		if len(helper.followups) != test.followups || helper.deleted != test.followups {
+			t.Errorf("%s: got %d follow-ups and %d deleted responses, want %d", test.name, len(helper.followups), helper.deleted, test.followups)
+		}
+		for _, followup := range helper.followups {
+			if followup.Flags&discordgo.MessageFlagsEphemeral == 0 || followup.Content != "done" {
+				t.Errorf("%s: got follow-up %+v", test.name, followup)
+			}
+		}
+	}
+}
+
+// TestInteractionResponderQueued starts an interaction, which then waits longer than its deadline
+// before being handled (e.g. in the queue of its module): it's deferred in the meantime.
+func TestInteractionResponderQueued(t *testing.T) {
+	helper := flextest.NewHelper()
+	responder := runtime.NewInteractionResponder(helper)
+	i := &discordgo.Interaction{ID: "queued", Type: discordgo.InteractionApplicationCommand}
+
+	pending := responder.Start(i, time.Now().Add(time.Millisecond))
+	for start := time.Now(); len(responses(helper)) == 0; time.Sleep(time.Millisecond) {
+		if time.Since(start) > 5*time.Second {
+			t.Fatal("not deferred while queued")
+		}
+	}
+
+	err := pending.Handle(func() (*discordgo.InteractionResponse, error) {
+		return &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{Content: "done"},
+		}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := responses(helper); !slices.Equal(got, []discordgo.InteractionResponseType{discordgo.InteractionResponseDeferredChannelMessageWithSource}) {
+		t.Errorf("got responses %v", got)
+	}
+	if edits := helper.CallsTo("InteractionResponseEdit"); len(edits) != 1 || *edits[0].Args[1].(*discordgo.WebhookEdit).Content != "done" {
+		t.Errorf("got edits %+v", edits)
+	}
+}
+
+// TestInteractionResponderDrop drops interactions, before and after the runtime deferred them:
+// the user is told that the module is busy either way.
+func TestInteractionResponderDrop(t *testing.T) {
+	for _, deferred := range []bool{false, true} {
+		helper := &followupHelper{Helper: flextest.NewHelper()}
+		responder := runtime.NewInteractionResponder(helper)
+		i := &discordgo.Interaction{ID: strconv.FormatBool(deferred), Type: discordgo.InteractionApplicationCommand}
+
+		var deadline time.Time
+		if deferred {
+			deadline = time.Now().Add(time.Millisecond)
+		}
+		pending := responder.Start(i, deadline)
+		for start := time.Now(); deferred && len(responses(helper.Helper)) == 0; time.Sleep(time.Millisecond) {
+			if time.Since(start) > 5*time.Second {
+				t.Fatal("not deferred")
+			}
+		}
+		if err := pending.Drop(); err != nil {
+			t.Fatalf("deferred %v: %v", deferred, err)
+		}
+
+		// The ephemeral error is a follow-up if the deferred response is public
+		content := ""
+		if deferred {
+			if len(helper.followups) == 1 {
+				content = helper.followups[0].Content
+			}
+		} else if calls := helper.CallsTo("InteractionRespond"); len(calls) == 1 {
+			resp := calls[0].Args[1].(*discordgo.InteractionResponse)
+			if resp.Data.Flags&discordgo.MessageFlagsEphemeral != 0 {
+				content = resp.Data.Content
+			}
+		}
+		if content != runtime.BusyResponse(i).Data.Content {
+			t.Errorf("deferred %v: got %q", deferred, content)
+		}
+	}
+}
+
+// closeRecorder is a file that records whether it was closed.
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (r *closeRecorder) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestInteractionResponderFiles(t *testing.T) {
+	responder := runtime.NewInteractionResponder(flextest.NewHelper())
+	for _, wantErr := range []bool{false, true} {
+		file := &closeRecorder{Reader: strings.NewReader("content")}
+		err := responder.Handle(&discordgo.Interaction{ID: strconv.FormatBool(wantErr)}, time.Time{}, func() (*discordgo.InteractionResponse, error) {
+			resp := &discordgo.InteractionResponse{
+				Type: discordgo.InteractionResponseChannelMessageWithSource,
+				Data: &discordgo.InteractionResponseData{Files: []*discordgo.File{{Name: "a.txt", Reader: file}}},
+			}
+			if wantErr {
+				return resp, errors.New("failed")
+			}
+			return resp, nil
+		})
+		if (err != nil) != wantErr {
+			t.Errorf("got error %v", err)
+		}
+		// Whether the response was sent or not
+		if !file.closed {
+			t.Errorf("the file wasn't closed (error: %v)", err)
+		}
+	}
+}
+
+// TestInteractionResponderRace responds with the helper while the runtime defers the interaction:
+// the interaction is either answered, or deferred and then edited, but never answered twice.
+func TestInteractionResponderRace(t *testing.T) {
+	helper := flextest.NewHelper()
+	responder := runtime.NewInteractionResponder(helper)
+	resp := &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Content: "done"},
+	}
+
+	var wg sync.WaitGroup
+	for n := range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			i := &discordgo.Interaction{ID: strconv.Itoa(n), Type: discordgo.InteractionApplicationCommand}
+			err := responder.Handle(i, time.Now().Add(time.Millisecond), func() (*discordgo.InteractionResponse, error) {
+				time.Sleep(time.Duration(n%3) * time.Millisecond)
+				return nil, responder.Helper().InteractionRespond(i, resp)
+			})
+			if err != nil {
+				t.Errorf("%s: %v", i.ID, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	responded := make(map[string][]discordgo.InteractionResponseType)
+	for _, call := range helper.CallsTo("InteractionRespond") {
+		id := call.Args[0].(*discordgo.Interaction).ID
+		responded[id] = append(responded[id], call.Args[1].(*discordgo.InteractionResponse).Type)
+	}
+	edited := make(map[string]int)
+	for _, call := range helper.CallsTo("InteractionResponseEdit") {
+		edited[call.Args[0].(*discordgo.Interaction).ID]++
+	}
+
+	if len(responded) != 50 {
+		t.Errorf("got responses to %d interactions", len(responded))
+	}
+	for id, types := range responded {
+		switch {
+		case len(types) != 1:
+			t.Errorf("%s: answered %d times", id, len(types))
+		case types[0] == discordgo.InteractionResponseDeferredChannelMessageWithSource && edited[id] != 1,
+			types[0] == discordgo.InteractionResponseChannelMessageWithSource && edited[id] != 0:
+			t.Errorf("%s: got response %d and %d edits", id, types[0], edited[id])
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package runtime
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// TrackMessages makes discordgo's state keep up to size messages per channel, so that the previous
+// version of edited and deleted messages is passed to the modules (see discordgo.MessageUpdate.BeforeUpdate
+// and discordgo.MessageDelete.BeforeDelete). A size of 0 disables it.
+//
+// The state only tracks the messages of the channels it knows, so not the ones of DMs, and it
+// removes bulk-deleted messages before any handler runs: the returned history keeps them for
+// MESSAGE_DELETE_BULK.
+func TrackMessages(state *discordgo.State, size int) *MessageHistory {
+	state.Lock()
+	defer state.Unlock()
+
+	state.MaxMessageCount = size
+	return &MessageHistory{state: state, size: size, channels: make(map[string][]*discordgo.Message)}
+}
+
+// MessageHistory remembers the messages tracked by the state, so that the bulk-deleted ones can
+// still be passed to the modules once the state removed them. It holds the state's own messages,
+// which the state keeps up to date, so they aren't cached twice.
+type MessageHistory struct {
+	state *discordgo.State
+	size  int
+
+	mu       sync.Mutex
+	channels map[string][]*discordgo.Message // Oldest first
+}
+
+// Track remembers the message of the state with the ID of m, once the state handled its creation
+// (or its update, if it wasn't tracked yet).
+func (h *MessageHistory) Track(m *discordgo.Message) {
+	if h.size <= 0 || m == nil {
+		return
+	}
+	tracked, err := h.state.Message(m.ChannelID, m.ID)
+	if err != nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	messages := h.channels[m.ChannelID]
+	if slices.Contains(messages, tracked) {
+		return
+	}
+	messages = append(messages, tracked)
+	if len(messages) > h.size {
+		messages = messages[len(messages)-h.size:]
+	}
+	h.channels[m.ChannelID] = messages
+}
+
+// Forget forgets a deleted message.
+func (h *MessageHistory) Forget(channelID, messageID string) {
+	h.remove(channelID, []string{messageID})
+}
+
+// BulkDelete forgets bulk-deleted messages, and returns copies of the ones that were tracked.
+func (h *MessageHistory) BulkDelete(bulk *discordgo.MessageDeleteBulk) []*discordgo.Message {
+	deleted := h.remove(bulk.ChannelID, bulk.Messages)
+
+	// The state merged the updates into them while holding its lock
+	h.state.RLock()
+	defer h.state.RUnlock()
+
+	copies := make([]*discordgo.Message, len(deleted))
+	for i, m := range deleted {
+		message := *m
+		copies[i] = &message
+	}
+	return copies
+}
+
+// remove forgets the messages of a channel with the given IDs, and returns them.
+func (h *MessageHistory) remove(channelID string, ids []string) []*discordgo.Message {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var removed, kept []*discordgo.Message
+	for _, m := range h.channels[channelID] {
+		if slices.Contains(ids, m.ID) {
+			removed = append(removed, m)
+		} else {
+			kept = append(kept, m)
+		}
+	}
+	if len(kept) == 0 {
+		delete(h.channels, channelID)
+	} else {
+		h.channels[channelID] = kept
+	}
+	return removed
+}
+
+// UpdateMessage completes the state's merge of an edited message, once the state has handled m.
+//
+// The state keeps the fields that are empty in an update, as Discord leaves them out of partial
+// updates (e.g. the embeds of a link). A full update (with the author) without content clears it.
+func UpdateMessage(state *discordgo.State, m *discordgo.MessageUpdate) {
+	if m.Message == nil || m.Author == nil || m.Content != "" || m.BeforeUpdate == nil || m.BeforeUpdate.Content == "" {
+		return
+	}
+
+	cached, err := state.Message(m.ChannelID, m.ID)
+	if err != nil {
+		return
+	}
+
+	state.Lock()
+	defer state.Unlock()
+
+	cached.Content = ""
+}
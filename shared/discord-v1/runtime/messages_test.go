@@ -0,0 +1,120 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+)
+
+// messageState returns a state tracking size messages per channel, as the session would handle events with.
+func messageState(size int) (*discordgo.State, *discordgo.Session) {
+	state := discordgo.NewState()
+	state.GuildAdd(&discordgo.Guild{ID: "A", Channels: []*discordgo.Channel{{ID: "a1", GuildID: "A"}}})
+	runtime.TrackMessages(state, size)
+	return state, &discordgo.Session{State: state, StateEnabled: true}
+}
+
+func TestTrackMessages(t *testing.T) {
+	state, session := messageState(2)
+	for _, id := range []string{"1", "2", "3"} {
+		state.OnInterface(session, &discordgo.MessageCreate{Message: &discordgo.Message{ID: id, ChannelID: "a1", Content: "message " + id, Author: &discordgo.User{ID: "u"}}})
+	}
+
+	// The oldest message was dropped
+	deleted := &discordgo.MessageDelete{Message: &discordgo.Message{ID: "1", ChannelID: "a1"}}
+	state.OnInterface(session, deleted)
+	if deleted.BeforeDelete != nil {
+		t.Errorf("dropped message: got %#v", deleted.BeforeDelete)
+	}
+	deleted = &discordgo.MessageDelete{Message: &discordgo.Message{ID: "3", ChannelID: "a1"}}
+	state.OnInterface(session, deleted)
+	if deleted.BeforeDelete == nil || deleted.BeforeDelete.Content != "message 3" {
+		t.Errorf("deleted message: got %#v", deleted.BeforeDelete)
+	}
+}
+
+func TestUpdateMessage(t *testing.T) {
+	state, session := messageState(10)
+	author := &discordgo.User{ID: "u"}
+	state.OnInterface(session, &discordgo.MessageCreate{Message: &discordgo.Message{ID: "1", ChannelID: "a1", Content: "https://example.com", Author: author}})
+
+	update := func(m *discordgo.Message) *discordgo.Message {
+		t.Helper()
+		u := &discordgo.MessageUpdate{Message: m}
+		state.OnInterface(session, u)
+		runtime.UpdateMessage(state, u)
+		return u.BeforeUpdate
+	}
+	cached := func() *discordgo.Message {
+		t.Helper()
+		m, err := state.Message("a1", "1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+
+	// A partial update keeps the content
+	before := update(&discordgo.Message{ID: "1", ChannelID: "a1", Embeds: []*discordgo.MessageEmbed{{URL: "https://example.com"}}})
+	if before == nil || before.Content != "https://example.com" || len(before.Embeds) != 0 {
+		t.Errorf("partial update: got before %#v", before)
+	}
+	if m := cached(); m.Content != "https://example.com" || len(m.Embeds) != 1 {
+		t.Errorf("partial update: got %#v", m)
+	}
+
+	// A full update without content clears it
+	before = update(&discordgo.Message{ID: "1", ChannelID: "a1", Author: author, Embeds: []*discordgo.MessageEmbed{}})
+	if before == nil || before.Content != "https://example.com" {
+		t.Errorf("full update: got before %#v", before)
+	}
+	if m := cached(); m.Content != "" {
+		t.Errorf("full update: content is %q", m.Content)
+	}
+
+	before = update(&discordgo.Message{ID: "1", ChannelID: "a1", Author: author, Content: "edited"})
+	if before == nil || before.Content != "" {
+		t.Errorf("edit: got before %#v", before)
+	}
+	if m := cached(); m.Content != "edited" {
+		t.Errorf("edit: content is %q", m.Content)
+	}
+}
+
+func TestMessageHistory(t *testing.T) {
+	state, session := messageState(2)
+	history := runtime.TrackMessages(state, 2)
+	author := &discordgo.User{ID: "u"}
+	for _, id := range []string{"1", "2", "3"} {
+		m := &discordgo.Message{ID: id, ChannelID: "a1", Content: "message " + id, Author: author}
+		state.OnInterface(session, &discordgo.MessageCreate{Message: m})
+		history.Track(m)
+	}
+
+	// Updates merged by the state are seen by the history
+	edit := &discordgo.MessageUpdate{Message: &discordgo.Message{ID: "3", ChannelID: "a1", Content: "edited", Author: author}}
+	state.OnInterface(session, edit)
+	history.Track(edit.Message)
+
+	// Message 1 was dropped like in the state, and the state removes the messages before the handlers run
+	bulk := &discordgo.MessageDeleteBulk{ChannelID: "a1", Messages: []string{"1", "2", "3"}}
+	state.OnInterface(session, bulk)
+	before := history.BulkDelete(bulk)
+	if len(before) != 2 || before[0].Content != "message 2" || before[1].Content != "edited" {
+		t.Fatalf("got %#v", before)
+	}
+
+	// They are forgotten once deleted
+	if before := history.BulkDelete(bulk); len(before) != 0 {
+		t.Errorf("deleted twice: got %#v", before)
+	}
+
+	// Messages of channels unknown to the state (e.g. DMs) aren't tracked
+	dm := &discordgo.Message{ID: "4", ChannelID: "dm", Author: author}
+	state.OnInterface(session, &discordgo.MessageCreate{Message: dm})
+	history.Track(dm)
+	if before := history.BulkDelete(&discordgo.MessageDeleteBulk{ChannelID: "dm", Messages: []string{"4"}}); len(before) != 0 {
+		t.Errorf("DM: got %#v", before)
+	}
+}
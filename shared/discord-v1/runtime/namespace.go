@@ -0,0 +1,316 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/bwmarrin/discordgo"
+	shared "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+)
+
+// Namespace is reserved to a module in the custom IDs of components and modals, so that their
+// interactions are routed to the module that sent them.
+//
+// The runtime prefixes the custom IDs sent by a module with "<namespace>:" (see NamespacedHelper)
+// and removes the prefix from the interactions and the messages it passes to the module, so
+// modules never see it. The prefix counts in the 100 characters of a custom ID.
+type Namespace string
+
+// NamespaceSeparator separates the namespace from the custom ID set by the module.
+const NamespaceSeparator = ":"
+
+// MaxCustomIDLength is the maximum length of a custom ID, with the namespace, in UTF-16 code units
+// (as Discord counts characters).
+const MaxCustomIDLength = 100
+
+// CustomID returns the custom ID with the namespace. Empty custom IDs (e.g. of link buttons) stay empty.
+func (n Namespace) CustomID(id string) string {
+	if id == "" {
+		return ""
+	}
+	return string(n) + NamespaceSeparator + id
+}
+
+// SplitCustomID returns the namespace of a custom ID, and the custom ID set by the module.
+func SplitCustomID(id string) (Namespace, string, bool) {
+	namespace, rest, ok := strings.Cut(id, NamespaceSeparator)
+	return Namespace(namespace), rest, ok
+}
+
+// strip returns the custom ID set by the module, if id has the namespace.
+func (n Namespace) strip(id string) string {
+	if namespace, rest, ok := SplitCustomID(id); ok && namespace == n {
+		return rest
+	}
+	return id
+}
+
+// utf16Len returns the length of s in UTF-16 code units.
+func utf16Len(s string) int {
+	return len(utf16.Encode([]rune(s)))
+}
+
+// namespacer returns a function that namespaces custom IDs, and one that returns the error of the
+// first custom ID that was too long.
+func (n Namespace) namespacer() (func(string) string, func() error) {
+	var err error
+	return func(id string) string {
+			namespaced := n.CustomID(id)
+			if err == nil && utf16Len(namespaced) > MaxCustomIDLength {
+				err = fmt.Errorf("custom ID %q is longer than %d characters with the namespace %q", id, MaxCustomIDLength-utf16Len(string(n)+NamespaceSeparator), n)
+			}
+			return namespaced
+		}, func() error {
+			return err
+		}
+}
+
+// Components returns a copy of components with namespaced custom IDs, or an error if a custom ID
+// is too long with the namespace.
+func (n Namespace) Components(components []discordgo.MessageComponent) ([]discordgo.MessageComponent, error) {
+	customID, err := n.namespacer()
+	components = mapCustomIDs(components, customID)
+	return components, err()
+}
+
+// Response returns a copy of an interaction response with namespaced custom IDs, or an error if a
+// custom ID is too long with the namespace.
+func (n Namespace) Response(resp *discordgo.InteractionResponse) (*discordgo.InteractionResponse, error) {
+	if resp == nil || resp.Data == nil {
+		return resp, nil
+	}
+
+	customID, err := n.namespacer()
+	data := *resp.Data
+	data.CustomID = customID(data.CustomID)
+	data.Components = mapCustomIDs(data.Components, customID)
+	if err := err(); err != nil {
+		return nil, err
+	}
+	return &discordgo.InteractionResponse{Type: resp.Type, Data: &data}, nil
+}
+
+// Interaction returns a copy of an interaction of a component or a modal of the module, with the
+// custom IDs set by the module. Other interactions are returned as-is.
+func (n Namespace) Interaction(i *discordgo.Interaction) *discordgo.Interaction {
+	routed := *i
+	switch data := i.Data.(type) {
+	case discordgo.MessageComponentInteractionData:
+		data.CustomID = n.strip(data.CustomID)
+		routed.Data = data
+	case discordgo.ModalSubmitInteractionData:
+		data.CustomID = n.strip(data.CustomID)
+		data.Components = mapCustomIDs(data.Components, n.strip)
+		routed.Data = data
+	default:
+		return i
+	}
+
+	// The message of a component has the components with their namespace as well
+	routed.Message = n.Message(i.Message)
+	return &routed
+}
+
+// Message returns a copy of a message with the custom IDs set by the module in its components, or
+// the message itself if it has no components. The components of other modules keep their namespace.
+func (n Namespace) Message(m *discordgo.Message) *discordgo.Message {
+	if m == nil || len(m.Components) == 0 {
+		return m
+	}
+
+	message := *m
+	message.Components = mapCustomIDs(m.Components, n.strip)
+	return &message
+}
+
+// Messages returns messages with the custom IDs set by the module (see Message).
+func (n Namespace) Messages(messages []*discordgo.Message) []*discordgo.Message {
+	if messages == nil {
+		return nil
+	}
+
+	stripped := make([]*discordgo.Message, len(messages))
+	for i, m := range messages {
+		stripped[i] = n.Message(m)
+	}
+	return stripped
+}
+
+// mapCustomIDs returns a copy of components with their custom IDs (and the ones of their children) mapped by f.
+func mapCustomIDs(components []discordgo.MessageComponent, f func(string) string) []discordgo.MessageComponent {
+	if components == nil {
+		return nil
+	}
+
+	mapped := make([]discordgo.MessageComponent, len(components))
+	for i, component := range components {
+		switch c := component.(type) {
+		case *discordgo.ActionsRow:
+			row := *c
+			row.Components = mapCustomIDs(c.Components, f)
+			component = &row
+		case discordgo.ActionsRow:
+			c.Components = mapCustomIDs(c.Components, f)
+			component = c
+		case *discordgo.Button:
+			button := *c
+			button.CustomID = f(c.CustomID)
+			component = &button
+		case discordgo.Button:
+			c.CustomID = f(c.CustomID)
+			component = c
+		case *discordgo.SelectMenu:
+			menu := *c
+			menu.CustomID = f(c.CustomID)
+			component = &menu
+		case discordgo.SelectMenu:
+			c.CustomID = f(c.CustomID)
+			component = c
+		case *discordgo.TextInput:
+			input := *c
+			input.CustomID = f(c.CustomID)
+			component = &input
+		case discordgo.TextInput:
+			c.CustomID = f(c.CustomID)
+			component = c
+		}
+		mapped[i] = component
+	}
+	return mapped
+}
+
+// NamespacedHelper wraps a Helper and prefixes the custom IDs of the components and modals sent
+// by a module with its Namespace, removing it from the messages it returns.
+type NamespacedHelper struct {
+	shared.Helper
+	namespace Namespace
+}
+
+// NewNamespacedHelper creates a NamespacedHelper for the given namespace.
+func NewNamespacedHelper(helper shared.Helper, namespace Namespace) shared.Helper {
+	return &NamespacedHelper{
+		Helper:    helper,
+		namespace: namespace,
+	}
+}
+
+// CheckAttachment checks the attachment with the wrapped helper, if it's an AttachmentChecker.
+func (h *NamespacedHelper) CheckAttachment(attachment *discordgo.MessageAttachment) error {
+	if checker, ok := h.Helper.(AttachmentChecker); ok {
+		return checker.CheckAttachment(attachment)
+	}
+	return nil
+}
+
+// WithContext binds the wrapped helper to ctx, keeping the namespace.
+func (h *NamespacedHelper) WithContext(ctx context.Context) shared.Helper {
+	return &NamespacedHelper{
+		Helper:    shared.HelperWithContext(h.Helper, ctx),
+		namespace: h.namespace,
+	}
+}
+
+// message returns the result of a call with the custom IDs set by the module.
+func (h *NamespacedHelper) message(m *discordgo.Message, err error) (*discordgo.Message, error) {
+	return h.namespace.Message(m), err
+}
+
+func (h *NamespacedHelper) ChannelMessageSend(channelID string, content string) (*discordgo.Message, error) {
+	return h.message(h.Helper.ChannelMessageSend(channelID, content))
+}
+
+func (h *NamespacedHelper) ChannelMessageSendComplex(channelID string, data *discordgo.MessageSend) (*discordgo.Message, error) {
+	if data != nil {
+		d := *data
+		var err error
+		if d.Components, err = h.namespace.Components(d.Components); err != nil {
+			return nil, err
+		}
+		data = &d
+	}
+	return h.message(h.Helper.ChannelMessageSendComplex(channelID, data))
+}
+
+func (h *NamespacedHelper) ChannelMessageSendEmbed(channelID string, embed *discordgo.MessageEmbed) (*discordgo.Message, error) {
+	return h.message(h.Helper.ChannelMessageSendEmbed(channelID, embed))
+}
+
+func (h *NamespacedHelper) ChannelMessageSendEmbeds(channelID string, embeds []*discordgo.MessageEmbed) (*discordgo.Message, error) {
+	return h.message(h.Helper.ChannelMessageSendEmbeds(channelID, embeds))
+}
+
+func (h *NamespacedHelper) ChannelMessageEdit(channelID, messageID, content string) (*discordgo.Message, error) {
+	return h.message(h.Helper.ChannelMessageEdit(channelID, messageID, content))
+}
+
+func (h *NamespacedHelper) ChannelMessageEditComplex(m *discordgo.MessageEdit) (*discordgo.Message, error) {
+	if m != nil && m.Components != nil {
+		edit := *m
+		components, err := h.namespace.Components(*m.Components)
+		if err != nil {
+			return nil, err
+		}
+		edit.Components = &components
+		m = &edit
+	}
+	return h.message(h.Helper.ChannelMessageEditComplex(m))
+}
+
+func (h *NamespacedHelper) ChannelMessages(channelID string, limit int, beforeID, afterID, aroundID string) ([]*discordgo.Message, error) {
+	messages, err := h.Helper.ChannelMessages(channelID, limit, beforeID, afterID, aroundID)
+	return h.namespace.Messages(messages), err
+}
+
+func (h *NamespacedHelper) ChannelMessage(channelID, messageID string) (*discordgo.Message, error) {
+	return h.message(h.Helper.ChannelMessage(channelID, messageID))
+}
+
+func (h *NamespacedHelper) InteractionRespond(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse) error {
+	resp, err := h.namespace.Response(resp)
+	if err != nil {
+		return err
+	}
+	return h.Helper.InteractionRespond(interaction, resp)
+}
+
+func (h *NamespacedHelper) InteractionResponseEdit(interaction *discordgo.Interaction, newresp *discordgo.WebhookEdit) (*discordgo.Message, error) {
+	if newresp != nil && newresp.Components != nil {
+		edit := *newresp
+		components, err := h.namespace.Components(*newresp.Components)
+		if err != nil {
+			return nil, err
+		}
+		edit.Components = &components
+		newresp = &edit
+	}
+	return h.message(h.Helper.InteractionResponseEdit(interaction, newresp))
+}
+
+func (h *NamespacedHelper) WebhookExecute(webhookID, token string, wait bool, data *discordgo.WebhookParams) (*discordgo.Message, error) {
+	if data != nil {
+		d := *data
+		var err error
+		if d.Components, err = h.namespace.Components(d.Components); err != nil {
+			return nil, err
+		}
+		data = &d
+	}
+	return h.message(h.Helper.WebhookExecute(webhookID, token, wait, data))
+}
+
+// UnroutedResponse is the response to an interaction that no module handles (e.g. a component of a
+// module that was removed): an ephemeral error message, or no choices for an autocomplete.
+func UnroutedResponse(i *discordgo.Interaction) *discordgo.InteractionResponse {
+	if i.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		return shared.AutocompleteResponse(nil)
+	}
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: "This interaction is not available anymore.",
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	}
+}
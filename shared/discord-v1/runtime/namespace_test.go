@@ -0,0 +1,143 @@
+package runtime_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+	"github.com/thirdscam/chatanium-flexmodule/shared/flextest"
+)
+
+// button returns the custom ID of the first button in components.
+func button(components []discordgo.MessageComponent) string {
+	return components[0].(discordgo.ActionsRow).Components[0].(discordgo.Button).CustomID
+}
+
+// buttons returns an action row with a button per custom ID.
+func buttons(ids ...string) []discordgo.MessageComponent {
+	row := discordgo.ActionsRow{}
+	for _, id := range ids {
+		row.Components = append(row.Components, discordgo.Button{Label: id, CustomID: id})
+	}
+	return []discordgo.MessageComponent{row}
+}
+
+func TestNamespace(t *testing.T) {
+	namespace := runtime.Namespace("ns")
+	for id, want := range map[string]string{"vote": "ns:vote", "": "", "other:vote": "ns:other:vote"} {
+		if got := namespace.CustomID(id); got != want {
+			t.Errorf("%q: got %q, want %q", id, got, want)
+		}
+	}
+
+	components, err := namespace.Components(buttons("vote"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := button(components); got != "ns:vote" {
+		t.Errorf("got %q", got)
+	}
+
+	// The own namespace is removed from messages, the one of other modules is kept
+	message := &discordgo.Message{ID: "M", Components: buttons("ns:vote", "other:vote")}
+	stripped := namespace.Message(message)
+	row := stripped.Components[0].(discordgo.ActionsRow)
+	if a, b := row.Components[0].(discordgo.Button).CustomID, row.Components[1].(discordgo.Button).CustomID; a != "vote" || b != "other:vote" {
+		t.Errorf("got %q and %q", a, b)
+	}
+	if button(message.Components) != "ns:vote" {
+		t.Error("changed the original message")
+	}
+	if namespace.Message(nil) != nil {
+		t.Error("got a message from nil")
+	}
+
+	// Interactions of components have their custom ID, and the one of their message, stripped
+	i := namespace.Interaction(&discordgo.Interaction{
+		Type:    discordgo.InteractionMessageComponent,
+		Data:    discordgo.MessageComponentInteractionData{CustomID: "ns:vote"},
+		Message: message,
+	})
+	if id := i.MessageComponentData().CustomID; id != "vote" {
+		t.Errorf("got custom ID %q", id)
+	}
+	if id := button(i.Message.Components); id != "vote" {
+		t.Errorf("got custom ID %q in the message", id)
+	}
+}
+
+func TestNamespaceLength(t *testing.T) {
+	namespace := runtime.Namespace("ns")
+
+	// The prefix takes 3 characters, and Discord counts in UTF-16 code units
+	for id, long := range map[string]bool{
+		strings.Repeat("a", 97):       false,
+		strings.Repeat("a", 98):       true,
+		strings.Repeat("😀", 48) + "a": false,
+		strings.Repeat("😀", 49):       true,
+	} {
+		_, err := namespace.Components(buttons(id))
+		if (err != nil) != long {
+			t.Errorf("%d characters: got error %v", len([]rune(id)), err)
+		}
+	}
+
+	_, err := namespace.Response(&discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{CustomID: strings.Repeat("a", 98), Title: "Modal"},
+	})
+	if err == nil {
+		t.Error("no error for a long modal custom ID")
+	}
+
+	// The budget of non-ASCII namespaces is counted in UTF-16 code units too
+	if _, err := runtime.Namespace("日本").Components(buttons(strings.Repeat("a", 97))); err != nil {
+		t.Errorf("non-ASCII namespace: %v", err)
+	}
+	_, err = runtime.Namespace("日本").Components(buttons(strings.Repeat("a", 98)))
+	if err == nil || !strings.Contains(err.Error(), "longer than 97 characters") {
+		t.Errorf("non-ASCII namespace: got error %v", err)
+	}
+
+	helper := flextest.NewHelper()
+	namespaced := runtime.NewNamespacedHelper(helper, namespace)
+	if _, err := namespaced.ChannelMessageSendComplex("C", &discordgo.MessageSend{Components: buttons(strings.Repeat("a", 98))}); err == nil {
+		t.Error("sent a message with a long custom ID")
+	}
+	if calls := helper.CallsTo("ChannelMessageSendComplex"); len(calls) != 0 {
+		t.Errorf("got %d calls", len(calls))
+	}
+}
+
+func TestNamespacedHelper(t *testing.T) {
+	helper := flextest.NewHelper()
+	namespaced := runtime.NewNamespacedHelper(helper, "ns")
+
+	m, err := namespaced.ChannelMessageSendComplex("C", &discordgo.MessageSend{Components: buttons("vote")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sent := helper.CallsTo("ChannelMessageSendComplex")[0].Args[1].(*discordgo.MessageSend)
+	if id := button(sent.Components); id != "ns:vote" {
+		t.Errorf("sent custom ID %q", id)
+	}
+
+	// Sending the components of a returned message again doesn't namespace them twice
+	helper.Respond("ChannelMessage", &discordgo.Message{ID: m.ID, ChannelID: "C", Components: buttons("ns:vote")}, nil)
+	m, err = namespaced.ChannelMessage("C", m.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id := button(m.Components); id != "vote" {
+		t.Errorf("got custom ID %q", id)
+	}
+	components := m.Components
+	if _, err := namespaced.ChannelMessageEditComplex(&discordgo.MessageEdit{ID: m.ID, Channel: "C", Components: &components}); err != nil {
+		t.Fatal(err)
+	}
+	edit := helper.CallsTo("ChannelMessageEditComplex")[0].Args[0].(*discordgo.MessageEdit)
+	if id := button(*edit.Components); id != "ns:vote" {
+		t.Errorf("edited custom ID %q", id)
+	}
+}
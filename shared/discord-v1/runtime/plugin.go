@@ -2,6 +2,10 @@ package runtime
 
 import (
 	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
 
 	plugin "github.com/hashicorp/go-plugin"
 	proto "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
@@ -21,48 +25,79 @@ type Plugin struct {
 	Helper      shared.Helper      // The implementation of the Helper interface (runtime side)
 	Hook        shared.Hook        // Hook client to call module's hook functions
 	VoiceHelper *VoiceHelper       // Voice streaming helper
+	Scope       GuildScope         // Guilds where the module can join voice
+
+	// VoiceStream replaces VoiceHelper as the VoiceStream service provided to the module
+	// (e.g. a fake service in module tests).
+	VoiceStream proto.VoiceStreamServer
+
+	// Default deadlines of the hooks called by the runtime, and of the Helper calls made by the module
+	HookDeadlines   shared.Deadlines
+	HelperDeadlines shared.Deadlines
+
+	// Size cap of the files sent by the module in a call, in bytes (DefaultMaxUploadSize if zero)
+	MaxUploadSize int64
+
+	// Downloads the attachments requested by the module (the default limits and no cache if nil)
+	Downloads *Downloader
+
+	// Reattached is set when the runtime reattached to a module process that outlived
+	// a previous runtime. The module's broker only serves its first host, so in this case
+	// the Helper server is served on a runtime-owned unix socket instead.
+	Reattached bool
+
+	uploadsOnce sync.Once
+	uploads     *UploadStore
 }
 
 func (p *Plugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
 	// Register Helper server (runtime provides helper services to modules)
 	proto.RegisterHelperServer(s, &HelperServerImpl{
-		Impl:   p.Helper,
-		broker: broker,
+		Impl:      p.Helper,
+		broker:    broker,
+		deadlines: p.HelperDeadlines,
+		uploads:   p.uploadStore(),
+		downloads: p.Downloads,
 	})
 
 	// Register VoiceStream server if available
-	if p.VoiceHelper != nil {
-		proto.RegisterVoiceStreamServer(s, p.VoiceHelper)
+	if vs := p.voiceStream(); vs != nil {
+		proto.RegisterVoiceStreamServer(s, vs)
 	}
 
 	return nil
 }
 
 func (p *Plugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
-	// Start a broker server for Helper service so module can call runtime's helpers
-	var helperServerID uint32 = 1 // Use a fixed ID for the helper server
-	go broker.AcceptAndServe(helperServerID, func(opts []grpc.ServerOption) *grpc.Server {
-		s := grpc.NewServer(opts...)
-		
-		// Register Helper server
-		proto.RegisterHelperServer(s, &HelperServerImpl{
-			Impl:   p.Helper,
-			broker: broker,
-		})
-		
-		// Register VoiceStream server if available
-		if p.VoiceHelper != nil {
-			proto.RegisterVoiceStreamServer(s, p.VoiceHelper)
-		}
-		
-		return s
-	})
-
 	// Create Hook client to call module's hook functions
 	hookClient := &HookClient{
-		client:         proto.NewHookClient(c),
-		broker:         broker,
-		helperServerID: helperServerID, // Pass server ID to hook client
+		client:    proto.NewHookClient(c),
+		broker:    broker,
+		deadlines: p.HookDeadlines,
+		uploads:   p.uploadStore(),
+	}
+
+	if p.Reattached {
+		// Serve Helper on our own listener and let the module dial it directly
+		lis, dir, err := listenHelper()
+		if err != nil {
+			return nil, err
+		}
+		go func() {
+			p.newHelperServer(broker, nil).Serve(lis)
+			os.RemoveAll(dir)
+		}()
+
+		hookClient.helperNetwork = lis.Addr().Network()
+		hookClient.helperAddress = lis.Addr().String()
+	} else {
+		// Start a broker server for Helper service so module can call runtime's helpers
+		var helperServerID uint32 = 1 // Use a fixed ID for the helper server
+		go broker.AcceptAndServe(helperServerID, func(opts []grpc.ServerOption) *grpc.Server {
+			return p.newHelperServer(broker, opts)
+		})
+
+		hookClient.helperServerID = helperServerID // Pass server ID to hook client
 	}
 
 	// Create Helper client (for completeness, though runtime usually provides helpers)
@@ -82,6 +117,71 @@ func (p *Plugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *g
 	}, nil
 }
 
+// listenHelper listens on a unix socket that only the current user can connect to, since the
+// Helper server drives the bot's session and has no authentication of its own. The socket is in
+// a private directory, which should be removed once the listener is closed.
+func listenHelper() (net.Listener, string, error) {
+	dir, err := os.MkdirTemp("", "flexmodule-helper-*")
+	if err != nil {
+		return nil, "", err
+	}
+
+	path := filepath.Join(dir, "helper.sock")
+	lis, err := net.Listen("unix", path)
+	if err == nil {
+		err = os.Chmod(path, 0o600)
+	}
+	if err != nil {
+		if lis != nil {
+			lis.Close()
+		}
+		os.RemoveAll(dir)
+		return nil, "", err
+	}
+	return lis, dir, nil
+}
+
+// newHelperServer creates the gRPC server that provides Helper (and VoiceStream) services to the module.
+func (p *Plugin) newHelperServer(broker *plugin.GRPCBroker, opts []grpc.ServerOption) *grpc.Server {
+	s := grpc.NewServer(opts...)
+
+	// Register Helper server
+	proto.RegisterHelperServer(s, &HelperServerImpl{
+		Impl:      p.Helper,
+		broker:    broker,
+		deadlines: p.HelperDeadlines,
+		uploads:   p.uploadStore(),
+		downloads: p.Downloads,
+	})
+
+	// Register VoiceStream server if available
+	if vs := p.voiceStream(); vs != nil {
+		proto.RegisterVoiceStreamServer(s, vs)
+	}
+
+	return s
+}
+
+// uploadStore returns the store of the files uploaded by the module, shared by the Helper servers
+// and the Hook client.
+func (p *Plugin) uploadStore() *UploadStore {
+	p.uploadsOnce.Do(func() {
+		p.uploads = NewUploadStore(p.MaxUploadSize)
+	})
+	return p.uploads
+}
+
+// voiceStream returns the VoiceStream service provided to the module, or nil if there is none.
+func (p *Plugin) voiceStream() proto.VoiceStreamServer {
+	if p.VoiceStream != nil {
+		return p.VoiceStream
+	}
+	if p.VoiceHelper != nil {
+		return NewScopedVoiceStream(p.VoiceHelper, p.Scope)
+	}
+	return nil
+}
+
 // RuntimeClients wraps both Hook and Helper clients for runtime
 type RuntimeClients struct {
 	Hook        shared.Hook
@@ -0,0 +1,79 @@
+package runtime_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	plugin "github.com/hashicorp/go-plugin"
+	discord "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/module"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+	"github.com/thirdscam/chatanium-flexmodule/shared/flextest"
+)
+
+// initHook records the helpers it's initialized with.
+type initHook struct {
+	discord.AbstractHooks
+
+	mu      sync.Mutex
+	helpers []discord.Helper
+}
+
+func (h *initHook) OnInit(helper discord.Helper) discord.InitResponse {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.helpers = append(h.helpers, helper)
+	return discord.InitResponse{}
+}
+
+func (h *initHook) OnCreateInteraction(i *discordgo.Interaction) (*discordgo.InteractionResponse, error) {
+	return &discordgo.InteractionResponse{Type: discordgo.InteractionResponsePong}, nil
+}
+
+// TestReattach initializes the module twice, as runtimes reattaching to it after a restart: the
+// connection to the previous runtime's helper is closed, while the hooks keep being called.
+func TestReattach(t *testing.T) {
+	hook := &initHook{}
+	helper := flextest.NewHelper()
+	client, server := plugin.TestPluginGRPCConn(t, false, map[string]plugin.Plugin{
+		"discord-v1": &pluginPair{
+			module:  &module.Plugin{Impl: hook},
+			runtime: &runtime.Plugin{Helper: helper, Reattached: true},
+		},
+	})
+	defer client.Close()
+	defer server.Stop()
+
+	var hooks []discord.Hook
+	for range 2 {
+		raw, err := client.Dispense("discord-v1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		hooks = append(hooks, raw.(discord.RuntimeClients).GetHook())
+	}
+
+	hooks[0].OnInit(helper)
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hooks[0].OnCreateInteraction(&discordgo.Interaction{Type: discordgo.InteractionPing})
+		}()
+	}
+	hooks[1].OnInit(helper)
+	wg.Wait()
+
+	if len(hook.helpers) != 2 {
+		t.Fatalf("got %d inits", len(hook.helpers))
+	}
+	if _, err := hook.helpers[0].ChannelMessageSend("a1", "previous"); err == nil {
+		t.Error("the previous helper still works")
+	}
+	if _, err := hook.helpers[1].ChannelMessageSend("a1", "current"); err != nil {
+		t.Errorf("current helper: %v", err)
+	}
+}
@@ -0,0 +1,478 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/bwmarrin/discordgo"
+	proto "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
+	shared "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrOutOfScope is returned by ScopedHelper when a module targets a guild (or a channel of a guild)
+// where it isn't enabled.
+var ErrOutOfScope = errors.New("target is outside of the module's guild scope")
+
+// GuildScope describes the guilds where a module is enabled.
+//
+// If Allow is empty, the module is enabled in every guild except the ones in Deny.
+// Otherwise it is only enabled in the guilds in Allow (still minus the ones in Deny).
+// DMs don't belong to a guild, so they're never filtered by the scope.
+type GuildScope struct {
+	Allow []string `json:"allow,omitempty"`
+	Deny  []string `json:"deny,omitempty"`
+}
+
+// Enabled reports whether the module is enabled in the given guild.
+// An empty guildID (DMs) is always enabled.
+func (s GuildScope) Enabled(guildID string) bool {
+	if guildID == "" {
+		return true
+	}
+	if slices.Contains(s.Deny, guildID) {
+		return false
+	}
+	return len(s.Allow) == 0 || slices.Contains(s.Allow, guildID)
+}
+
+// Restricted reports whether the scope excludes any guild at all.
+func (s GuildScope) Restricted() bool {
+	return len(s.Allow) != 0 || len(s.Deny) != 0
+}
+
+// EnabledGuilds returns the guilds where the module is enabled.
+//
+// joined is the list of guilds the bot is in, which is used when Allow is empty.
+func (s GuildScope) EnabledGuilds(joined []string) []string {
+	candidates := s.Allow
+	if len(candidates) == 0 {
+		candidates = joined
+	}
+
+	guilds := make([]string, 0, len(candidates))
+	for _, id := range candidates {
+		if s.Enabled(id) {
+			guilds = append(guilds, id)
+		}
+	}
+	return guilds
+}
+
+// Check returns ErrOutOfScope if the module isn't enabled in the guild targeted by a call.
+// Global (guild-less) targets are only allowed for unrestricted modules.
+func (s GuildScope) Check(guildID string) error {
+	if guildID == "" && s.Restricted() {
+		return fmt.Errorf("%w: global target", ErrOutOfScope)
+	}
+	if !s.Enabled(guildID) {
+		return fmt.Errorf("%w: guild %s", ErrOutOfScope, guildID)
+	}
+	return nil
+}
+
+// ScopedHelper wraps a Helper and rejects calls that target guilds (or channels of guilds)
+// outside the module's GuildScope.
+//
+// Calls that don't target a guild or a channel (users, gateway, ...) are passed through as-is.
+type ScopedHelper struct {
+	shared.Helper
+	state *discordgo.State
+	scope GuildScope
+}
+
+// NewScopedHelper creates a ScopedHelper for the given scope.
+//
+// state is used to find the guild of a channel without a request. If it is nil
+// (or the channel isn't cached), the guild is looked up through the helper itself.
+func NewScopedHelper(helper shared.Helper, state *discordgo.State, scope GuildScope) shared.Helper {
+	return &ScopedHelper{
+		Helper: helper,
+		state:  state,
+		scope:  scope,
+	}
+}
+
+// WithContext binds the wrapped helper to ctx, keeping the scope.
+func (h *ScopedHelper) WithContext(ctx context.Context) shared.Helper {
+	return &ScopedHelper{
+		Helper: shared.HelperWithContext(h.Helper, ctx),
+		state:  h.state,
+		scope:  h.scope,
+	}
+}
+
+// checkGuild returns ErrOutOfScope if the module isn't enabled in the guild.
+func (h *ScopedHelper) checkGuild(guildID string) error {
+	return h.scope.Check(guildID)
+}
+
+// checkChannel returns ErrOutOfScope if the channel belongs to a guild where the module isn't enabled.
+func (h *ScopedHelper) checkChannel(channelID string) error {
+	if !h.scope.Restricted() {
+		return nil
+	}
+
+	var ch *discordgo.Channel
+	if h.state != nil {
+		ch, _ = h.state.Channel(channelID)
+	}
+	if ch == nil {
+		var err error
+		ch, err = h.Helper.Channel(channelID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// DM channels don't belong to a guild
+	if !h.scope.Enabled(ch.GuildID) {
+		return fmt.Errorf("%w: channel %s", ErrOutOfScope, channelID)
+	}
+	return nil
+}
+
+// CheckAttachment returns ErrOutOfScope if the attachment was sent in a channel of a guild where the
+// module isn't enabled, as found in its URL.
+func (h *ScopedHelper) CheckAttachment(attachment *discordgo.MessageAttachment) error {
+	channelID := attachmentChannel(attachment.URL)
+	if channelID == "" {
+		return nil
+	}
+	return h.checkChannel(channelID)
+}
+
+// ================================================
+// Message operations
+// ================================================
+
+func (h *ScopedHelper) ChannelMessageSend(channelID string, content string) (*discordgo.Message, error) {
+	if err := h.checkChannel(channelID); err != nil {
+		return nil, err
+	}
+	return h.Helper.ChannelMessageSend(channelID, content)
+}
+
+func (h *ScopedHelper) ChannelMessageSendComplex(channelID string, data *discordgo.MessageSend) (*discordgo.Message, error) {
+	if err := h.checkChannel(channelID); err != nil {
+		return nil, err
+	}
+	return h.Helper.ChannelMessageSendComplex(channelID, data)
+}
+
+func (h *ScopedHelper) ChannelMessageSendEmbed(channelID string, embed *discordgo.MessageEmbed) (*discordgo.Message, error) {
+	if err := h.checkChannel(channelID); err != nil {
+		return nil, err
+	}
+	return h.Helper.ChannelMessageSendEmbed(channelID, embed)
+}
+
+func (h *ScopedHelper) ChannelMessageSendEmbeds(channelID string, embeds []*discordgo.MessageEmbed) (*discordgo.Message, error) {
+	if err := h.checkChannel(channelID); err != nil {
+		return nil, err
+	}
+	return h.Helper.ChannelMessageSendEmbeds(channelID, embeds)
+}
+
+func (h *ScopedHelper) ChannelMessageEdit(channelID, messageID, content string) (*discordgo.Message, error) {
+	if err := h.checkChannel(channelID); err != nil {
+		return nil, err
+	}
+	return h.Helper.ChannelMessageEdit(channelID, messageID, content)
+}
+
+func (h *ScopedHelper) ChannelMessageEditComplex(m *discordgo.MessageEdit) (*discordgo.Message, error) {
+	if err := h.checkChannel(m.Channel); err != nil {
+		return nil, err
+	}
+	return h.Helper.ChannelMessageEditComplex(m)
+}
+
+func (h *ScopedHelper) ChannelMessageDelete(channelID, messageID string) error {
+	if err := h.checkChannel(channelID); err != nil {
+		return err
+	}
+	return h.Helper.ChannelMessageDelete(channelID, messageID)
+}
+
+func (h *ScopedHelper) ChannelMessages(channelID string, limit int, beforeID, afterID, aroundID string) ([]*discordgo.Message, error) {
+	if err := h.checkChannel(channelID); err != nil {
+		return nil, err
+	}
+	return h.Helper.ChannelMessages(channelID, limit, beforeID, afterID, aroundID)
+}
+
+func (h *ScopedHelper) ChannelMessage(channelID, messageID string) (*discordgo.Message, error) {
+	if err := h.checkChannel(channelID); err != nil {
+		return nil, err
+	}
+	return h.Helper.ChannelMessage(channelID, messageID)
+}
+
+// ================================================
+// Channel operations
+// ================================================
+
+func (h *ScopedHelper) Channel(channelID string) (*discordgo.Channel, error) {
+	if err := h.checkChannel(channelID); err != nil {
+		return nil, err
+	}
+	return h.Helper.Channel(channelID)
+}
+
+func (h *ScopedHelper) ChannelEdit(channelID string, data *discordgo.ChannelEdit) (*discordgo.Channel, error) {
+	if err := h.checkChannel(channelID); err != nil {
+		return nil, err
+	}
+	return h.Helper.ChannelEdit(channelID, data)
+}
+
+func (h *ScopedHelper) ChannelDelete(channelID string) (*discordgo.Channel, error) {
+	if err := h.checkChannel(channelID); err != nil {
+		return nil, err
+	}
+	return h.Helper.ChannelDelete(channelID)
+}
+
+func (h *ScopedHelper) ChannelTyping(channelID string) error {
+	if err := h.checkChannel(channelID); err != nil {
+		return err
+	}
+	return h.Helper.ChannelTyping(channelID)
+}
+
+// ================================================
+// Guild operations
+// ================================================
+
+func (h *ScopedHelper) Guild(guildID string) (*discordgo.Guild, error) {
+	if err := h.checkGuild(guildID); err != nil {
+		return nil, err
+	}
+	return h.Helper.Guild(guildID)
+}
+
+func (h *ScopedHelper) GuildChannels(guildID string) ([]*discordgo.Channel, error) {
+	if err := h.checkGuild(guildID); err != nil {
+		return nil, err
+	}
+	return h.Helper.GuildChannels(guildID)
+}
+
+func (h *ScopedHelper) GuildMembers(guildID string, after string, limit int) ([]*discordgo.Member, error) {
+	if err := h.checkGuild(guildID); err != nil {
+		return nil, err
+	}
+	return h.Helper.GuildMembers(guildID, after, limit)
+}
+
+func (h *ScopedHelper) GuildMember(guildID, userID string) (*discordgo.Member, error) {
+	if err := h.checkGuild(guildID); err != nil {
+		return nil, err
+	}
+	return h.Helper.GuildMember(guildID, userID)
+}
+
+func (h *ScopedHelper) GuildRoles(guildID string) ([]*discordgo.Role, error) {
+	if err := h.checkGuild(guildID); err != nil {
+		return nil, err
+	}
+	return h.Helper.GuildRoles(guildID)
+}
+
+// ================================================
+// Interaction operations
+// ================================================
+
+func (h *ScopedHelper) InteractionRespond(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse) error {
+	if !h.scope.Enabled(interaction.GuildID) {
+		return fmt.Errorf("%w: guild %s", ErrOutOfScope, interaction.GuildID)
+	}
+	return h.Helper.InteractionRespond(interaction, resp)
+}
+
+func (h *ScopedHelper) InteractionResponseEdit(interaction *discordgo.Interaction, newresp *discordgo.WebhookEdit) (*discordgo.Message, error) {
+	if !h.scope.Enabled(interaction.GuildID) {
+		return nil, fmt.Errorf("%w: guild %s", ErrOutOfScope, interaction.GuildID)
+	}
+	return h.Helper.InteractionResponseEdit(interaction, newresp)
+}
+
+// ================================================
+// Application Command operations
+// ================================================
+
+func (h *ScopedHelper) ApplicationCommandCreate(appID string, guildID string, cmd *discordgo.ApplicationCommand) (*discordgo.ApplicationCommand, error) {
+	if err := h.checkGuild(guildID); err != nil {
+		return nil, err
+	}
+	return h.Helper.ApplicationCommandCreate(appID, guildID, cmd)
+}
+
+func (h *ScopedHelper) ApplicationCommandEdit(appID, guildID, cmdID string, cmd *discordgo.ApplicationCommand) (*discordgo.ApplicationCommand, error) {
+	if err := h.checkGuild(guildID); err != nil {
+		return nil, err
+	}
+	return h.Helper.ApplicationCommandEdit(appID, guildID, cmdID, cmd)
+}
+
+func (h *ScopedHelper) ApplicationCommandDelete(appID, guildID, cmdID string) error {
+	if err := h.checkGuild(guildID); err != nil {
+		return err
+	}
+	return h.Helper.ApplicationCommandDelete(appID, guildID, cmdID)
+}
+
+func (h *ScopedHelper) ApplicationCommands(appID, guildID string) ([]*discordgo.ApplicationCommand, error) {
+	if err := h.checkGuild(guildID); err != nil {
+		return nil, err
+	}
+	return h.Helper.ApplicationCommands(appID, guildID)
+}
+
+// ================================================
+// Reaction operations
+// ================================================
+
+func (h *ScopedHelper) MessageReactionAdd(channelID, messageID, emojiID string) error {
+	if err := h.checkChannel(channelID); err != nil {
+		return err
+	}
+	return h.Helper.MessageReactionAdd(channelID, messageID, emojiID)
+}
+
+func (h *ScopedHelper) MessageReactionRemove(channelID, messageID, emojiID, userID string) error {
+	if err := h.checkChannel(channelID); err != nil {
+		return err
+	}
+	return h.Helper.MessageReactionRemove(channelID, messageID, emojiID, userID)
+}
+
+func (h *ScopedHelper) MessageReactionsRemoveAll(channelID, messageID string) error {
+	if err := h.checkChannel(channelID); err != nil {
+		return err
+	}
+	return h.Helper.MessageReactionsRemoveAll(channelID, messageID)
+}
+
+// ================================================
+// Thread operations
+// ================================================
+
+func (h *ScopedHelper) ThreadStart(channelID, name string, typ discordgo.ChannelType, archiveDuration int) (*discordgo.Channel, error) {
+	if err := h.checkChannel(channelID); err != nil {
+		return nil, err
+	}
+	return h.Helper.ThreadStart(channelID, name, typ, archiveDuration)
+}
+
+func (h *ScopedHelper) ThreadJoin(threadID string) error {
+	if err := h.checkChannel(threadID); err != nil {
+		return err
+	}
+	return h.Helper.ThreadJoin(threadID)
+}
+
+func (h *ScopedHelper) ThreadLeave(threadID string) error {
+	if err := h.checkChannel(threadID); err != nil {
+		return err
+	}
+	return h.Helper.ThreadLeave(threadID)
+}
+
+func (h *ScopedHelper) ThreadMemberAdd(threadID, memberID string) error {
+	if err := h.checkChannel(threadID); err != nil {
+		return err
+	}
+	return h.Helper.ThreadMemberAdd(threadID, memberID)
+}
+
+func (h *ScopedHelper) ThreadMemberRemove(threadID, memberID string) error {
+	if err := h.checkChannel(threadID); err != nil {
+		return err
+	}
+	return h.Helper.ThreadMemberRemove(threadID, memberID)
+}
+
+// ================================================
+// Voice operations
+// ================================================
+
+func (h *ScopedHelper) GuildVoiceStates(guildID string) ([]*discordgo.VoiceState, error) {
+	if err := h.checkGuild(guildID); err != nil {
+		return nil, err
+	}
+	return h.Helper.GuildVoiceStates(guildID)
+}
+
+// ================================================
+// Webhook operations
+// ================================================
+
+func (h *ScopedHelper) WebhookCreate(channelID, name, avatar string) (*discordgo.Webhook, error) {
+	if err := h.checkChannel(channelID); err != nil {
+		return nil, err
+	}
+	return h.Helper.WebhookCreate(channelID, name, avatar)
+}
+
+// ================================================
+// Permission operations
+// ================================================
+
+func (h *ScopedHelper) UserChannelPermissions(userID, channelID string) (int64, error) {
+	if err := h.checkChannel(channelID); err != nil {
+		return 0, err
+	}
+	return h.Helper.UserChannelPermissions(userID, channelID)
+}
+
+// ================================================
+// File operations
+// ================================================
+
+func (h *ScopedHelper) AttachmentDownload(attachment *discordgo.MessageAttachment) (*shared.Download, error) {
+	if err := h.CheckAttachment(attachment); err != nil {
+		return nil, err
+	}
+	return h.Helper.AttachmentDownload(attachment)
+}
+
+var (
+	_ shared.Helper        = &ScopedHelper{}
+	_ shared.ContextHelper = &ScopedHelper{}
+	_ AttachmentChecker    = &ScopedHelper{}
+)
+
+// ScopedVoiceStream wraps the VoiceStream service and rejects joining voice (or reading the queue)
+// in guilds outside the module's GuildScope. The other calls use the connection of a join, so
+// they're passed through as-is.
+type ScopedVoiceStream struct {
+	proto.VoiceStreamServer
+	scope GuildScope
+}
+
+// NewScopedVoiceStream creates a ScopedVoiceStream for the given scope.
+func NewScopedVoiceStream(voiceStream proto.VoiceStreamServer, scope GuildScope) proto.VoiceStreamServer {
+	return &ScopedVoiceStream{
+		VoiceStreamServer: voiceStream,
+		scope:             scope,
+	}
+}
+
+func (s *ScopedVoiceStream) VoiceJoin(ctx context.Context, req *proto.VoiceJoinRequest) (*proto.VoiceJoinResponse, error) {
+	if err := s.scope.Check(req.GuildId); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	return s.VoiceStreamServer.VoiceJoin(ctx, req)
+}
+
+func (s *ScopedVoiceStream) GetQueueStatus(ctx context.Context, req *proto.QueueStatusRequest) (*proto.QueueStatusResponse, error) {
+	if err := s.scope.Check(req.GuildId); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	return s.VoiceStreamServer.GetQueueStatus(ctx, req)
+}
@@ -0,0 +1,132 @@
+package runtime_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	proto "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+	"github.com/thirdscam/chatanium-flexmodule/shared/flextest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGuildScope(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		scope      runtime.GuildScope
+		enabled    []string
+		disabled   []string
+		restricted bool
+	}{
+		{name: "unrestricted", enabled: []string{"A", "B", ""}},
+		{name: "allow", scope: runtime.GuildScope{Allow: []string{"A"}}, enabled: []string{"A", ""}, disabled: []string{"B"}, restricted: true},
+		{name: "deny", scope: runtime.GuildScope{Deny: []string{"B"}}, enabled: []string{"A", ""}, disabled: []string{"B"}, restricted: true},
+		{name: "allow and deny", scope: runtime.GuildScope{Allow: []string{"A", "B"}, Deny: []string{"B"}}, enabled: []string{"A"}, disabled: []string{"B", "C"}, restricted: true},
+	} {
+		for _, id := range test.enabled {
+			if !test.scope.Enabled(id) {
+				t.Errorf("%s: guild %q is not enabled", test.name, id)
+			}
+		}
+		for _, id := range test.disabled {
+			if test.scope.Enabled(id) {
+				t.Errorf("%s: guild %q is enabled", test.name, id)
+			}
+			if !errors.Is(test.scope.Check(id), runtime.ErrOutOfScope) {
+				t.Errorf("%s: guild %q is not out of scope", test.name, id)
+			}
+		}
+		if test.scope.Restricted() != test.restricted {
+			t.Errorf("%s: restricted is %v", test.name, !test.restricted)
+		}
+		// Global targets would reach the guilds outside the scope
+		if err := test.scope.Check(""); (err != nil) != test.restricted {
+			t.Errorf("%s: global target: got %v", test.name, err)
+		}
+	}
+
+	scope := runtime.GuildScope{Deny: []string{"B"}}
+	if got := scope.EnabledGuilds([]string{"A", "B", "C"}); !slices.Equal(got, []string{"A", "C"}) {
+		t.Errorf("enabled guilds: got %v", got)
+	}
+}
+
+func TestScopedHelper(t *testing.T) {
+	state := discordgo.NewState()
+	state.GuildAdd(&discordgo.Guild{ID: "A", Channels: []*discordgo.Channel{{ID: "a1", GuildID: "A"}}})
+	state.GuildAdd(&discordgo.Guild{ID: "B", Channels: []*discordgo.Channel{{ID: "b1", GuildID: "B"}}})
+
+	fake := flextest.NewHelper()
+	helper := runtime.NewScopedHelper(fake, state, runtime.GuildScope{Allow: []string{"A"}})
+
+	if _, err := helper.ChannelMessageSend("a1", "hello"); err != nil {
+		t.Errorf("channel in scope: %v", err)
+	}
+	if _, err := helper.ChannelMessageSend("b1", "hello"); !errors.Is(err, runtime.ErrOutOfScope) {
+		t.Errorf("channel out of scope: got %v", err)
+	}
+	if _, err := helper.GuildMember("B", "1"); !errors.Is(err, runtime.ErrOutOfScope) {
+		t.Errorf("guild out of scope: got %v", err)
+	}
+	if _, err := helper.GuildVoiceStates("B"); !errors.Is(err, runtime.ErrOutOfScope) {
+		t.Errorf("voice states out of scope: got %v", err)
+	}
+	if _, err := helper.GuildVoiceStates("A"); err != nil {
+		t.Errorf("voice states in scope: %v", err)
+	}
+	if _, err := helper.ApplicationCommandCreate("app", "", &discordgo.ApplicationCommand{Name: "global"}); !errors.Is(err, runtime.ErrOutOfScope) {
+		t.Errorf("global command: got %v", err)
+	}
+
+	// Channels missing from the state are looked up through the helper
+	fake.Respond("Channel", &discordgo.Channel{ID: "c1", GuildID: "B"}, nil)
+	if _, err := helper.ChannelMessageSend("c1", "hello"); !errors.Is(err, runtime.ErrOutOfScope) {
+		t.Errorf("channel looked up: got %v", err)
+	}
+
+	// Only the call in scope went through
+	if calls := fake.CallsTo("ChannelMessageSend"); len(calls) != 1 || calls[0].Args[0] != "a1" {
+		t.Errorf("sent: got %v", calls)
+	}
+	if calls := fake.CallsTo("GuildMember"); len(calls) != 0 {
+		t.Errorf("guild member: got %v", calls)
+	}
+	if calls := fake.CallsTo("GuildVoiceStates"); len(calls) != 1 || calls[0].Args[0] != "A" {
+		t.Errorf("voice states: got %v", calls)
+	}
+}
+
+type fakeVoiceStream struct {
+	proto.UnimplementedVoiceStreamServer
+	joined []string
+}
+
+func (f *fakeVoiceStream) VoiceJoin(ctx context.Context, req *proto.VoiceJoinRequest) (*proto.VoiceJoinResponse, error) {
+	f.joined = append(f.joined, req.GuildId)
+	return &proto.VoiceJoinResponse{GuildId: req.GuildId}, nil
+}
+
+func TestScopedVoiceStream(t *testing.T) {
+	fake := &fakeVoiceStream{}
+	voice := runtime.NewScopedVoiceStream(fake, runtime.GuildScope{Allow: []string{"A"}})
+
+	if _, err := voice.VoiceJoin(context.Background(), &proto.VoiceJoinRequest{GuildId: "A", ChannelId: "a1"}); err != nil {
+		t.Errorf("guild in scope: %v", err)
+	}
+	_, err := voice.VoiceJoin(context.Background(), &proto.VoiceJoinRequest{GuildId: "B", ChannelId: "b1"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("guild out of scope: got %v", err)
+	}
+	_, err = voice.GetQueueStatus(context.Background(), &proto.QueueStatusRequest{GuildId: "B"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("queue out of scope: got %v", err)
+	}
+
+	if !slices.Equal(fake.joined, []string{"A"}) {
+		t.Errorf("joined: got %v", fake.joined)
+	}
+}
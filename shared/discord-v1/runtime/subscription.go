@@ -0,0 +1,115 @@
+package runtime
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	core "github.com/thirdscam/chatanium-flexmodule/shared/core-v1"
+)
+
+// EventInfo is what subscriptions are matched against.
+//
+// It is read from the raw discordgo event, so that the runtime can drop an event
+// before converting it for a module that doesn't want it.
+type EventInfo struct {
+	Type      string // Gateway event name (e.g. "MESSAGE_CREATE")
+	GuildID   string
+	ChannelID string
+
+	Content     string // Content of the message, for message events
+	CommandName string // Name of the command, for application command and autocomplete interactions
+	CustomID    string // custom_id of the component or modal, for component and modal interactions
+}
+
+// MessageEventInfo describes a message event. m may be nil (e.g. an uncached deleted message).
+func MessageEventInfo(eventType string, m *discordgo.Message) EventInfo {
+	if m == nil {
+		return EventInfo{Type: eventType}
+	}
+	return EventInfo{
+		Type:      eventType,
+		GuildID:   m.GuildID,
+		ChannelID: m.ChannelID,
+		Content:   m.Content,
+	}
+}
+
+// InteractionEventInfo describes an INTERACTION_CREATE event.
+func InteractionEventInfo(i *discordgo.Interaction) EventInfo {
+	info := EventInfo{
+		Type:      "INTERACTION_CREATE",
+		GuildID:   i.GuildID,
+		ChannelID: i.ChannelID,
+	}
+
+	// Not using the *Data getters, which panic when Data is missing
+	switch data := i.Data.(type) {
+	case discordgo.ApplicationCommandInteractionData:
+		info.CommandName = data.Name
+	case discordgo.MessageComponentInteractionData:
+		info.CustomID = data.CustomID
+	case discordgo.ModalSubmitInteractionData:
+		info.CustomID = data.CustomID
+	}
+
+	return info
+}
+
+// RawEventInfo describes any gateway event from its JSON payload, reading only the guild and channel.
+func RawEventInfo(e *discordgo.Event) EventInfo {
+	var ids struct {
+		ID        string `json:"id"`
+		GuildID   string `json:"guild_id"`
+		ChannelID string `json:"channel_id"`
+	}
+	json.Unmarshal(e.RawData, &ids)
+
+	// The channel is the payload itself for channel and thread events
+	if ids.ChannelID == "" && (strings.HasPrefix(e.Type, "CHANNEL_") || strings.HasPrefix(e.Type, "THREAD_")) {
+		ids.ChannelID = ids.ID
+	}
+
+	return EventInfo{
+		Type:      e.Type,
+		GuildID:   ids.GuildID,
+		ChannelID: ids.ChannelID,
+	}
+}
+
+// Subscriptions are the event subscriptions declared in a module's manifest.
+type Subscriptions []core.Subscription
+
+// Match reports whether the event matches any of the subscriptions.
+// A module without subscriptions receives every event.
+func (s Subscriptions) Match(e EventInfo) bool {
+	if len(s) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(s, func(sub core.Subscription) bool {
+		return matchSubscription(sub, e)
+	})
+}
+
+func matchSubscription(sub core.Subscription, e EventInfo) bool {
+	if sub.Event != "" && sub.Event != "*" && sub.Event != e.Type {
+		return false
+	}
+	if len(sub.Guilds) > 0 && !slices.Contains(sub.Guilds, e.GuildID) {
+		return false
+	}
+	if len(sub.Channels) > 0 && !slices.Contains(sub.Channels, e.ChannelID) {
+		return false
+	}
+	if sub.MessagePrefix != "" && !strings.HasPrefix(e.Content, sub.MessagePrefix) {
+		return false
+	}
+	if len(sub.Commands) > 0 && !slices.Contains(sub.Commands, e.CommandName) {
+		return false
+	}
+	if sub.CustomIDPrefix != "" && !strings.HasPrefix(e.CustomID, sub.CustomIDPrefix) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,108 @@
+package runtime_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+)
+
+func TestSubscriptionsMatch(t *testing.T) {
+	message := runtime.EventInfo{Type: "MESSAGE_CREATE", GuildID: "A", ChannelID: "a1", Content: "!play song"}
+	command := runtime.EventInfo{Type: "INTERACTION_CREATE", GuildID: "A", ChannelID: "a1", CommandName: "play"}
+	button := runtime.EventInfo{Type: "INTERACTION_CREATE", GuildID: "B", ChannelID: "b1", CustomID: "vote:yes"}
+
+	for _, test := range []struct {
+		name  string
+		subs  runtime.Subscriptions
+		event runtime.EventInfo
+		want  bool
+	}{
+		{name: "no subscriptions", event: message, want: true},
+		{name: "any event", subs: runtime.Subscriptions{{Event: "*"}}, event: message, want: true},
+		{name: "empty event", subs: runtime.Subscriptions{{Guilds: []string{"A"}}}, event: message, want: true},
+		{name: "empty event in another guild", subs: runtime.Subscriptions{{Guilds: []string{"B"}}}, event: message, want: false},
+		{name: "event", subs: runtime.Subscriptions{{Event: "MESSAGE_CREATE"}}, event: message, want: true},
+		{name: "other event", subs: runtime.Subscriptions{{Event: "MESSAGE_UPDATE"}}, event: message, want: false},
+		{name: "channel", subs: runtime.Subscriptions{{Event: "*", Channels: []string{"a1"}}}, event: message, want: true},
+		{name: "other channel", subs: runtime.Subscriptions{{Event: "*", Channels: []string{"a2"}}}, event: message, want: false},
+		{name: "prefix", subs: runtime.Subscriptions{{Event: "MESSAGE_CREATE", MessagePrefix: "!"}}, event: message, want: true},
+		{name: "other prefix", subs: runtime.Subscriptions{{Event: "MESSAGE_CREATE", MessagePrefix: "?"}}, event: message, want: false},
+		{name: "prefix without content", subs: runtime.Subscriptions{{Event: "*", MessagePrefix: "!"}}, event: command, want: false},
+		{name: "command", subs: runtime.Subscriptions{{Event: "INTERACTION_CREATE", Commands: []string{"play", "stop"}}}, event: command, want: true},
+		{name: "other command", subs: runtime.Subscriptions{{Event: "INTERACTION_CREATE", Commands: []string{"stop"}}}, event: command, want: false},
+		{name: "custom ID prefix", subs: runtime.Subscriptions{{CustomIDPrefix: "vote:"}}, event: button, want: true},
+		{name: "other custom ID prefix", subs: runtime.Subscriptions{{CustomIDPrefix: "poll:"}}, event: button, want: false},
+		{
+			name:  "any subscription",
+			subs:  runtime.Subscriptions{{Event: "MESSAGE_CREATE"}, {Event: "INTERACTION_CREATE", Guilds: []string{"B"}}},
+			event: button,
+			want:  true,
+		},
+		{
+			name:  "all filters",
+			subs:  runtime.Subscriptions{{Event: "MESSAGE_CREATE", Guilds: []string{"A"}, Channels: []string{"a2"}, MessagePrefix: "!"}},
+			event: message,
+			want:  false,
+		},
+	} {
+		if got := test.subs.Match(test.event); got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestEventInfo(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		got  runtime.EventInfo
+		want runtime.EventInfo
+	}{
+		{
+			name: "message",
+			got:  runtime.MessageEventInfo("MESSAGE_CREATE", &discordgo.Message{GuildID: "A", ChannelID: "a1", Content: "hi"}),
+			want: runtime.EventInfo{Type: "MESSAGE_CREATE", GuildID: "A", ChannelID: "a1", Content: "hi"},
+		},
+		{
+			name: "uncached message",
+			got:  runtime.MessageEventInfo("MESSAGE_DELETE", nil),
+			want: runtime.EventInfo{Type: "MESSAGE_DELETE"},
+		},
+		{
+			name: "command",
+			got: runtime.InteractionEventInfo(&discordgo.Interaction{
+				GuildID: "A",
+				Data:    discordgo.ApplicationCommandInteractionData{Name: "play"},
+			}),
+			want: runtime.EventInfo{Type: "INTERACTION_CREATE", GuildID: "A", CommandName: "play"},
+		},
+		{
+			name: "modal",
+			got: runtime.InteractionEventInfo(&discordgo.Interaction{
+				ChannelID: "a1",
+				Data:      discordgo.ModalSubmitInteractionData{CustomID: "feedback"},
+			}),
+			want: runtime.EventInfo{Type: "INTERACTION_CREATE", ChannelID: "a1", CustomID: "feedback"},
+		},
+		{
+			name: "interaction without data",
+			got:  runtime.InteractionEventInfo(&discordgo.Interaction{GuildID: "A"}),
+			want: runtime.EventInfo{Type: "INTERACTION_CREATE", GuildID: "A"},
+		},
+		{
+			name: "raw",
+			got:  runtime.RawEventInfo(&discordgo.Event{Type: "TYPING_START", RawData: json.RawMessage(`{"guild_id":"A","channel_id":"a1"}`)}),
+			want: runtime.EventInfo{Type: "TYPING_START", GuildID: "A", ChannelID: "a1"},
+		},
+		{
+			name: "raw channel",
+			got:  runtime.RawEventInfo(&discordgo.Event{Type: "THREAD_CREATE", RawData: json.RawMessage(`{"id":"t1","guild_id":"A"}`)}),
+			want: runtime.EventInfo{Type: "THREAD_CREATE", GuildID: "A", ChannelID: "t1"},
+		},
+	} {
+		if test.got != test.want {
+			t.Errorf("%s: got %+v, want %+v", test.name, test.got, test.want)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package runtime
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	proto "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
+)
+
+// DefaultMaxUploadSize is the default size cap of the files sent by a module in a call (Discord's limit
+// for bots in servers without boosts).
+const DefaultMaxUploadSize = 25 << 20
+
+// uploadLifetime is how long an uploaded file waits for the call that references it.
+const uploadLifetime = 10 * time.Minute
+
+// UploadStore buffers the files uploaded by a module with Helper.UploadFile to disk, until the
+// call that references them sends them. Each upload can be sent once.
+type UploadStore struct {
+	maxSize int64
+
+	mu      sync.Mutex
+	uploads map[string]*upload // By upload ID
+}
+
+type upload struct {
+	path    string
+	created time.Time
+	file    *os.File // Set once the upload is being sent
+}
+
+// NewUploadStore creates a store of files of up to maxSize bytes in total per call (DefaultMaxUploadSize
+// if zero).
+func NewUploadStore(maxSize int64) *UploadStore {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxUploadSize
+	}
+	return &UploadStore{
+		maxSize: maxSize,
+		uploads: make(map[string]*upload),
+	}
+}
+
+// Store writes the content of a file to disk, and returns its upload ID and size.
+func (s *UploadStore) Store(r io.Reader) (string, int64, error) {
+	s.expire()
+
+	file, err := os.CreateTemp("", "flexmodule-upload-*")
+	if err != nil {
+		return "", 0, err
+	}
+	defer file.Close()
+
+	// Read one more byte than allowed to detect files that are too large
+	size, err := io.Copy(file, io.LimitReader(r, s.maxSize+1))
+	if err == nil && size > s.maxSize {
+		err = fmt.Errorf("file is larger than the limit of %d bytes", s.maxSize)
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", 0, err
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		os.Remove(file.Name())
+		return "", 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.uploads[hex.EncodeToString(id)] = &upload{path: file.Name(), created: time.Now()}
+	return hex.EncodeToString(id), size, nil
+}
+
+// Files converts the files of a call, opening their uploads. Call done once the call returned,
+// to delete the uploads (the ones that were read to the end, or closed, are already deleted).
+func (s *UploadStore) Files(buf []*proto.File) (files []*discordgo.File, done func(), err error) {
+	s.expire()
+
+	var opened []string
+	var total int64
+	done = func() {
+		for _, id := range opened {
+			s.remove(id)
+		}
+	}
+
+	for _, f := range buf {
+		file := &discordgo.File{Name: f.Name, ContentType: f.ContentType}
+		switch {
+		case f.UploadId != "":
+			upload, err := s.open(f.UploadId)
+			if err != nil {
+				done()
+				return nil, nil, fmt.Errorf("file %s: %w", f.Name, err)
+			}
+			opened = append(opened, f.UploadId)
+			file.Reader = &uploadReader{store: s, id: f.UploadId, file: upload}
+
+			info, err := upload.Stat()
+			if err != nil {
+				done()
+				return nil, nil, fmt.Errorf("file %s: %w", f.Name, err)
+			}
+			total += info.Size()
+		default:
+			file.Reader = bytes.NewReader(f.Data)
+			total += int64(len(f.Data))
+		}
+		if total > s.maxSize {
+			done()
+			return nil, nil, fmt.Errorf("files are larger than the limit of %d bytes", s.maxSize)
+		}
+		files = append(files, file)
+	}
+
+	return files, done, nil
+}
+
+func (s *UploadStore) open(id string) (*os.File, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	upload, ok := s.uploads[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown (or expired) upload %s", id)
+	}
+	if upload.file != nil {
+		return nil, fmt.Errorf("upload %s was already sent", id)
+	}
+
+	file, err := os.Open(upload.path)
+	if err != nil {
+		return nil, err
+	}
+	upload.file = file
+	return file, nil
+}
+
+// remove deletes an upload from the disk.
+func (s *UploadStore) remove(id string) {
+	s.mu.Lock()
+	upload, ok := s.uploads[id]
+	delete(s.uploads, id)
+	s.mu.Unlock()
+
+	if ok {
+		if upload.file != nil {
+			upload.file.Close()
+		}
+		os.Remove(upload.path)
+	}
+}
+
+// expire deletes the uploads that were never sent.
+func (s *UploadStore) expire() {
+	s.mu.Lock()
+	var expired []string
+	for id, upload := range s.uploads {
+		if time.Since(upload.created) > uploadLifetime {
+			expired = append(expired, id)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, id := range expired {
+		s.remove(id)
+	}
+}
+
+// uploadReader reads an upload, and deletes it once read to the end or closed.
+type uploadReader struct {
+	store *UploadStore
+	id    string
+	file  *os.File
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	n, err := r.file.Read(p)
+	if err == io.EOF {
+		r.store.remove(r.id)
+	}
+	return n, err
+}
+
+func (r *uploadReader) Close() error {
+	r.store.remove(r.id)
+	return nil
+}
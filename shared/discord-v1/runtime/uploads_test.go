@@ -0,0 +1,57 @@
+package runtime_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	proto "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+)
+
+// store stores an upload with content.
+func store(t *testing.T, s *runtime.UploadStore, content string) string {
+	t.Helper()
+
+	id, size, err := s.Store(strings.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size != int64(len(content)) {
+		t.Fatalf("stored %d bytes of %d", size, len(content))
+	}
+	return id
+}
+
+func TestUploadStoreFiles(t *testing.T) {
+	s := runtime.NewUploadStore(10)
+	if _, _, err := s.Store(strings.NewReader("more than 10 bytes")); err == nil {
+		t.Error("stored a file larger than the limit")
+	}
+
+	// The uploads are deleted once read to the end, or closed
+	read, closed := store(t, s, "read"), store(t, s, "shut")
+	files, done, err := s.Files([]*proto.File{{Name: "read.txt", UploadId: read}, {Name: "closed.txt", UploadId: closed}, {Name: "data.txt", Data: []byte("d")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content, err := io.ReadAll(files[0].Reader); err != nil || string(content) != "read" {
+		t.Errorf("got %q (%v)", content, err)
+	}
+	files[1].Reader.(io.Closer).Close()
+	for _, id := range []string{read, closed} {
+		if _, _, err := s.Files([]*proto.File{{Name: "again.txt", UploadId: id}}); err == nil {
+			t.Errorf("upload %s wasn't deleted", id)
+		}
+	}
+	done()
+
+	// The limit applies to all the files of a call
+	first, second := store(t, s, "123456"), store(t, s, "123456")
+	if _, _, err := s.Files([]*proto.File{{Name: "1.txt", UploadId: first}, {Name: "2.txt", UploadId: second}}); err == nil {
+		t.Error("sent more than the limit in a call")
+	}
+	if _, _, err := s.Files([]*proto.File{{Name: "1.txt", UploadId: store(t, s, "123456")}, {Name: "2.txt", Data: []byte("123456")}}); err == nil {
+		t.Error("sent more than the limit in a call with data")
+	}
+}
@@ -0,0 +1,107 @@
+// Package flextest runs a module's hooks in-process for unit tests.
+//
+// The hooks are served through the real discord-v1 and core-v1 plugins over an in-memory gRPC
+// connection, so a test exercises the same proto conversions as a module running under the runtime.
+// The runtime side is replaced by a recording Helper and a fake VoiceStream service.
+//
+//	m := flextest.Start(t, nil, &MyModule{})
+//	m.Init()
+//	m.Discord.OnCreateChatMessage(&discordgo.Message{ChannelID: "1", Content: "ping", Author: &discordgo.User{ID: "2"}})
+//	if calls := m.Helper.CallsTo("ChannelMessageSend"); len(calls) != 1 { ... }
+package flextest
+
+import (
+	"context"
+	"testing"
+
+	plugin "github.com/hashicorp/go-plugin"
+	core "github.com/thirdscam/chatanium-flexmodule/shared/core-v1"
+	core_module "github.com/thirdscam/chatanium-flexmodule/shared/core-v1/module"
+	core_runtime "github.com/thirdscam/chatanium-flexmodule/shared/core-v1/runtime"
+	discord "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+	discord_module "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/module"
+	discord_runtime "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/runtime"
+	"google.golang.org/grpc"
+)
+
+// Module is a module under test, as seen by the runtime.
+type Module struct {
+	Core    core.Hook    // Runtime-side client of the core hook (nil if the module has none)
+	Discord discord.Hook // Runtime-side client of the discord hook
+
+	Helper *Helper      // Helper provided to the module
+	Voice  *VoiceServer // VoiceStream service provided to the module
+}
+
+// Start serves coreHook (may be nil) and discordHook over an in-memory connection.
+// The connection is closed when the test ends.
+func Start(t testing.TB, coreHook core.Hook, discordHook discord.Hook) *Module {
+	t.Helper()
+
+	m := &Module{
+		Helper: NewHelper(),
+		Voice:  NewVoiceServer(),
+	}
+
+	plugins := map[string]plugin.Plugin{
+		"discord-v1": &pair{
+			module: &discord_module.Plugin{Impl: discordHook},
+			runtime: &discord_runtime.Plugin{
+				Helper:      m.Helper,
+				VoiceStream: m.Voice,
+			},
+		},
+	}
+	if coreHook != nil {
+		plugins["core-v1"] = &pair{
+			module:  &core_module.Plugin{Impl: coreHook},
+			runtime: &core_runtime.Plugin{},
+		}
+	}
+
+	client, server := plugin.TestPluginGRPCConn(t, false, plugins)
+	t.Cleanup(func() {
+		client.Close()
+		server.Stop()
+	})
+
+	raw, err := client.Dispense("discord-v1")
+	if err != nil {
+		t.Fatalf("flextest: failed to dispense discord-v1: %v", err)
+	}
+	m.Discord = raw.(discord.RuntimeClients).GetHook()
+
+	if coreHook != nil {
+		raw, err := client.Dispense("core-v1")
+		if err != nil {
+			t.Fatalf("flextest: failed to dispense core-v1: %v", err)
+		}
+		m.Core = raw.(core.Hook)
+	}
+
+	return m
+}
+
+// Init initializes the discord hook as the runtime does, connecting the module to Helper and Voice.
+func (m *Module) Init() discord.InitResponse {
+	return m.Discord.OnInit(m.Helper)
+}
+
+// pair serves the module side of a plugin and dispenses its runtime side,
+// so that both ends of the connection live in the test process.
+type pair struct {
+	plugin.NetRPCUnsupportedPlugin
+
+	module  plugin.GRPCPlugin
+	runtime plugin.GRPCPlugin
+}
+
+func (p *pair) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	return p.module.GRPCServer(broker, s)
+}
+
+func (p *pair) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return p.runtime.GRPCClient(ctx, broker, c)
+}
+
+var _ plugin.GRPCPlugin = &pair{}
@@ -0,0 +1,257 @@
+package flextest_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	pb "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
+	core "github.com/thirdscam/chatanium-flexmodule/shared/core-v1"
+	discord "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+	"github.com/thirdscam/chatanium-flexmodule/shared/discord-v1/module"
+	"github.com/thirdscam/chatanium-flexmodule/shared/flextest"
+)
+
+type coreHook struct{}
+
+func (h *coreHook) GetManifest() (core.Manifest, error) {
+	return core.Manifest{Name: "echo", Version: "1.0.0", Permissions: core.Permissions{"DISCORD_SEND_MESSAGE"}}, nil
+}
+
+func (h *coreHook) GetStatus() (core.Status, error) { return core.Status{IsReady: true}, nil }
+
+func (h *coreHook) OnStage(stage string) {}
+
+type echoModule struct {
+	discord.AbstractHooks
+	helper discord.Helper
+	voice  *module.VoiceClient
+	stream pb.VoiceStreamClient
+	errs   chan error
+}
+
+func (m *echoModule) SetVoiceStream(stream pb.VoiceStreamClient) { m.stream = stream }
+
+func (m *echoModule) OnInit(h discord.Helper) discord.InitResponse {
+	m.helper = h
+	return discord.InitResponse{
+		Interactions: []*discordgo.ApplicationCommand{{Name: "echo", Description: "Echo a text"}},
+	}
+}
+
+func (m *echoModule) OnCreateChatMessage(msg *discordgo.Message) error {
+	switch msg.Content {
+	case "join":
+		m.voice = module.NewVoiceClient(m.stream, "echo")
+		if err := m.voice.Join(context.Background(), msg.GuildID, msg.ChannelID, false, false); err != nil {
+			m.errs <- err
+			return err
+		}
+		m.voice.SendWithDefaults([]byte{0xf8, 0xff, 0xfe})
+	default:
+		_, err := m.helper.ChannelMessageSendEmbed(msg.ChannelID, &discordgo.MessageEmbed{
+			Title:       msg.Author.Username,
+			Description: msg.Content,
+		})
+		if err != nil {
+			m.errs <- err
+		}
+	}
+	return nil
+}
+
+func TestModule(t *testing.T) {
+	impl := &echoModule{errs: make(chan error, 1)}
+	m := flextest.Start(t, &coreHook{}, impl)
+
+	manifest, err := m.Core.GetManifest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if manifest.Name != "echo" || len(manifest.Permissions) != 1 {
+		t.Fatalf("unexpected manifest: %+v", manifest)
+	}
+
+	resp := m.Init()
+	if len(resp.Interactions) != 1 || resp.Interactions[0].Name != "echo" {
+		t.Fatalf("unexpected interactions: %+v", resp.Interactions)
+	}
+
+	// The message and the embed both go through proto
+	if err := m.Discord.OnCreateChatMessage(&discordgo.Message{
+		ID:        "10",
+		ChannelID: "20",
+		GuildID:   "30",
+		Content:   "hello",
+		Author:    &discordgo.User{ID: "40", Username: "alice"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := m.Helper.CallsTo("ChannelMessageSendEmbed")
+	if len(calls) != 1 {
+		t.Fatalf("expected 1 ChannelMessageSendEmbed call, got %+v", m.Helper.Calls())
+	}
+	embed := calls[0].Args[1].(*discordgo.MessageEmbed)
+	if calls[0].Args[0] != "20" || embed.Title != "alice" || embed.Description != "hello" {
+		t.Fatalf("unexpected call: %+v %+v", calls[0], embed)
+	}
+
+	// Scripted errors reach the module
+	m.Helper.Respond("ChannelMessageSendEmbed", nil, errors.New("missing permissions"))
+	m.Discord.OnCreateChatMessage(&discordgo.Message{ChannelID: "20", Content: "again", Author: &discordgo.User{ID: "40"}})
+	select {
+	case err := <-impl.errs:
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+	default:
+		t.Fatal("the scripted error was not returned to the module")
+	}
+
+	// Voice
+	m.Discord.OnCreateChatMessage(&discordgo.Message{ChannelID: "50", GuildID: "30", Content: "join", Author: &discordgo.User{ID: "40"}})
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		conns := m.Voice.Connections()
+		if len(conns) == 1 && len(conns[0].Sent) == 1 {
+			if conns[0].ChannelID != "50" || len(conns[0].Sent[0]) != 3 {
+				t.Fatalf("unexpected voice connection: %+v", conns[0])
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("timeout waiting for the voice frame: %+v", conns)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if err := m.Voice.Receive("voice-1", &pb.VoicePacket{OpusData: []byte{1, 2}, Ssrc: 7}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case packet := <-impl.voice.RecvChan:
+		if packet.SSRC != 7 || len(packet.OpusData) != 2 {
+			t.Fatalf("unexpected packet: %+v", packet)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for the received packet")
+	}
+}
+
+type fileModule struct {
+	discord.AbstractHooks
+	helper discord.Helper
+	errs   chan error
+}
+
+func (m *fileModule) OnInit(h discord.Helper) discord.InitResponse {
+	m.helper = h
+	return discord.InitResponse{}
+}
+
+// Sends a small file (inline) and a large one (uploaded)
+func (m *fileModule) OnCreateChatMessage(msg *discordgo.Message) error {
+	_, err := m.helper.ChannelMessageSendComplex(msg.ChannelID, &discordgo.MessageSend{
+		Content: "files",
+		Files: []*discordgo.File{
+			{Name: "small.txt", ContentType: "text/plain", Reader: bytes.NewReader([]byte("hello"))},
+			{Name: "large.bin", Reader: bytes.NewReader(bytes.Repeat([]byte{7}, 3<<20))},
+		},
+	})
+	m.errs <- err
+	return nil
+}
+
+func TestFiles(t *testing.T) {
+	impl := &fileModule{errs: make(chan error, 1)}
+	m := flextest.Start(t, nil, impl)
+	m.Init()
+
+	if err := m.Discord.OnCreateChatMessage(&discordgo.Message{ChannelID: "20", Author: &discordgo.User{ID: "40"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-impl.errs; err != nil {
+		t.Fatal(err)
+	}
+
+	calls := m.Helper.CallsTo("ChannelMessageSendComplex")
+	if len(calls) != 1 {
+		t.Fatalf("expected 1 ChannelMessageSendComplex call, got %+v", m.Helper.Calls())
+	}
+	files := calls[0].Args[1].(*discordgo.MessageSend).Files
+	if len(files) != 2 || files[0].Name != "small.txt" || files[0].ContentType != "text/plain" || files[1].Name != "large.bin" {
+		t.Fatalf("unexpected files: %+v", files)
+	}
+	if data, _ := io.ReadAll(files[0].Reader); string(data) != "hello" {
+		t.Errorf("small file: got %q", data)
+	}
+	if data, _ := io.ReadAll(files[1].Reader); !bytes.Equal(data, bytes.Repeat([]byte{7}, 3<<20)) {
+		t.Errorf("large file: got %d bytes", len(data))
+	}
+}
+
+type downloadModule struct {
+	discord.AbstractHooks
+	helper discord.Helper
+	result chan string
+}
+
+func (m *downloadModule) OnInit(h discord.Helper) discord.InitResponse {
+	m.helper = h
+	return discord.InitResponse{}
+}
+
+func (m *downloadModule) OnCreateChatMessage(msg *discordgo.Message) error {
+	download, err := m.helper.AttachmentDownload(msg.Attachments[0])
+	if err != nil {
+		m.result <- "error: " + err.Error()
+		return nil
+	}
+	defer download.Close()
+
+	data, err := io.ReadAll(download)
+	if err != nil {
+		m.result <- "error: " + err.Error()
+		return nil
+	}
+	m.result <- download.ContentType + " " + string(data)
+	return nil
+}
+
+func TestAttachmentDownload(t *testing.T) {
+	impl := &downloadModule{result: make(chan string, 1)}
+	m := flextest.Start(t, nil, impl)
+	m.Init()
+
+	content := bytes.Repeat([]byte("a"), 100<<10)
+	m.Helper.Respond("AttachmentDownload", &discord.Download{
+		ReadCloser:  io.NopCloser(bytes.NewReader(content)),
+		ContentType: "audio/mpeg",
+		Size:        int64(len(content)),
+	}, nil)
+
+	attachment := &discordgo.MessageAttachment{ID: "60", URL: "https://cdn.discordapp.com/attachments/1/60/song.mp3", Filename: "song.mp3"}
+	if err := m.Discord.OnCreateChatMessage(&discordgo.Message{ChannelID: "20", Attachments: []*discordgo.MessageAttachment{attachment}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := <-impl.result; got != "audio/mpeg "+string(content) {
+		t.Errorf("unexpected download: %.40q", got)
+	}
+
+	calls := m.Helper.CallsTo("AttachmentDownload")
+	if len(calls) != 1 || calls[0].Args[0].(*discordgo.MessageAttachment).URL != attachment.URL {
+		t.Fatalf("unexpected calls: %+v", calls)
+	}
+
+	// The runtime's error reaches the module
+	m.Helper.Respond("AttachmentDownload", nil, errors.New("not found"))
+	m.Discord.OnCreateChatMessage(&discordgo.Message{ChannelID: "20", Attachments: []*discordgo.MessageAttachment{attachment}})
+	if got := <-impl.result; got != "error: rpc error: code = Unknown desc = not found" {
+		t.Errorf("unexpected result: %q", got)
+	}
+}
@@ -0,0 +1,521 @@
+package flextest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	discord "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+)
+
+// Call is a Helper call made by the module.
+//
+// Args are the arguments as received by the runtime, i.e. after the round trip through proto.
+// The files of the calls were read as if they were sent, and can be read again from their Reader.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Response is a scripted result of a Helper call.
+type Response struct {
+	Value interface{} // Must have the result type of the method (ignored for methods that only return an error)
+	Err   error
+}
+
+// Helper is a fake discord.Helper that records every call and returns scripted responses.
+//
+// Without a scripted response, a call succeeds with a plausible result built from its arguments
+// (e.g. ChannelMessageSend returns a message with the sent content).
+type Helper struct {
+	mu        sync.Mutex
+	calls     []Call
+	responses map[string][]Response
+	nextID    uint64
+
+	Bot *discordgo.User // The user the module acts as
+}
+
+// NewHelper creates an empty Helper.
+func NewHelper() *Helper {
+	return &Helper{
+		responses: make(map[string][]Response),
+		nextID:    200000000000000000,
+		Bot:       &discordgo.User{ID: "200000000000000000", Username: "flextest", Bot: true},
+	}
+}
+
+// Respond scripts the result of the next call to method (e.g. "ChannelMessageSend").
+// Responses to the same method are used in the order they were scripted.
+func (h *Helper) Respond(method string, value interface{}, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.responses[method] = append(h.responses[method], Response{Value: value, Err: err})
+}
+
+// Calls returns all calls made so far, in order.
+func (h *Helper) Calls() []Call {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]Call{}, h.calls...)
+}
+
+// CallsTo returns the calls made so far to method, in order.
+func (h *Helper) CallsTo(method string) []Call {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	calls := make([]Call, 0)
+	for _, c := range h.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// Reset forgets all recorded calls and unused responses.
+func (h *Helper) Reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.calls = nil
+	h.responses = make(map[string][]Response)
+}
+
+// record records a call and pops its scripted response, if any.
+func (h *Helper) record(method string, args ...interface{}) (Response, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.calls = append(h.calls, Call{Method: method, Args: args})
+
+	queue := h.responses[method]
+	if len(queue) == 0 {
+		return Response{}, false
+	}
+	h.responses[method] = queue[1:]
+	return queue[0], true
+}
+
+func (h *Helper) newID() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.nextID++
+	return strconv.FormatUint(h.nextID, 10)
+}
+
+// result returns the scripted response of a call, or def if there is none.
+func result[T any](h *Helper, method string, def func() T, args ...interface{}) (T, error) {
+	r, ok := h.record(method, args...)
+	if !ok {
+		return def(), nil
+	}
+
+	var zero T
+	if r.Value == nil {
+		return zero, r.Err
+	}
+	v, ok := r.Value.(T)
+	if !ok {
+		return zero, fmt.Errorf("flextest: scripted %T for %s, expected %T", r.Value, method, zero)
+	}
+	return v, r.Err
+}
+
+// fail returns the scripted error of a call that only returns an error.
+func (h *Helper) fail(method string, args ...interface{}) error {
+	r, _ := h.record(method, args...)
+	return r.Err
+}
+
+// WithContext returns the helper itself, so that the calls made with any context are recorded together.
+func (h *Helper) WithContext(ctx context.Context) discord.Helper {
+	return h
+}
+
+// sent returns a message as if it was sent by the bot.
+func (h *Helper) sent(channelID, content string, embeds []*discordgo.MessageEmbed) *discordgo.Message {
+	return &discordgo.Message{
+		ID:        h.newID(),
+		ChannelID: channelID,
+		Content:   content,
+		Embeds:    embeds,
+		Timestamp: time.Now(),
+		Author:    h.Bot,
+	}
+}
+
+// readFiles reads the files of a call (which the runtime deletes once the call returned), and
+// replaces their Reader with their content.
+func readFiles(files []*discordgo.File) error {
+	for _, file := range files {
+		if file.Reader == nil {
+			continue
+		}
+		data, err := io.ReadAll(file.Reader)
+		if err != nil {
+			return fmt.Errorf("flextest: failed to read file %s: %w", file.Name, err)
+		}
+		file.Reader = bytes.NewReader(data)
+	}
+	return nil
+}
+
+// ================================================
+// Message operations
+// ================================================
+
+func (h *Helper) ChannelMessageSend(channelID string, content string) (*discordgo.Message, error) {
+	return result(h, "ChannelMessageSend", func() *discordgo.Message {
+		return h.sent(channelID, content, nil)
+	}, channelID, content)
+}
+
+func (h *Helper) ChannelMessageSendComplex(channelID string, data *discordgo.MessageSend) (*discordgo.Message, error) {
+	if err := readFiles(data.Files); err != nil {
+		return nil, err
+	}
+	return result(h, "ChannelMessageSendComplex", func() *discordgo.Message {
+		return h.sent(channelID, data.Content, data.Embeds)
+	}, channelID, data)
+}
+
+func (h *Helper) ChannelMessageSendEmbed(channelID string, embed *discordgo.MessageEmbed) (*discordgo.Message, error) {
+	return result(h, "ChannelMessageSendEmbed", func() *discordgo.Message {
+		return h.sent(channelID, "", []*discordgo.MessageEmbed{embed})
+	}, channelID, embed)
+}
+
+func (h *Helper) ChannelMessageSendEmbeds(channelID string, embeds []*discordgo.MessageEmbed) (*discordgo.Message, error) {
+	return result(h, "ChannelMessageSendEmbeds", func() *discordgo.Message {
+		return h.sent(channelID, "", embeds)
+	}, channelID, embeds)
+}
+
+func (h *Helper) ChannelMessageEdit(channelID, messageID, content string) (*discordgo.Message, error) {
+	return result(h, "ChannelMessageEdit", func() *discordgo.Message {
+		m := h.sent(channelID, content, nil)
+		m.ID = messageID
+		return m
+	}, channelID, messageID, content)
+}
+
+func (h *Helper) ChannelMessageEditComplex(data *discordgo.MessageEdit) (*discordgo.Message, error) {
+	if err := readFiles(data.Files); err != nil {
+		return nil, err
+	}
+	return result(h, "ChannelMessageEditComplex", func() *discordgo.Message {
+		m := h.sent(data.Channel, "", nil)
+		m.ID = data.ID
+		if data.Content != nil {
+			m.Content = *data.Content
+		}
+		if data.Embeds != nil {
+			m.Embeds = *data.Embeds
+		}
+		return m
+	}, data)
+}
+
+func (h *Helper) ChannelMessageDelete(channelID, messageID string) error {
+	return h.fail("ChannelMessageDelete", channelID, messageID)
+}
+
+func (h *Helper) ChannelMessages(channelID string, limit int, beforeID, afterID, aroundID string) ([]*discordgo.Message, error) {
+	return result(h, "ChannelMessages", func() []*discordgo.Message {
+		return []*discordgo.Message{}
+	}, channelID, limit, beforeID, afterID, aroundID)
+}
+
+func (h *Helper) ChannelMessage(channelID, messageID string) (*discordgo.Message, error) {
+	return result(h, "ChannelMessage", func() *discordgo.Message {
+		m := h.sent(channelID, "", nil)
+		m.ID = messageID
+		return m
+	}, channelID, messageID)
+}
+
+// ================================================
+// Channel operations
+// ================================================
+
+func (h *Helper) Channel(channelID string) (*discordgo.Channel, error) {
+	return result(h, "Channel", func() *discordgo.Channel {
+		return &discordgo.Channel{ID: channelID, Type: discordgo.ChannelTypeGuildText}
+	}, channelID)
+}
+
+func (h *Helper) ChannelEdit(channelID string, data *discordgo.ChannelEdit) (*discordgo.Channel, error) {
+	return result(h, "ChannelEdit", func() *discordgo.Channel {
+		return &discordgo.Channel{ID: channelID, Name: data.Name, Type: discordgo.ChannelTypeGuildText}
+	}, channelID, data)
+}
+
+func (h *Helper) ChannelDelete(channelID string) (*discordgo.Channel, error) {
+	return result(h, "ChannelDelete", func() *discordgo.Channel {
+		return &discordgo.Channel{ID: channelID, Type: discordgo.ChannelTypeGuildText}
+	}, channelID)
+}
+
+func (h *Helper) ChannelTyping(channelID string) error {
+	return h.fail("ChannelTyping", channelID)
+}
+
+// ================================================
+// Guild operations
+// ================================================
+
+func (h *Helper) Guild(guildID string) (*discordgo.Guild, error) {
+	return result(h, "Guild", func() *discordgo.Guild {
+		return &discordgo.Guild{ID: guildID}
+	}, guildID)
+}
+
+func (h *Helper) GuildChannels(guildID string) ([]*discordgo.Channel, error) {
+	return result(h, "GuildChannels", func() []*discordgo.Channel {
+		return []*discordgo.Channel{}
+	}, guildID)
+}
+
+func (h *Helper) GuildMembers(guildID string, after string, limit int) ([]*discordgo.Member, error) {
+	return result(h, "GuildMembers", func() []*discordgo.Member {
+		return []*discordgo.Member{}
+	}, guildID, after, limit)
+}
+
+func (h *Helper) GuildMember(guildID, userID string) (*discordgo.Member, error) {
+	return result(h, "GuildMember", func() *discordgo.Member {
+		return &discordgo.Member{GuildID: guildID, User: &discordgo.User{ID: userID}}
+	}, guildID, userID)
+}
+
+func (h *Helper) GuildRoles(guildID string) ([]*discordgo.Role, error) {
+	return result(h, "GuildRoles", func() []*discordgo.Role {
+		return []*discordgo.Role{}
+	}, guildID)
+}
+
+// ================================================
+// User operations
+// ================================================
+
+func (h *Helper) User(userID string) (*discordgo.User, error) {
+	return result(h, "User", func() *discordgo.User {
+		if userID == "@me" {
+			return h.Bot
+		}
+		return &discordgo.User{ID: userID}
+	}, userID)
+}
+
+func (h *Helper) UserChannelCreate(recipientID string) (*discordgo.Channel, error) {
+	return result(h, "UserChannelCreate", func() *discordgo.Channel {
+		return &discordgo.Channel{
+			ID:         h.newID(),
+			Type:       discordgo.ChannelTypeDM,
+			Recipients: []*discordgo.User{{ID: recipientID}},
+		}
+	}, recipientID)
+}
+
+// ================================================
+// Interaction operations
+// ================================================
+
+func (h *Helper) InteractionRespond(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse) error {
+	if resp.Data != nil {
+		if err := readFiles(resp.Data.Files); err != nil {
+			return err
+		}
+	}
+	return h.fail("InteractionRespond", interaction, resp)
+}
+
+func (h *Helper) InteractionResponseEdit(interaction *discordgo.Interaction, newresp *discordgo.WebhookEdit) (*discordgo.Message, error) {
+	if err := readFiles(newresp.Files); err != nil {
+		return nil, err
+	}
+	return result(h, "InteractionResponseEdit", func() *discordgo.Message {
+		m := h.sent(interaction.ChannelID, "", nil)
+		if newresp.Content != nil {
+			m.Content = *newresp.Content
+		}
+		return m
+	}, interaction, newresp)
+}
+
+// ================================================
+// Application Command operations
+// ================================================
+
+func (h *Helper) ApplicationCommandCreate(appID string, guildID string, cmd *discordgo.ApplicationCommand) (*discordgo.ApplicationCommand, error) {
+	return result(h, "ApplicationCommandCreate", func() *discordgo.ApplicationCommand {
+		created := *cmd
+		created.ID = h.newID()
+		created.ApplicationID = appID
+		created.GuildID = guildID
+		return &created
+	}, appID, guildID, cmd)
+}
+
+func (h *Helper) ApplicationCommandEdit(appID, guildID, cmdID string, cmd *discordgo.ApplicationCommand) (*discordgo.ApplicationCommand, error) {
+	return result(h, "ApplicationCommandEdit", func() *discordgo.ApplicationCommand {
+		edited := *cmd
+		edited.ID = cmdID
+		edited.ApplicationID = appID
+		edited.GuildID = guildID
+		return &edited
+	}, appID, guildID, cmdID, cmd)
+}
+
+func (h *Helper) ApplicationCommandDelete(appID, guildID, cmdID string) error {
+	return h.fail("ApplicationCommandDelete", appID, guildID, cmdID)
+}
+
+func (h *Helper) ApplicationCommands(appID, guildID string) ([]*discordgo.ApplicationCommand, error) {
+	return result(h, "ApplicationCommands", func() []*discordgo.ApplicationCommand {
+		return []*discordgo.ApplicationCommand{}
+	}, appID, guildID)
+}
+
+// ================================================
+// Reaction operations
+// ================================================
+
+func (h *Helper) MessageReactionAdd(channelID, messageID, emojiID string) error {
+	return h.fail("MessageReactionAdd", channelID, messageID, emojiID)
+}
+
+func (h *Helper) MessageReactionRemove(channelID, messageID, emojiID, userID string) error {
+	return h.fail("MessageReactionRemove", channelID, messageID, emojiID, userID)
+}
+
+func (h *Helper) MessageReactionsRemoveAll(channelID, messageID string) error {
+	return h.fail("MessageReactionsRemoveAll", channelID, messageID)
+}
+
+// ================================================
+// Thread operations
+// ================================================
+
+func (h *Helper) ThreadStart(channelID, name string, typ discordgo.ChannelType, archiveDuration int) (*discordgo.Channel, error) {
+	return result(h, "ThreadStart", func() *discordgo.Channel {
+		return &discordgo.Channel{ID: h.newID(), ParentID: channelID, Name: name, Type: typ}
+	}, channelID, name, typ, archiveDuration)
+}
+
+func (h *Helper) ThreadJoin(threadID string) error {
+	return h.fail("ThreadJoin", threadID)
+}
+
+func (h *Helper) ThreadLeave(threadID string) error {
+	return h.fail("ThreadLeave", threadID)
+}
+
+func (h *Helper) ThreadMemberAdd(threadID, memberID string) error {
+	return h.fail("ThreadMemberAdd", threadID, memberID)
+}
+
+func (h *Helper) ThreadMemberRemove(threadID, memberID string) error {
+	return h.fail("ThreadMemberRemove", threadID, memberID)
+}
+
+// ================================================
+// Voice operations
+// ================================================
+
+func (h *Helper) VoiceRegions() ([]*discordgo.VoiceRegion, error) {
+	return result(h, "VoiceRegions", func() []*discordgo.VoiceRegion {
+		return []*discordgo.VoiceRegion{}
+	})
+}
+
+func (h *Helper) GuildVoiceStates(guildID string) ([]*discordgo.VoiceState, error) {
+	return result(h, "GuildVoiceStates", func() []*discordgo.VoiceState {
+		return []*discordgo.VoiceState{}
+	}, guildID)
+}
+
+// ================================================
+// File operations
+// ================================================
+
+// AttachmentDownload returns an empty download by default. Script a *discord.Download to give it content.
+func (h *Helper) AttachmentDownload(attachment *discordgo.MessageAttachment) (*discord.Download, error) {
+	return result(h, "AttachmentDownload", func() *discord.Download {
+		return &discord.Download{
+			ReadCloser:  io.NopCloser(bytes.NewReader(nil)),
+			ContentType: attachment.ContentType,
+			Size:        0,
+		}
+	}, attachment)
+}
+
+// ================================================
+// Webhook operations
+// ================================================
+
+func (h *Helper) WebhookCreate(channelID, name, avatar string) (*discordgo.Webhook, error) {
+	return result(h, "WebhookCreate", func() *discordgo.Webhook {
+		return &discordgo.Webhook{
+			ID:        h.newID(),
+			Type:      discordgo.WebhookTypeIncoming,
+			ChannelID: channelID,
+			Name:      name,
+			Avatar:    avatar,
+			Token:     "flextest-" + h.newID(),
+		}
+	}, channelID, name, avatar)
+}
+
+func (h *Helper) WebhookExecute(webhookID, token string, wait bool, data *discordgo.WebhookParams) (*discordgo.Message, error) {
+	if err := readFiles(data.Files); err != nil {
+		return nil, err
+	}
+	return result(h, "WebhookExecute", func() *discordgo.Message {
+		if !wait {
+			return nil
+		}
+		return h.sent("", data.Content, data.Embeds)
+	}, webhookID, token, wait, data)
+}
+
+// ================================================
+// Permission operations
+// ================================================
+
+func (h *Helper) UserChannelPermissions(userID, channelID string) (int64, error) {
+	return result(h, "UserChannelPermissions", func() int64 {
+		return discordgo.PermissionAll
+	}, userID, channelID)
+}
+
+// ================================================
+// Utility operations
+// ================================================
+
+func (h *Helper) Gateway() (string, error) {
+	return result(h, "Gateway", func() string {
+		return "wss://flextest.invalid"
+	})
+}
+
+func (h *Helper) GatewayBot() (*discordgo.GatewayBotResponse, error) {
+	return result(h, "GatewayBot", func() *discordgo.GatewayBotResponse {
+		return &discordgo.GatewayBotResponse{URL: "wss://flextest.invalid", Shards: 1}
+	})
+}
+
+var (
+	_ discord.Helper        = &Helper{}
+	_ discord.ContextHelper = &Helper{}
+)
@@ -0,0 +1,356 @@
+package flextest_test
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	discord "github.com/thirdscam/chatanium-flexmodule/shared/discord-v1"
+	"github.com/thirdscam/chatanium-flexmodule/shared/flextest"
+)
+
+// recordingHook records the arguments of the events it receives.
+type recordingHook struct {
+	discord.AbstractHooks
+
+	mu    sync.Mutex
+	calls []flextest.Call
+}
+
+func (h *recordingHook) record(method string, args ...any) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.calls = append(h.calls, flextest.Call{Method: method, Args: args})
+	return nil
+}
+
+// only returns the arguments of the single event received since the last call.
+func (h *recordingHook) only(t *testing.T, method string) []any {
+	t.Helper()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	calls := h.calls
+	h.calls = nil
+	if len(calls) != 1 || calls[0].Method != method {
+		t.Fatalf("expected 1 %s call, got %+v", method, calls)
+	}
+	return calls[0].Args
+}
+
+func (h *recordingHook) OnAddReaction(r *discordgo.MessageReactionAdd) error {
+	return h.record("OnAddReaction", r)
+}
+
+func (h *recordingHook) OnRemoveReaction(r *discordgo.MessageReactionRemove) error {
+	return h.record("OnRemoveReaction", r)
+}
+
+func (h *recordingHook) OnRemoveAllReactions(r *discordgo.MessageReactionRemoveAll) error {
+	return h.record("OnRemoveAllReactions", r)
+}
+
+func (h *recordingHook) OnRemoveEmojiReactions(r *discordgo.MessageReaction) error {
+	return h.record("OnRemoveEmojiReactions", r)
+}
+
+func (h *recordingHook) OnAddGuildMember(m *discordgo.Member) error {
+	return h.record("OnAddGuildMember", m)
+}
+
+func (h *recordingHook) OnRemoveGuildMember(m *discordgo.Member) error {
+	return h.record("OnRemoveGuildMember", m)
+}
+
+func (h *recordingHook) OnUpdateGuildMember(m *discordgo.Member, before *discordgo.Member) error {
+	return h.record("OnUpdateGuildMember", m, before)
+}
+
+func (h *recordingHook) OnAddGuildBan(b *discordgo.GuildBanAdd) error {
+	return h.record("OnAddGuildBan", b)
+}
+
+func (h *recordingHook) OnRemoveGuildBan(b *discordgo.GuildBanRemove) error {
+	return h.record("OnRemoveGuildBan", b)
+}
+
+func (h *recordingHook) OnVoiceStateUpdate(s *discordgo.VoiceState, before *discordgo.VoiceState) error {
+	return h.record("OnVoiceStateUpdate", s, before)
+}
+
+func (h *recordingHook) OnReady(r *discordgo.Ready) error {
+	return h.record("OnReady", r)
+}
+
+func (h *recordingHook) OnResumed() error {
+	return h.record("OnResumed")
+}
+
+func (h *recordingHook) OnDisconnect() error {
+	return h.record("OnDisconnect")
+}
+
+func (h *recordingHook) OnCreateGuild(g *discordgo.Guild) error {
+	return h.record("OnCreateGuild", g)
+}
+
+func (h *recordingHook) OnUpdateGuild(g *discordgo.Guild) error {
+	return h.record("OnUpdateGuild", g)
+}
+
+func (h *recordingHook) OnDeleteGuild(g *discordgo.Guild, before *discordgo.Guild) error {
+	return h.record("OnDeleteGuild", g, before)
+}
+
+func TestReactionHooks(t *testing.T) {
+	hook := &recordingHook{}
+	m := flextest.Start(t, nil, hook)
+	m.Init()
+
+	reaction := &discordgo.MessageReaction{
+		UserID:    "1",
+		MessageID: "2",
+		ChannelID: "3",
+		GuildID:   "4",
+		Emoji:     discordgo.Emoji{ID: "5", Name: "party", Animated: true},
+	}
+
+	member := &discordgo.Member{GuildID: "4", Nick: "ally", Roles: []string{"6"}, User: &discordgo.User{ID: "1", Username: "alice"}}
+	if err := m.Discord.OnAddReaction(&discordgo.MessageReactionAdd{MessageReaction: reaction, Member: member}); err != nil {
+		t.Fatal(err)
+	}
+	add := hook.only(t, "OnAddReaction")[0].(*discordgo.MessageReactionAdd)
+	if !reflect.DeepEqual(add.MessageReaction, reaction) {
+		t.Errorf("OnAddReaction: got %#v, want %#v", add.MessageReaction, reaction)
+	}
+	if add.Member == nil || add.Member.GuildID != "4" || add.Member.User.ID != "1" || add.Member.Nick != "ally" || !reflect.DeepEqual(add.Member.Roles, member.Roles) {
+		t.Errorf("OnAddReaction: unexpected member %#v", add.Member)
+	}
+
+	// Reactions in DMs have no member
+	if err := m.Discord.OnAddReaction(&discordgo.MessageReactionAdd{MessageReaction: &discordgo.MessageReaction{UserID: "1", MessageID: "2", ChannelID: "3"}}); err != nil {
+		t.Fatal(err)
+	}
+	if add := hook.only(t, "OnAddReaction")[0].(*discordgo.MessageReactionAdd); add.Member != nil || add.ChannelID != "3" {
+		t.Errorf("OnAddReaction in DMs: unexpected reaction %#v", add)
+	}
+
+	if err := m.Discord.OnRemoveReaction(&discordgo.MessageReactionRemove{MessageReaction: reaction}); err != nil {
+		t.Fatal(err)
+	}
+	if got := hook.only(t, "OnRemoveReaction")[0].(*discordgo.MessageReactionRemove).MessageReaction; !reflect.DeepEqual(got, reaction) {
+		t.Errorf("OnRemoveReaction: got %#v, want %#v", got, reaction)
+	}
+
+	all := &discordgo.MessageReaction{MessageID: "2", ChannelID: "3", GuildID: "4"}
+	if err := m.Discord.OnRemoveAllReactions(&discordgo.MessageReactionRemoveAll{MessageReaction: all}); err != nil {
+		t.Fatal(err)
+	}
+	if got := hook.only(t, "OnRemoveAllReactions")[0].(*discordgo.MessageReactionRemoveAll).MessageReaction; !reflect.DeepEqual(got, all) {
+		t.Errorf("OnRemoveAllReactions: got %#v, want %#v", got, all)
+	}
+
+	emoji := &discordgo.MessageReaction{MessageID: "2", ChannelID: "3", GuildID: "4", Emoji: discordgo.Emoji{Name: "👍"}}
+	if err := m.Discord.OnRemoveEmojiReactions(emoji); err != nil {
+		t.Fatal(err)
+	}
+	if got := hook.only(t, "OnRemoveEmojiReactions")[0].(*discordgo.MessageReaction); !reflect.DeepEqual(got, emoji) {
+		t.Errorf("OnRemoveEmojiReactions: got %#v, want %#v", got, emoji)
+	}
+}
+
+func TestMemberHooks(t *testing.T) {
+	hook := &recordingHook{}
+	m := flextest.Start(t, nil, hook)
+	m.Init()
+
+	// The runtime gets the previous version of updated members from the state
+	state := discordgo.NewState()
+	session := &discordgo.Session{State: state, StateEnabled: true}
+	state.GuildAdd(&discordgo.Guild{ID: "4"})
+
+	joined := &discordgo.Member{GuildID: "4", Nick: "ally", Roles: []string{"6"}, User: &discordgo.User{ID: "1", Username: "alice"}}
+	state.OnInterface(session, &discordgo.GuildMemberAdd{Member: joined})
+	if err := m.Discord.OnAddGuildMember(joined); err != nil {
+		t.Fatal(err)
+	}
+	if got := hook.only(t, "OnAddGuildMember")[0].(*discordgo.Member); got.GuildID != "4" || got.User.ID != "1" || got.Nick != "ally" {
+		t.Errorf("OnAddGuildMember: unexpected member %#v", got)
+	}
+
+	update := &discordgo.GuildMemberUpdate{Member: &discordgo.Member{GuildID: "4", Nick: "al", Roles: []string{"6", "7"}, User: &discordgo.User{ID: "1", Username: "alice"}}}
+	state.OnInterface(session, update)
+	if err := m.Discord.OnUpdateGuildMember(update.Member, update.BeforeUpdate); err != nil {
+		t.Fatal(err)
+	}
+	args := hook.only(t, "OnUpdateGuildMember")
+	member, before := args[0].(*discordgo.Member), args[1].(*discordgo.Member)
+	if member.Nick != "al" || !reflect.DeepEqual(member.Roles, []string{"6", "7"}) {
+		t.Errorf("OnUpdateGuildMember: unexpected member %#v", member)
+	}
+	if before == nil || before.User.ID != "1" || before.Nick != "ally" || !reflect.DeepEqual(before.Roles, []string{"6"}) {
+		t.Errorf("OnUpdateGuildMember: unexpected before %#v", before)
+	}
+
+	// A member missing from the state has no previous version
+	update = &discordgo.GuildMemberUpdate{Member: &discordgo.Member{GuildID: "4", Nick: "bo", User: &discordgo.User{ID: "2", Username: "bob"}}}
+	state.OnInterface(session, update)
+	if update.BeforeUpdate != nil {
+		t.Fatalf("unexpected before in the state: %#v", update.BeforeUpdate)
+	}
+	if err := m.Discord.OnUpdateGuildMember(update.Member, update.BeforeUpdate); err != nil {
+		t.Fatal(err)
+	}
+	args = hook.only(t, "OnUpdateGuildMember")
+	if member := args[0].(*discordgo.Member); member.User.ID != "2" || member.Nick != "bo" {
+		t.Errorf("OnUpdateGuildMember without before: unexpected member %#v", member)
+	}
+	if before := args[1].(*discordgo.Member); before != nil {
+		t.Errorf("OnUpdateGuildMember without before: got before %#v", before)
+	}
+
+	if err := m.Discord.OnRemoveGuildMember(&discordgo.Member{GuildID: "4", User: &discordgo.User{ID: "1"}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := hook.only(t, "OnRemoveGuildMember")[0].(*discordgo.Member); got.GuildID != "4" || got.User.ID != "1" {
+		t.Errorf("OnRemoveGuildMember: unexpected member %#v", got)
+	}
+
+	if err := m.Discord.OnAddGuildBan(&discordgo.GuildBanAdd{GuildID: "4", User: &discordgo.User{ID: "1", Username: "alice"}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := hook.only(t, "OnAddGuildBan")[0].(*discordgo.GuildBanAdd); got.GuildID != "4" || got.User.ID != "1" || got.User.Username != "alice" {
+		t.Errorf("OnAddGuildBan: unexpected ban %#v", got)
+	}
+
+	if err := m.Discord.OnRemoveGuildBan(&discordgo.GuildBanRemove{GuildID: "4", User: &discordgo.User{ID: "1"}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := hook.only(t, "OnRemoveGuildBan")[0].(*discordgo.GuildBanRemove); got.GuildID != "4" || got.User.ID != "1" {
+		t.Errorf("OnRemoveGuildBan: unexpected ban %#v", got)
+	}
+}
+
+func TestVoiceStateHook(t *testing.T) {
+	hook := &recordingHook{}
+	m := flextest.Start(t, nil, hook)
+	m.Init()
+
+	// The runtime gets the previous voice state from the state
+	state := discordgo.NewState()
+	session := &discordgo.Session{State: state, StateEnabled: true}
+	state.GuildAdd(&discordgo.Guild{ID: "4"})
+
+	for _, test := range []struct {
+		name   string
+		update *discordgo.VoiceState
+		before string // Channel before the update, empty if the user wasn't in one
+	}{
+		{name: "join", update: &discordgo.VoiceState{GuildID: "4", UserID: "1", ChannelID: "8", SessionID: "s"}},
+		{name: "move", update: &discordgo.VoiceState{GuildID: "4", UserID: "1", ChannelID: "9", SessionID: "s", SelfMute: true}, before: "8"},
+		{name: "leave", update: &discordgo.VoiceState{GuildID: "4", UserID: "1", SessionID: "s"}, before: "9"},
+	} {
+		update := &discordgo.VoiceStateUpdate{VoiceState: test.update}
+		state.OnInterface(session, update)
+		if err := m.Discord.OnVoiceStateUpdate(update.VoiceState, update.BeforeUpdate); err != nil {
+			t.Fatal(err)
+		}
+
+		args := hook.only(t, "OnVoiceStateUpdate")
+		after, before := args[0].(*discordgo.VoiceState), args[1].(*discordgo.VoiceState)
+		if !reflect.DeepEqual(after, test.update) {
+			t.Errorf("%s: got %#v, want %#v", test.name, after, test.update)
+		}
+		if test.before == "" {
+			if before != nil {
+				t.Errorf("%s: got before %#v", test.name, before)
+			}
+		} else if before == nil || before.ChannelID != test.before || before.UserID != "1" || before.GuildID != "4" {
+			t.Errorf("%s: got before %#v, want channel %s", test.name, before, test.before)
+		}
+	}
+}
+
+func TestGatewayHooks(t *testing.T) {
+	hook := &recordingHook{}
+	m := flextest.Start(t, nil, hook)
+	m.Init()
+
+	if err := m.Discord.OnReady(&discordgo.Ready{
+		User:      &discordgo.User{ID: "1", Username: "bot", Bot: true},
+		SessionID: "session",
+		Guilds:    []*discordgo.Guild{{ID: "4", Unavailable: true}, {ID: "5", Unavailable: true}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	ready := hook.only(t, "OnReady")[0].(*discordgo.Ready)
+	if ready.User.ID != "1" || !ready.User.Bot || ready.SessionID != "session" || len(ready.Guilds) != 2 || ready.Guilds[1].ID != "5" || !ready.Guilds[1].Unavailable {
+		t.Errorf("OnReady: unexpected ready %#v", ready)
+	}
+
+	if err := m.Discord.OnResumed(); err != nil {
+		t.Fatal(err)
+	}
+	hook.only(t, "OnResumed")
+	if err := m.Discord.OnDisconnect(); err != nil {
+		t.Fatal(err)
+	}
+	hook.only(t, "OnDisconnect")
+
+	// The runtime gets the previous version of deleted guilds from the state
+	state := discordgo.NewState()
+	session := &discordgo.Session{State: state, StateEnabled: true}
+
+	guild := &discordgo.Guild{
+		ID:       "4",
+		Name:     "Guild",
+		OwnerID:  "1",
+		Roles:    []*discordgo.Role{{ID: "6", Name: "Moderator"}},
+		Channels: []*discordgo.Channel{{ID: "7", GuildID: "4", Name: "general"}},
+		Members:  []*discordgo.Member{{GuildID: "4", User: &discordgo.User{ID: "1"}}},
+	}
+	state.OnInterface(session, &discordgo.GuildCreate{Guild: guild})
+	if err := m.Discord.OnCreateGuild(guild); err != nil {
+		t.Fatal(err)
+	}
+	created := hook.only(t, "OnCreateGuild")[0].(*discordgo.Guild)
+	if created.ID != "4" || created.Name != "Guild" || created.OwnerID != "1" || len(created.Roles) != 1 || created.Roles[0].ID != "6" ||
+		len(created.Channels) != 1 || created.Channels[0].ID != "7" || len(created.Members) != 1 || created.Members[0].User.ID != "1" {
+		t.Errorf("OnCreateGuild: unexpected guild %#v", created)
+	}
+
+	if err := m.Discord.OnUpdateGuild(&discordgo.Guild{ID: "4", Name: "Renamed"}); err != nil {
+		t.Fatal(err)
+	}
+	if updated := hook.only(t, "OnUpdateGuild")[0].(*discordgo.Guild); updated.ID != "4" || updated.Name != "Renamed" {
+		t.Errorf("OnUpdateGuild: unexpected guild %#v", updated)
+	}
+
+	for _, test := range []struct {
+		name   string
+		before bool
+	}{
+		{name: "cached", before: true},
+		{name: "uncached"}, // Already deleted from the state
+	} {
+		deleted := &discordgo.GuildDelete{Guild: &discordgo.Guild{ID: "4", Unavailable: true}}
+		state.OnInterface(session, deleted)
+		if err := m.Discord.OnDeleteGuild(deleted.Guild, deleted.BeforeDelete); err != nil {
+			t.Fatal(err)
+		}
+
+		args := hook.only(t, "OnDeleteGuild")
+		guild, before := args[0].(*discordgo.Guild), args[1].(*discordgo.Guild)
+		if guild.ID != "4" || !guild.Unavailable {
+			t.Errorf("%s: unexpected guild %#v", test.name, guild)
+		}
+		if !test.before {
+			if before != nil {
+				t.Errorf("%s: got before %#v", test.name, before)
+			}
+		} else if before == nil || before.ID != "4" || before.Name != "Guild" || len(before.Channels) != 1 || before.Channels[0].ID != "7" {
+			t.Errorf("%s: unexpected before %#v", test.name, before)
+		}
+	}
+}
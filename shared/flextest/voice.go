@@ -0,0 +1,213 @@
+package flextest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	proto_common "github.com/thirdscam/chatanium-flexmodule/proto"
+	pb "github.com/thirdscam/chatanium-flexmodule/proto/discord-v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// VoiceServer is a fake VoiceStream service.
+//
+// It accepts every join, records the opus frames sent by the module,
+// and delivers the packets passed to Receive to the module's open streams.
+type VoiceServer struct {
+	pb.UnimplementedVoiceStreamServer
+
+	mu          sync.Mutex
+	nextID      int
+	connections map[string]*VoiceConnection
+}
+
+// VoiceConnection is a voice connection made by the module.
+type VoiceConnection struct {
+	ID        string
+	GuildID   string
+	ChannelID string
+	Speaking  bool
+	Left      bool
+	Sent      [][]byte // Opus frames sent by the module, in order
+
+	recv chan *pb.VoicePacket
+}
+
+// NewVoiceServer creates a VoiceServer without connections.
+func NewVoiceServer() *VoiceServer {
+	return &VoiceServer{
+		connections: make(map[string]*VoiceConnection),
+	}
+}
+
+// Connections returns a snapshot of the connections made so far.
+func (v *VoiceServer) Connections() []VoiceConnection {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	conns := make([]VoiceConnection, 0, len(v.connections))
+	for i := 1; i <= v.nextID; i++ {
+		c := v.connections[connectionID(i)]
+		snapshot := *c
+		snapshot.Sent = append([][]byte{}, c.Sent...)
+		conns = append(conns, snapshot)
+	}
+	return conns
+}
+
+// Receive delivers a packet to the module on the given connection, as if it was received from Discord.
+func (v *VoiceServer) Receive(connectionID string, packet *pb.VoicePacket) error {
+	v.mu.Lock()
+	c, ok := v.connections[connectionID]
+	v.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("flextest: unknown voice connection %q", connectionID)
+	}
+
+	c.recv <- packet
+	return nil
+}
+
+func connectionID(n int) string {
+	return fmt.Sprintf("voice-%d", n)
+}
+
+// connection returns the open connection with the given ID.
+func (v *VoiceServer) connection(id string) (*VoiceConnection, error) {
+	c, ok := v.connections[id]
+	if !ok || c.Left {
+		return nil, status.Errorf(codes.NotFound, "voice connection %q not found", id)
+	}
+	return c, nil
+}
+
+// VoiceJoin implements VoiceStream.VoiceJoin
+func (v *VoiceServer) VoiceJoin(ctx context.Context, req *pb.VoiceJoinRequest) (*pb.VoiceJoinResponse, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.nextID++
+	c := &VoiceConnection{
+		ID:        connectionID(v.nextID),
+		GuildID:   req.GuildId,
+		ChannelID: req.ChannelId,
+		recv:      make(chan *pb.VoicePacket, 100),
+	}
+	v.connections[c.ID] = c
+
+	return &pb.VoiceJoinResponse{
+		ConnectionId:      c.ID,
+		GuildId:           c.GuildID,
+		ChannelId:         c.ChannelID,
+		Ready:             true,
+		ActiveSubscribers: 1,
+	}, nil
+}
+
+// VoiceStream implements VoiceStream.VoiceStream
+func (v *VoiceServer) VoiceStream(stream pb.VoiceStream_VoiceStreamServer) error {
+	// The first packet identifies the connection
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	v.mu.Lock()
+	c, err := v.connection(first.ConnectionId)
+	v.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	record := func(packet *pb.VoicePacket) {
+		if len(packet.OpusData) == 0 {
+			return
+		}
+		v.mu.Lock()
+		c.Sent = append(c.Sent, packet.OpusData)
+		v.mu.Unlock()
+	}
+	record(first)
+
+	errChan := make(chan error, 2)
+
+	// module -> fake
+	go func() {
+		for {
+			packet, err := stream.Recv()
+			if err != nil {
+				errChan <- err
+				return
+			}
+			record(packet)
+		}
+	}()
+
+	// fake -> module
+	go func() {
+		for {
+			select {
+			case packet := <-c.recv:
+				packet.ConnectionId = c.ID
+				if err := stream.Send(packet); err != nil {
+					errChan <- err
+					return
+				}
+			case <-stream.Context().Done():
+				errChan <- stream.Context().Err()
+				return
+			}
+		}
+	}()
+
+	return <-errChan
+}
+
+// VoiceLeave implements VoiceStream.VoiceLeave
+func (v *VoiceServer) VoiceLeave(ctx context.Context, req *pb.VoiceLeaveRequest) (*proto_common.Empty, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	c, err := v.connection(req.ConnectionId)
+	if err != nil {
+		return nil, err
+	}
+	c.Left = true
+
+	return &proto_common.Empty{}, nil
+}
+
+// VoiceSpeaking implements VoiceStream.VoiceSpeaking
+func (v *VoiceServer) VoiceSpeaking(ctx context.Context, req *pb.VoiceSpeakingRequest) (*proto_common.Empty, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	c, err := v.connection(req.ConnectionId)
+	if err != nil {
+		return nil, err
+	}
+	c.Speaking = req.Speaking
+
+	return &proto_common.Empty{}, nil
+}
+
+// GetQueueStatus implements VoiceStream.GetQueueStatus
+func (v *VoiceServer) GetQueueStatus(ctx context.Context, req *pb.QueueStatusRequest) (*pb.QueueStatusResponse, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	active := 0
+	for _, c := range v.connections {
+		if !c.Left && c.GuildID == req.GuildId {
+			active++
+		}
+	}
+
+	return &pb.QueueStatusResponse{
+		ActiveSubscribers: int32(active),
+	}, nil
+}
+
+var _ pb.VoiceStreamServer = &VoiceServer{}
@@ -1,6 +1,9 @@
 package shared
 
 import (
+	"os/signal"
+	"syscall"
+
 	"github.com/hashicorp/go-plugin"
 	core_module "github.com/thirdscam/chatanium-flexmodule/shared/core-v1/module"
 	core_runtime "github.com/thirdscam/chatanium-flexmodule/shared/core-v1/runtime"
@@ -30,18 +33,34 @@ var ModulePluginMap = map[string]plugin.Plugin{
 	"discord-v1": &discord_module.Plugin{},
 }
 
-// CreateRuntimePluginMap creates a runtime plugin map with the given Discord helper and voice helper
-func CreateRuntimePluginMap(discordHelper discord_shared.Helper, voiceHelper *discord_runtime.VoiceHelper) map[string]plugin.Plugin {
+// CreateRuntimePluginMap creates a runtime plugin map with the given Discord helper and voice helper.
+//
+// The module can only join voice in the guilds of scope. Set reattached when the map is used to reattach to a module that is already running.
+// hookDeadlines and helperDeadlines are the default deadlines of the hooks and Helper calls
+// (the zero value has none). maxUploadSize is the size cap of the files sent by the module in a call, in
+// bytes (discord_runtime.DefaultMaxUploadSize if zero), and downloads downloads the attachments
+// requested by the module (may be nil).
+func CreateRuntimePluginMap(discordHelper discord_shared.Helper, voiceHelper *discord_runtime.VoiceHelper, scope discord_runtime.GuildScope, reattached bool, hookDeadlines, helperDeadlines discord_shared.Deadlines, maxUploadSize int64, downloads *discord_runtime.Downloader) map[string]plugin.Plugin {
 	return map[string]plugin.Plugin{
 		"core-v1": &core_runtime.Plugin{},
 		"discord-v1": &discord_runtime.Plugin{
-			Helper:      discordHelper,
-			VoiceHelper: voiceHelper,
+			Helper:          discordHelper,
+			VoiceHelper:     voiceHelper,
+			Scope:           scope,
+			HookDeadlines:   hookDeadlines,
+			HelperDeadlines: helperDeadlines,
+			MaxUploadSize:   maxUploadSize,
+			Downloads:       downloads,
+			Reattached:      reattached,
 		},
 	}
 }
 
 func ServeToRuntime(plugins map[string]plugin.Plugin) {
+	// The module may outlive the runtime that started it (so that a restarted runtime can reattach).
+	// Writing logs to the dead runtime's stdio pipes must not kill the module in the meantime.
+	signal.Ignore(syscall.SIGPIPE)
+
 	plugin.Serve(&plugin.ServeConfig{
 		HandshakeConfig: Handshake,
 		Plugins:         plugins,
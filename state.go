@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/hashicorp/go-plugin"
+)
+
+// RuntimeState is persisted to the state file so that a restarted runtime can
+// reattach to modules that are still running instead of launching them again.
+type RuntimeState struct {
+	Modules       map[string]ModuleState `json:"modules"`                  // keyed by module path
+	CommandGuilds []string               `json:"command_guilds,omitempty"` // Guilds with commands on Discord, see CommandRegistry.RemoteGuilds
+}
+
+// ModuleState holds the go-plugin reattach information of a single module.
+type ModuleState struct {
+	Protocol        plugin.Protocol `json:"protocol"`
+	ProtocolVersion int             `json:"protocol_version"`
+	Network         string          `json:"network"`
+	Address         string          `json:"address"`
+	Pid             int             `json:"pid"`
+}
+
+// LoadState reads the runtime state from path.
+// A missing state file is not an error and results in an empty state.
+func LoadState(path string) (*RuntimeState, error) {
+	state := &RuntimeState{Modules: map[string]ModuleState{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return state, err
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return &RuntimeState{Modules: map[string]ModuleState{}}, err
+	}
+	if state.Modules == nil {
+		state.Modules = map[string]ModuleState{}
+	}
+
+	return state, nil
+}
+
+// Save writes the runtime state to path.
+// The file is replaced atomically, so a crash while saving never leaves a broken state behind.
+func (s *RuntimeState) Save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// NewModuleState creates a ModuleState from the reattach config of a running client.
+func NewModuleState(c *plugin.ReattachConfig) ModuleState {
+	return ModuleState{
+		Protocol:        c.Protocol,
+		ProtocolVersion: c.ProtocolVersion,
+		Network:         c.Addr.Network(),
+		Address:         c.Addr.String(),
+		Pid:             c.Pid,
+	}
+}
+
+// ReattachConfig converts the saved state back into a go-plugin ReattachConfig.
+func (m ModuleState) ReattachConfig() (*plugin.ReattachConfig, error) {
+	var addr net.Addr
+	var err error
+	switch m.Network {
+	case "tcp":
+		addr, err = net.ResolveTCPAddr("tcp", m.Address)
+	case "unix":
+		addr, err = net.ResolveUnixAddr("unix", m.Address)
+	default:
+		err = fmt.Errorf("unknown network type: %s", m.Network)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &plugin.ReattachConfig{
+		Protocol:        m.Protocol,
+		ProtocolVersion: m.ProtocolVersion,
+		Addr:            addr,
+		Pid:             m.Pid,
+	}, nil
+}
@@ -1,7 +1,10 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/go-hclog"
@@ -28,6 +31,12 @@ var MANIFEST = Core.Manifest{
 	Author:      "thirdscam",
 	Repository:  "github:thirdscam/chatanium-flexmodule",
 	Permissions: PERMISSIONS,
+
+	// Only the messages and the commands below are forwarded by the runtime
+	Subscriptions: []Core.Subscription{
+		{Event: "MESSAGE_CREATE"},
+		{Event: "INTERACTION_CREATE", Commands: []string{"test", "hello"}},
+	},
 }
 
 var log hclog.Logger
@@ -75,6 +84,8 @@ type discord struct {
 	// of hooks, and use it similarly to implementing an abstract class.
 	Discord.AbstractHooks
 	helper Discord.Helper // Store helper instance for use in other methods
+
+	commands *DiscordPlugin.Router // Commands of the module, built in OnInit
 }
 
 func (u *discord) OnInit(h Discord.Helper) Discord.InitResponse {
@@ -103,22 +114,33 @@ func (u *discord) OnInit(h Discord.Helper) Discord.InitResponse {
 	// 	}
 	// }()
 
+	// The router is built once: its handlers get the helper of each hook from Dispatch
+	u.commands = DiscordPlugin.NewRouter()
+	DiscordPlugin.Command(u.commands, "test", "Test command", test)
+	DiscordPlugin.Command(u.commands, "hello", "Say hello", hello)
+
 	log.Info("Discord module initialized with persistent helper connection")
 
 	return Discord.InitResponse{
-		Interactions: []*discordgo.ApplicationCommand{
-			{
-				Name:        "test",
-				Description: "Test command",
-			},
-			{
-				Name:        "hello",
-				Description: "Say hello",
-			},
-		},
+		Interactions: u.commands.Commands(),
 	}
 }
 
+// HelloOptions are the options of /hello, bound by the router.
+type HelloOptions struct {
+	// Suggested by OnAutocomplete
+	Name *string `option:"name,autocomplete,max=32" description:"Who to greet"`
+}
+
+// WithContext is called by the runtime before each hook (see Discord.ContextHook). The helper of
+// the returned copy is bound to the context of the hook, so that its calls are cancelled if the
+// runtime cancels the hook (or its deadline expires).
+func (u *discord) WithContext(ctx context.Context) Discord.Hook {
+	hook := *u
+	hook.helper = Discord.HelperWithContext(u.helper, ctx)
+	return &hook
+}
+
 func (u *discord) OnCreateChatMessage(m *discordgo.Message) error {
 	log.Debug("MESSAGE_CREATE", "message", hclog.Fmt("%+v", m))
 
@@ -167,54 +189,68 @@ func (u *discord) OnCreateChatMessage(m *discordgo.Message) error {
 	return nil
 }
 
-func (u *discord) OnCreateInteraction(i *discordgo.Interaction) error {
+func (u *discord) OnCreateInteraction(i *discordgo.Interaction) (*discordgo.InteractionResponse, error) {
 	log.Debug("INTERACTION_CREATE", "interaction", hclog.Fmt("%+v", i))
+	// u.helper is bound to the context of the hook (see WithContext)
+	return u.commands.Dispatch(u.helper, i)
+}
 
-	if i.Type == discordgo.InteractionApplicationCommand {
-		log.Debug("INTERACTION_CREATE", "i.Type", i.Type, "i.Data", i.Data)
-		cmdData := i.ApplicationCommandData()
-
-		switch cmdData.Name {
-		case "test":
-			log.Debug("INTERACTION_CREATE > test")
-			// Respond to the test command
-			if u.helper != nil {
-				err := u.helper.InteractionRespond(i, &discordgo.InteractionResponse{
-					Type: discordgo.InteractionResponseChannelMessageWithSource,
-					Data: &discordgo.InteractionResponseData{
-						Content: "This is a test response from the plugin! 🎉",
-					},
-				})
-				if err != nil {
-					log.Error("Failed to respond to interaction", "error", err)
-					return err
-				}
-			}
-		case "hello":
-			log.Debug("INTERACTION_CREATE > hello")
-			// Send a hello message
-			if u.helper != nil {
-				err := u.helper.InteractionRespond(i, &discordgo.InteractionResponse{
-					Type: discordgo.InteractionResponseChannelMessageWithSource,
-					Data: &discordgo.InteractionResponseData{
-						Content: "Hello! 👋 This message was sent using the helper function from the plugin!",
-						Embeds: []*discordgo.MessageEmbed{
-							{
-								Title:       "Plugin Helper Test",
-								Description: "This embed was created using the Discord helper functions!",
-								Color:       0x00ff00,
-							},
-						},
+func test(h Discord.Helper, i *discordgo.Interaction, _ *struct{}) (*discordgo.InteractionResponse, error) {
+	log.Debug("INTERACTION_CREATE > test")
+	// Returning the response lets the runtime send it (without a Helper call)
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: "This is a test response from the plugin! 🎉",
+		},
+	}, nil
+}
+
+func hello(h Discord.Helper, i *discordgo.Interaction, options *HelloOptions) (*discordgo.InteractionResponse, error) {
+	log.Debug("INTERACTION_CREATE > hello")
+	greeting := "Hello!"
+	if options.Name != nil {
+		greeting = fmt.Sprintf("Hello, %s!", *options.Name)
+	}
+
+	// Send a hello message
+	if h != nil {
+		err := h.InteractionRespond(i, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content: greeting + " 👋 This message was sent using the helper function from the plugin!",
+				Embeds: []*discordgo.MessageEmbed{
+					{
+						Title:       "Plugin Helper Test",
+						Description: "This embed was created using the Discord helper functions!",
+						Color:       0x00ff00,
 					},
-				})
-				if err != nil {
-					log.Error("Failed to respond to interaction", "error", err)
-					return err
-				}
-			}
+				},
+			},
+		})
+		if err != nil {
+			log.Error("Failed to respond to interaction", "error", err)
+			return nil, err
 		}
 	}
-	return nil
+	return nil, nil
+}
+
+// OnAutocomplete suggests names for the "name" option of /hello (see Discord.AutocompleteHook).
+func (u *discord) OnAutocomplete(i *discordgo.Interaction, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	log.Debug("INTERACTION_CREATE > autocomplete", "focused", hclog.Fmt("%+v", focused))
+
+	if focused == nil || focused.Name != "name" {
+		return nil, nil
+	}
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, name := range []string{"World", "Chatanium", "Gopher"} {
+		if strings.HasPrefix(strings.ToLower(name), strings.ToLower(focused.StringValue())) {
+			choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: name, Value: name})
+		}
+	}
+	return choices, nil
 }
 
 func main() {
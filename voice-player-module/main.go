@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -26,9 +25,7 @@ import (
 )
 
 const (
-	TARGET_GUILD_ID   = "919823370600742942"
-	TARGET_CHANNEL_ID = "1434561578560131245"
-	TEMP_DIR          = "./temp_audio"
+	TEMP_DIR = "./temp_audio"
 )
 
 var PERMISSIONS = Core.Permissions{
@@ -113,30 +110,58 @@ func (vp *voicePlayer) OnCreateChatMessage(m *discordgo.Message) error {
 	}
 
 	for _, attachment := range m.Attachments {
-		// Check if it's an audio file
-		if isAudioFile(attachment.Filename) {
-			log.Info("Audio file detected",
-				"filename", attachment.Filename,
-				"url", attachment.URL,
-				"size", attachment.Size,
-			)
-
-			// Send acknowledgment
-			vp.helper.ChannelMessageSend(m.ChannelID,
-				fmt.Sprintf("🎵 음성 파일을 감지했습니다: `%s`\n음성 채널에 접속하여 재생합니다...", attachment.Filename))
-
-			// Play audio in background
-			go vp.playAudioFile(attachment, m.ChannelID)
+		if !isAudioFile(attachment.Filename) {
+			continue
 		}
+
+		// Play in the voice channel where the uploader is
+		voiceChannelID, err := vp.findVoiceChannel(m.GuildID, m.Author.ID)
+		if err != nil {
+			log.Error("Failed to find the voice channel of the user", "user", m.Author.ID, "error", err)
+			vp.helper.ChannelMessageSend(m.ChannelID, "❌ 음성 채널을 찾을 수 없습니다: "+err.Error())
+			return nil
+		}
+
+		log.Info("Audio file detected",
+			"filename", attachment.Filename,
+			"url", attachment.URL,
+			"size", attachment.Size,
+		)
+
+		// Send acknowledgment
+		vp.helper.ChannelMessageSend(m.ChannelID,
+			fmt.Sprintf("🎵 음성 파일을 감지했습니다: `%s`\n음성 채널에 접속하여 재생합니다...", attachment.Filename))
+
+		// Play audio in background
+		go vp.playAudioFile(attachment, m.GuildID, voiceChannelID, m.ChannelID)
 	}
 
 	return nil
 }
 
-func (vp *voicePlayer) playAudioFile(attachment *discordgo.MessageAttachment, replyChannelID string) {
+// findVoiceChannel returns the voice channel where the user is in the guild.
+func (vp *voicePlayer) findVoiceChannel(guildID, userID string) (string, error) {
+	if guildID == "" {
+		return "", fmt.Errorf("not in a guild")
+	}
+
+	states, err := vp.helper.GuildVoiceStates(guildID)
+	if err != nil {
+		return "", err
+	}
+
+	for _, state := range states {
+		if state.UserID == userID && state.ChannelID != "" {
+			return state.ChannelID, nil
+		}
+	}
+	return "", fmt.Errorf("user is not in a voice channel")
+}
+
+func (vp *voicePlayer) playAudioFile(attachment *discordgo.MessageAttachment, guildID, voiceChannelID, replyChannelID string) {
 	// Download audio file
 	localPath := filepath.Join(TEMP_DIR, attachment.Filename)
-	err := downloadFile(attachment.URL, localPath)
+	err := vp.downloadFile(attachment, localPath)
 	if err != nil {
 		log.Error("Failed to download audio file", "error", err)
 		vp.helper.ChannelMessageSend(replyChannelID, "❌ 음성 파일 다운로드 실패: "+err.Error())
@@ -163,7 +188,7 @@ func (vp *voicePlayer) playAudioFile(attachment *discordgo.MessageAttachment, re
 
 	// Join voice channel
 	ctx := getContextWithModuleID("voice-player")
-	err = voiceClient.Join(ctx, TARGET_GUILD_ID, TARGET_CHANNEL_ID, false, false)
+	err = voiceClient.Join(ctx, guildID, voiceChannelID, false, false)
 	if err != nil {
 		log.Error("Failed to join voice channel", "error", err)
 		vp.helper.ChannelMessageSend(replyChannelID, "❌ 음성 채널 접속 실패: "+err.Error())
@@ -171,7 +196,7 @@ func (vp *voicePlayer) playAudioFile(attachment *discordgo.MessageAttachment, re
 	}
 	defer voiceClient.Leave()
 
-	log.Info("Joined voice channel", "guild", TARGET_GUILD_ID, "channel", TARGET_CHANNEL_ID)
+	log.Info("Joined voice channel", "guild", guildID, "channel", voiceChannelID)
 	vp.helper.ChannelMessageSend(replyChannelID, "✅ 음성 채널에 접속했습니다. 재생을 시작합니다...")
 
 	// Wait for connection to be ready
@@ -222,12 +247,13 @@ func isAudioFile(filename string) bool {
 	return false
 }
 
-func downloadFile(url string, filepath string) error {
-	resp, err := http.Get(url)
+// downloadFile downloads an attachment through the runtime, so the module needs no network access.
+func (vp *voicePlayer) downloadFile(attachment *discordgo.MessageAttachment, filepath string) error {
+	download, err := vp.helper.AttachmentDownload(attachment)
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
+	defer download.Close()
 
 	out, err := os.Create(filepath)
 	if err != nil {
@@ -235,7 +261,7 @@ func downloadFile(url string, filepath string) error {
 	}
 	defer out.Close()
 
-	_, err = io.Copy(out, resp.Body)
+	_, err = io.Copy(out, download)
 	return err
 }
 